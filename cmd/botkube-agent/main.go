@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"time"
+	_ "time/tzdata" // quiet hours can be configured for any time zone, while the container image doesn't ship the time zone database
 
 	"github.com/google/go-github/v53/github"
 	"github.com/gorilla/mux"
@@ -17,6 +18,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/utils/strings"
@@ -35,6 +37,7 @@ import (
 	"github.com/kubeshop/botkube/internal/lifecycle"
 	"github.com/kubeshop/botkube/internal/loggerx"
 	"github.com/kubeshop/botkube/internal/plugin"
+	"github.com/kubeshop/botkube/internal/silence"
 	"github.com/kubeshop/botkube/internal/source"
 	"github.com/kubeshop/botkube/internal/status"
 	"github.com/kubeshop/botkube/internal/storage"
@@ -96,16 +99,44 @@ func run(ctx context.Context) (err error) {
 	}
 
 	cfgProvider := intconfig.GetProvider(remoteCfgEnabled, deployClient)
-	configs, cfgVersion, err := cfgProvider.Configs(ctx)
+	configs, _, err := cfgProvider.Configs(ctx)
 	if err != nil {
 		return fmt.Errorf("while loading configuration files: %w", err)
 	}
 
+	// Secrets referenced in the configuration are read with the K8s client, which is configured in the settings,
+	// so the settings are loaded first without resolving the references.
+	redactedConfigs, err := intconfig.RedactValueRefs(configs)
+	if err != nil {
+		return fmt.Errorf("while loading configuration files: %w", err)
+	}
+	bootstrapConf, err := config.MergeWithDefaults(redactedConfigs)
+	if err != nil {
+		return fmt.Errorf("while merging app configuration: %w", err)
+	}
+	logger := loggerx.New(bootstrapConf.Settings.Log)
+
+	// Prepare K8s clients and mapper
+	kubeConfig, err := kubex.BuildConfigFromFlags("", bootstrapConf.Settings.Kubeconfig, bootstrapConf.Settings.SACredentialsPathPrefix)
+	if err != nil {
+		return fmt.Errorf("while loading k8s config: %w", err)
+	}
+	k8sCli, err := kubernetes.NewForConfig(kubeConfig)
+	if err != nil {
+		return fmt.Errorf("while creating K8s clientset: %w", err)
+	}
+
+	valueRefProvider := intconfig.NewValueRefProvider(logger.WithField(componentLogFieldKey, "Value Reference Provider"), cfgProvider, k8sCli.CoreV1(), bootstrapConf.Settings.SystemConfigMap.Namespace, bootstrapConf.ConfigWatcher.ValueRefs.AllowedFileDirs)
+	cfgProvider = valueRefProvider
+	configs, cfgVersion, err := cfgProvider.Configs(ctx)
+	if err != nil {
+		return fmt.Errorf("while resolving configuration references: %w", err)
+	}
+
 	conf, confDetails, err := config.LoadWithDefaults(configs)
 	if err != nil {
 		return fmt.Errorf("while merging app configuration: %w", err)
 	}
-	logger := loggerx.New(conf.Settings.Log)
 	if confDetails.ValidateWarnings != nil {
 		logger.Warnf("Configuration validation warnings: %v", confDetails.ValidateWarnings.Error())
 	}
@@ -125,20 +156,32 @@ func run(ctx context.Context) (err error) {
 	defer analytics.ReportPanicIfOccurs(logger, reporter)
 
 	reportFatalError := reportFatalErrFn(logger, reporter, statusReporter)
-	// Prepare K8s clients and mapper
-	kubeConfig, err := kubex.BuildConfigFromFlags("", conf.Settings.Kubeconfig, conf.Settings.SACredentialsPathPrefix)
-	if err != nil {
-		return reportFatalError("while loading k8s config", err)
-	}
 	discoveryCli, err := getK8sClients(kubeConfig)
 	if err != nil {
 		return reportFatalError("while getting K8s clients", err)
 	}
 
-	// Register current anonymous identity
-	k8sCli, err := kubernetes.NewForConfig(kubeConfig)
-	if err != nil {
-		return reportFatalError("while creating K8s clientset", err)
+	// Configuration custom resources are merged on top of the configuration files, so the K8s client must be created first.
+	var crdProvider *intconfig.CRDProvider
+	if conf.ConfigWatcher.CRD.Enabled && !remoteCfgEnabled {
+		dynamicCli, err := dynamic.NewForConfig(kubeConfig)
+		if err != nil {
+			return reportFatalError("while creating K8s dynamic client", err)
+		}
+		crdProvider = intconfig.NewCRDProvider(logger.WithField(componentLogFieldKey, "CRD Config Provider"), dynamicCli, cfgProvider, conf.ConfigWatcher.CRD)
+		cfgProvider = crdProvider
+
+		configs, cfgVersion, err = cfgProvider.Configs(ctx)
+		if err != nil {
+			return reportFatalError("while loading configuration custom resources", err)
+		}
+		conf, confDetails, err = config.LoadWithDefaults(configs)
+		if err != nil {
+			return reportFatalError("while merging configuration custom resources", err)
+		}
+		if confDetails.ValidateWarnings != nil {
+			logger.Warnf("Configuration validation warnings: %v", confDetails.ValidateWarnings.Error())
+		}
 	}
 	botkubeVersion, k8sVer, err := findVersions(k8sCli)
 	if err = statusReporter.ReportDeploymentConnectionInit(ctx, k8sVer); err != nil {
@@ -151,7 +194,10 @@ func run(ctx context.Context) (err error) {
 
 	statusReporter.SetLogger(logger)
 	statusReporter.SetResourceVersion(cfgVersion)
-	auditReporter := audit.GetReporter(remoteCfgEnabled, logger, gqlClient)
+	auditReporter, auditStore, err := audit.GetReporter(ctx, remoteCfgEnabled, logger, gqlClient, conf.Settings.Audit, conf.Settings.ClusterName)
+	if err != nil {
+		return reportFatalError("while creating audit reporter", err)
+	}
 
 	ctx, cancel := context.WithCancel(ctx)
 	errGroup, ctx := errgroup.WithContext(ctx)
@@ -209,6 +255,11 @@ func run(ctx context.Context) (err error) {
 	cmdGuard := command.NewCommandGuard(logger.WithField(componentLogFieldKey, "Command Guard"), discoveryCli)
 	// Create executor factory
 	cfgManager := config.NewManager(remoteCfgEnabled, logger.WithField(componentLogFieldKey, "Config manager"), conf.Settings.PersistentConfig, cfgVersion, k8sCli, gqlClient, deployClient)
+	silenceStore := silence.NewStore(cfgManager)
+	if err := silenceStore.Load(ctx); err != nil {
+		logger.Errorf("Failed to load persisted silences. Starting without them: %s", err.Error())
+	}
+
 	executorFactory, err := execute.NewExecutorFactory(
 		execute.DefaultExecutorFactoryParams{
 			Log:               logger.WithField(componentLogFieldKey, "Executor"),
@@ -220,7 +271,9 @@ func run(ctx context.Context) (err error) {
 			BotKubeVersion:    botkubeVersion,
 			RestCfg:           kubeConfig,
 			AuditReporter:     auditReporter,
+			AuditStore:        auditStore,
 			PluginHealthStats: pluginHealthStats,
+			SilenceStore:      silenceStore,
 		},
 	)
 
@@ -228,92 +281,11 @@ func run(ctx context.Context) (err error) {
 		return reportFatalError("while creating executor factory", err)
 	}
 
-	var (
-		sinkNotifiers []notifier.Sink
-		bots          = map[string]bot.Bot{}
-	)
-
-	// TODO: Current limitation: Communication platform config should be separate inside every group:
-	//    For example, if in both communication groups there's a Slack configuration pointing to the same workspace,
-	//	  when user executes `kubectl` command, one Bot instance will execute the command and return response,
-	//	  and the second "Sorry, this channel is not authorized to execute kubectl command" error.
-	for commGroupName, commGroupCfg := range conf.Communications {
-		commGroupLogger := logger.WithField(commGroupFieldKey, commGroupName)
-
-		scheduleBotNotifier := func(in bot.Bot) {
-			bots[fmt.Sprintf("%s-%s", commGroupName, in.IntegrationName())] = in
-			errGroup.Go(func() error {
-				defer analytics.ReportPanicIfOccurs(commGroupLogger, reporter)
-				return in.Start(ctx)
-			})
-		}
-
-		// Run bots
-		if commGroupCfg.Slack.Enabled {
-			sb, err := bot.NewSlack(commGroupLogger.WithField(botLogFieldKey, "Slack"), commGroupName, commGroupCfg.Slack, executorFactory, reporter)
-			if err != nil {
-				return reportFatalError("while creating Slack bot", err)
-			}
-			scheduleBotNotifier(sb)
-		}
-
-		if commGroupCfg.SocketSlack.Enabled {
-			sb, err := bot.NewSocketSlack(commGroupLogger.WithField(botLogFieldKey, "SocketSlack"), commGroupName, commGroupCfg.SocketSlack, executorFactory, reporter)
-			if err != nil {
-				return reportFatalError("while creating SocketSlack bot", err)
-			}
-			scheduleBotNotifier(sb)
-		}
-
-		if commGroupCfg.CloudSlack.Enabled {
-			sb, err := bot.NewCloudSlack(commGroupLogger.WithField(botLogFieldKey, "CloudSlack"), commGroupName, commGroupCfg.CloudSlack, conf.Settings.ClusterName, executorFactory, reporter)
-			if err != nil {
-				return reportFatalError("while creating CloudSlack bot", err)
-			}
-			scheduleBotNotifier(sb)
-		}
-
-		if commGroupCfg.Mattermost.Enabled {
-			mb, err := bot.NewMattermost(ctx, commGroupLogger.WithField(botLogFieldKey, "Mattermost"), commGroupName, commGroupCfg.Mattermost, executorFactory, reporter)
-			if err != nil {
-				return reportFatalError("while creating Mattermost bot", err)
-			}
-			scheduleBotNotifier(mb)
-		}
-
-		if commGroupCfg.Teams.Enabled {
-			tb, err := bot.NewTeams(commGroupLogger.WithField(botLogFieldKey, "MS Teams"), commGroupName, commGroupCfg.Teams, conf.Settings.ClusterName, executorFactory, reporter)
-			if err != nil {
-				return reportFatalError("while creating Teams bot", err)
-			}
-			scheduleBotNotifier(tb)
-		}
-
-		if commGroupCfg.Discord.Enabled {
-			db, err := bot.NewDiscord(commGroupLogger.WithField(botLogFieldKey, "Discord"), commGroupName, commGroupCfg.Discord, executorFactory, reporter)
-			if err != nil {
-				return reportFatalError("while creating Discord bot", err)
-			}
-			scheduleBotNotifier(db)
-		}
-
-		// Run sinks
-		if commGroupCfg.Elasticsearch.Enabled {
-			es, err := sink.NewElasticsearch(commGroupLogger.WithField(sinkLogFieldKey, "Elasticsearch"), commGroupCfg.Elasticsearch, reporter)
-			if err != nil {
-				return reportFatalError("while creating Elasticsearch sink", err)
-			}
-			sinkNotifiers = append(sinkNotifiers, es)
-		}
-
-		if commGroupCfg.Webhook.Enabled {
-			wh, err := sink.NewWebhook(commGroupLogger.WithField(sinkLogFieldKey, "Webhook"), commGroupCfg.Webhook, reporter)
-			if err != nil {
-				return reportFatalError("while creating Webhook sink", err)
-			}
-
-			sinkNotifiers = append(sinkNotifiers, wh)
-		}
+	sinkDeadLetters := sink.NewDeadLetterStore(conf.Settings.SinkDelivery.DeadLetter, k8sCli)
+	notifiers := newNotifierManager(ctx, logger, errGroup, reporter, executorFactory, cfgManager, conf.Settings.ClusterName, conf.Settings.SinkDelivery, sinkDeadLetters)
+	bots, sinkNotifiers, err := notifiers.Start(conf.Communications)
+	if err != nil {
+		return reportFatalError("while starting notifiers", err)
 	}
 
 	// TODO(https://github.com/kubeshop/botkube/issues/1011): Move restarter under `if conf.ConfigWatcher.Enabled {`
@@ -323,20 +295,29 @@ func run(ctx context.Context) (err error) {
 		conf.ConfigWatcher.Deployment,
 		conf.Settings.ClusterName,
 		func(msg string) error {
-			return notifier.SendPlaintextMessage(ctx, bot.AsNotifiers(bots), msg)
+			return notifier.SendPlaintextMessage(ctx, bot.AsNotifiers(notifiers.Bots()), msg)
 		},
 	)
+	// Changes of aliases, actions, executors, sources, communications and routing rules are applied in-process, all other ones restart the app.
+	hotReloader := reloader.NewHotReloader(logger.WithField(componentLogFieldKey, "Hot Reloader"), restarter)
+	hotReloader.Register(reloader.AliasesSubsystem, func(_ context.Context, cfg config.Config) error {
+		executorFactory.SetAliases(cfg.Aliases)
+		return nil
+	})
+	var remoteCfgReloader *reloader.RemoteConfigReloader
 	if conf.ConfigWatcher.Enabled {
 		cfgReloader := reloader.Get(
 			remoteCfgEnabled,
 			logger.WithField(componentLogFieldKey, "Config Updater"),
 			deployClient,
-			restarter,
+			valueRefProvider,
+			hotReloader,
 			*conf,
 			cfgVersion,
 			statusReporter,
 			cfgManager,
 		)
+		remoteCfgReloader, _ = cfgReloader.(*reloader.RemoteConfigReloader)
 		errGroup.Go(func() error {
 			defer analytics.ReportPanicIfOccurs(logger, reporter)
 			return cfgReloader.Do(ctx)
@@ -360,11 +341,55 @@ func run(ctx context.Context) (err error) {
 
 	// TODO(https://github.com/kubeshop/botkube/issues/1011): Remove once we migrate to ConfigMap-based config reloader
 	// Lifecycle server
+	var fileCfgReloader *reloader.FileConfigReloader
+	if !remoteCfgEnabled {
+		fileCfgReloader = reloader.NewFileConfigReloader(
+			logger.WithField(componentLogFieldKey, "File Config Reloader"),
+			cfgProvider,
+			hotReloader,
+			*conf,
+		)
+	}
+	if crdProvider != nil {
+		errGroup.Go(func() error {
+			defer analytics.ReportPanicIfOccurs(logger, reporter)
+			return crdProvider.Watch(ctx, func(ctx context.Context) {
+				if err := fileCfgReloader.Do(ctx); err != nil {
+					logger.Errorf("while reloading configuration custom resources: %s", err.Error())
+				}
+			})
+		})
+	}
+	if fileCfgReloader != nil {
+		errGroup.Go(func() error {
+			defer analytics.ReportPanicIfOccurs(logger, reporter)
+			return valueRefProvider.Watch(ctx, conf.ConfigWatcher.ValueRefs.PollInterval, func(ctx context.Context) {
+				if err := fileCfgReloader.Do(ctx); err != nil {
+					logger.Errorf("while reloading referenced configuration values: %s", err.Error())
+				}
+			})
+		})
+	}
+	if remoteCfgReloader != nil {
+		errGroup.Go(func() error {
+			defer analytics.ReportPanicIfOccurs(logger, reporter)
+			return valueRefProvider.Watch(ctx, conf.ConfigWatcher.ValueRefs.PollInterval, func(ctx context.Context) {
+				if err := remoteCfgReloader.Reload(ctx); err != nil {
+					logger.Errorf("while reloading referenced configuration values: %s", err.Error())
+				}
+			})
+		})
+	}
+
 	if conf.Settings.LifecycleServer.Enabled {
+		var lifecycleReloader lifecycle.Restarter = restarter
+		if fileCfgReloader != nil {
+			lifecycleReloader = fileCfgReloader
+		}
 		lifecycleSrv := lifecycle.NewServer(
 			logger.WithField(componentLogFieldKey, "Lifecycle server"),
 			conf.Settings.LifecycleServer,
-			restarter,
+			lifecycleReloader,
 		)
 		errGroup.Go(func() error {
 			defer analytics.ReportPanicIfOccurs(logger, reporter)
@@ -396,20 +421,61 @@ func run(ctx context.Context) (err error) {
 	}
 
 	actionProvider := action.NewProvider(logger.WithField(componentLogFieldKey, "Action Provider"), conf.Actions, executorFactory)
+	router := source.NewRouter(conf.Routing)
+	hotReloader.Register(reloader.RoutingSubsystem, func(_ context.Context, cfg config.Config) error {
+		router.SetRouting(cfg.Routing)
+		return nil
+	})
 
-	sourcePluginDispatcher := source.NewDispatcher(logger, conf.Settings.ClusterName, bots, sinkNotifiers, pluginManager, actionProvider, reporter, auditReporter, kubeConfig)
+	sourcePluginDispatcher := source.NewDispatcher(logger, conf.Settings.ClusterName, bots, sinkNotifiers, pluginManager, actionProvider, reporter, auditReporter, kubeConfig, silenceStore, router)
 	scheduler := source.NewScheduler(ctx, logger, conf, sourcePluginDispatcher, schedulerChan)
 	err = scheduler.Start(ctx)
 	if err != nil {
 		return fmt.Errorf("while starting source plugin event dispatcher: %w", err)
 	}
 
+	// Plugins are started and stopped in-process. Only changed plugin versions require a restart.
+	// Reload callbacks may be called with short-lived contexts, so the root one is used for started plugins.
+	reloadPlugins := func(cfg config.Config) error {
+		executors, sources := collector.GetAllEnabledAndUsedPlugins(&cfg)
+		err := pluginManager.Reload(ctx, executors, sources)
+		switch {
+		case errors.Is(err, plugin.ErrPluginVersionChanged):
+			return fmt.Errorf("%w: %s", reloader.ErrRestartRequired, err.Error())
+		case err != nil:
+			return fmt.Errorf("while reloading plugins: %w", err)
+		}
+		return scheduler.Reload(ctx, &cfg)
+	}
+	hotReloader.Register(reloader.ActionsSubsystem, func(_ context.Context, cfg config.Config) error {
+		actionProvider.SetActions(cfg.Actions)
+		executorFactory.SetActions(cfg.Actions)
+		return reloadPlugins(cfg)
+	})
+	hotReloader.Register(reloader.ExecutorsSubsystem, func(_ context.Context, cfg config.Config) error {
+		if err := reloadPlugins(cfg); err != nil {
+			return err
+		}
+		return executorFactory.SetExecutors(cfg.Executors)
+	})
+	hotReloader.Register(reloader.SourcesSubsystem, func(_ context.Context, cfg config.Config) error {
+		return reloadPlugins(cfg)
+	})
+	hotReloader.Register(reloader.CommunicationsSubsystem, func(_ context.Context, cfg config.Config) error {
+		newBots, newSinks, err := notifiers.Reload(cfg.Communications)
+		if err != nil {
+			return err
+		}
+		sourcePluginDispatcher.AddNotifiers(newBots, newSinks)
+		return reloadPlugins(cfg)
+	})
+
 	if conf.Plugins.IncomingWebhook.Enabled {
 		incomingWebhookSrv := source.NewIncomingWebhookServer(
 			logger.WithField(componentLogFieldKey, "Incoming Webhook Server"),
 			conf,
 			sourcePluginDispatcher,
-			scheduler.StartedSourcePlugins(),
+			scheduler,
 		)
 
 		errGroup.Go(func() error {
@@ -0,0 +1,325 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/kubeshop/botkube/internal/analytics"
+	"github.com/kubeshop/botkube/internal/config/reloader"
+	"github.com/kubeshop/botkube/pkg/bot"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/execute"
+	"github.com/kubeshop/botkube/pkg/notifier"
+	"github.com/kubeshop/botkube/pkg/sink"
+)
+
+// notifierManager creates and starts bots and sinks for communication groups. Once the configuration is reloaded,
+// it updates channel bindings of the running bots and starts newly enabled bots and sinks.
+type notifierManager struct {
+	ctx             context.Context
+	log             logrus.FieldLogger
+	errGroup        *errgroup.Group
+	reporter        analytics.Reporter
+	executorFactory *execute.DefaultExecutorFactory
+	cfgManager      config.PersistenceManager
+	clusterName     string
+	sinkDelivery    config.SinkDelivery
+	deadLetters     sink.DeadLetterStore
+
+	mu           sync.RWMutex
+	cfg          map[string]config.Communications
+	bots         map[string]bot.Bot
+	botsByGroups map[string][]bot.Bot
+}
+
+func newNotifierManager(ctx context.Context, log logrus.FieldLogger, errGroup *errgroup.Group, reporter analytics.Reporter, executorFactory *execute.DefaultExecutorFactory, cfgManager config.PersistenceManager, clusterName string, sinkDelivery config.SinkDelivery, deadLetters sink.DeadLetterStore) *notifierManager {
+	return &notifierManager{
+		ctx:             ctx,
+		log:             log,
+		errGroup:        errGroup,
+		reporter:        reporter,
+		executorFactory: executorFactory,
+		cfgManager:      cfgManager,
+		clusterName:     clusterName,
+		sinkDelivery:    sinkDelivery,
+		deadLetters:     deadLetters,
+		cfg:             map[string]config.Communications{},
+		bots:            map[string]bot.Bot{},
+		botsByGroups:    map[string][]bot.Bot{},
+	}
+}
+
+// Bots returns all started bots. The returned map is not modified once bots are added.
+func (m *notifierManager) Bots() map[string]bot.Bot {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.bots
+}
+
+// Start starts bots and sinks for all communication groups. Sinks are returned by communication group names.
+func (m *notifierManager) Start(cfg map[string]config.Communications) (map[string]bot.Bot, map[string][]notifier.Sink, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.startAll(cfg)
+}
+
+// Reload updates channel bindings of the running bots and starts bots and sinks which are newly enabled.
+// It returns reloader.ErrRestartRequired for other changes, such as disabled platforms or changed credentials.
+func (m *notifierManager) Reload(cfg map[string]config.Communications) (map[string]bot.Bot, map[string][]notifier.Sink, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// all changes are checked upfront, so they are not applied partially
+	toStart := map[string]config.Communications{}
+	for commGroupName, updated := range cfg {
+		current, found := m.cfg[commGroupName]
+		if !found {
+			toStart[commGroupName] = updated
+			continue
+		}
+
+		newlyEnabled, err := newlyEnabledPlatforms(current, updated)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: communication group %q: %s", reloader.ErrRestartRequired, commGroupName, err.Error())
+		}
+		toStart[commGroupName] = newlyEnabled
+	}
+	for commGroupName := range m.cfg {
+		if _, found := cfg[commGroupName]; !found {
+			return nil, nil, fmt.Errorf("%w: communication group %q was removed", reloader.ErrRestartRequired, commGroupName)
+		}
+	}
+
+	for commGroupName, bots := range m.botsByGroups {
+		for _, b := range bots {
+			if updater, ok := b.(bot.BindingsUpdater); ok {
+				updater.UpdateBindings(cfg[commGroupName])
+			}
+		}
+	}
+
+	bots, sinks, err := m.startAll(toStart)
+	if err != nil {
+		return nil, nil, err
+	}
+	// only bindings of the running bots were applied, so the other settings are kept as they are
+	for commGroupName, updated := range cfg {
+		m.cfg[commGroupName] = updated
+	}
+	return bots, sinks, nil
+}
+
+func (m *notifierManager) startAll(cfg map[string]config.Communications) (map[string]bot.Bot, map[string][]notifier.Sink, error) {
+	var (
+		startedBots  = map[string]bot.Bot{}
+		startedSinks = map[string][]notifier.Sink{}
+	)
+	for commGroupName, commGroupCfg := range cfg {
+		bots, sinks, err := m.startCommGroup(commGroupName, commGroupCfg)
+		if err != nil {
+			return nil, nil, err
+		}
+		for key, b := range bots {
+			startedBots[key] = b
+		}
+		if len(sinks) > 0 {
+			startedSinks[commGroupName] = sinks
+		}
+
+		// the map returned by Bots is replaced instead of modified, as it's used by other components
+		allBots := make(map[string]bot.Bot, len(m.bots)+len(bots))
+		for key, b := range m.bots {
+			allBots[key] = b
+		}
+		for key, b := range bots {
+			allBots[key] = b
+			m.botsByGroups[commGroupName] = append(m.botsByGroups[commGroupName], b)
+		}
+		m.bots = allBots
+
+		if _, found := m.cfg[commGroupName]; !found {
+			m.cfg[commGroupName] = commGroupCfg
+		}
+	}
+	return startedBots, startedSinks, nil
+}
+
+// TODO: Current limitation: Communication platform config should be separate inside every group:
+//
+//	   For example, if in both communication groups there's a Slack configuration pointing to the same workspace,
+//		  when user executes `kubectl` command, one Bot instance will execute the command and return response,
+//		  and the second "Sorry, this channel is not authorized to execute kubectl command" error.
+func (m *notifierManager) startCommGroup(commGroupName string, commGroupCfg config.Communications) (map[string]bot.Bot, []notifier.Sink, error) {
+	var (
+		ctx             = m.ctx
+		commGroupLogger = m.log.WithField(commGroupFieldKey, commGroupName)
+		bots            = map[string]bot.Bot{}
+		sinkNotifiers   []notifier.Sink
+	)
+
+	scheduleBotNotifier := func(in bot.Bot) {
+		bots[fmt.Sprintf("%s-%s", commGroupName, in.IntegrationName())] = in
+		m.errGroup.Go(func() error {
+			defer analytics.ReportPanicIfOccurs(commGroupLogger, m.reporter)
+			return in.Start(ctx)
+		})
+	}
+
+	// Run bots
+	if commGroupCfg.Slack.Enabled {
+		sb, err := bot.NewSlack(commGroupLogger.WithField(botLogFieldKey, "Slack"), commGroupName, commGroupCfg.Slack, m.executorFactory, m.reporter)
+		if err != nil {
+			return nil, nil, fmt.Errorf("while creating Slack bot: %w", err)
+		}
+		scheduleBotNotifier(sb)
+	}
+
+	if commGroupCfg.SocketSlack.Enabled {
+		sb, err := bot.NewSocketSlack(commGroupLogger.WithField(botLogFieldKey, "SocketSlack"), commGroupName, commGroupCfg.SocketSlack, m.executorFactory, m.reporter, m.cfgManager)
+		if err != nil {
+			return nil, nil, fmt.Errorf("while creating SocketSlack bot: %w", err)
+		}
+		scheduleBotNotifier(sb)
+	}
+
+	if commGroupCfg.CloudSlack.Enabled {
+		sb, err := bot.NewCloudSlack(commGroupLogger.WithField(botLogFieldKey, "CloudSlack"), commGroupName, commGroupCfg.CloudSlack, m.clusterName, m.executorFactory, m.reporter, m.cfgManager)
+		if err != nil {
+			return nil, nil, fmt.Errorf("while creating CloudSlack bot: %w", err)
+		}
+		scheduleBotNotifier(sb)
+	}
+
+	if commGroupCfg.Mattermost.Enabled {
+		mb, err := bot.NewMattermost(ctx, commGroupLogger.WithField(botLogFieldKey, "Mattermost"), commGroupName, commGroupCfg.Mattermost, m.executorFactory, m.reporter, m.cfgManager)
+		if err != nil {
+			return nil, nil, fmt.Errorf("while creating Mattermost bot: %w", err)
+		}
+		scheduleBotNotifier(mb)
+	}
+
+	if commGroupCfg.Teams.Enabled {
+		tb, err := bot.NewTeams(commGroupLogger.WithField(botLogFieldKey, "MS Teams"), commGroupName, commGroupCfg.Teams, m.clusterName, m.executorFactory, m.reporter, m.cfgManager)
+		if err != nil {
+			return nil, nil, fmt.Errorf("while creating Teams bot: %w", err)
+		}
+		scheduleBotNotifier(tb)
+	}
+
+	if commGroupCfg.Discord.Enabled {
+		db, err := bot.NewDiscord(commGroupLogger.WithField(botLogFieldKey, "Discord"), commGroupName, commGroupCfg.Discord, m.executorFactory, m.reporter, m.cfgManager)
+		if err != nil {
+			return nil, nil, fmt.Errorf("while creating Discord bot: %w", err)
+		}
+		scheduleBotNotifier(db)
+	}
+
+	scheduleSinkNotifier := func(in sink.Sink, logger logrus.FieldLogger) error {
+		queue, err := sink.NewQueue(logger, fmt.Sprintf("%s-%s", commGroupName, in.IntegrationName()), in, m.sinkDelivery, m.deadLetters)
+		if err != nil {
+			return err
+		}
+		sinkNotifiers = append(sinkNotifiers, queue)
+		m.errGroup.Go(func() error {
+			defer analytics.ReportPanicIfOccurs(logger, m.reporter)
+			return queue.Start(ctx)
+		})
+		return nil
+	}
+
+	// Run sinks
+	if commGroupCfg.Elasticsearch.Enabled {
+		sinkLogger := commGroupLogger.WithField(sinkLogFieldKey, "Elasticsearch")
+		es, err := sink.NewElasticsearch(sinkLogger, commGroupCfg.Elasticsearch, m.reporter)
+		if err != nil {
+			return nil, nil, fmt.Errorf("while creating Elasticsearch sink: %w", err)
+		}
+		if err := scheduleSinkNotifier(es, sinkLogger); err != nil {
+			return nil, nil, fmt.Errorf("while creating Elasticsearch sink queue: %w", err)
+		}
+	}
+
+	if commGroupCfg.Webhook.Enabled {
+		sinkLogger := commGroupLogger.WithField(sinkLogFieldKey, "Webhook")
+		wh, err := sink.NewWebhook(sinkLogger, commGroupCfg.Webhook, m.reporter)
+		if err != nil {
+			return nil, nil, fmt.Errorf("while creating Webhook sink: %w", err)
+		}
+		if err := scheduleSinkNotifier(wh, sinkLogger); err != nil {
+			return nil, nil, fmt.Errorf("while creating Webhook sink queue: %w", err)
+		}
+	}
+
+	return bots, sinkNotifiers, nil
+}
+
+// newlyEnabledPlatforms returns the configuration with only the platforms which are enabled in the updated configuration,
+// but were disabled before. It returns an error if any running platform is disabled, or its settings other than
+// channel bindings changed, as running bots and sinks cannot be reconfigured.
+func newlyEnabledPlatforms(current, updated config.Communications) (config.Communications, error) {
+	var (
+		out          config.Communications
+		currentBase  = current.WithoutBotBindings()
+		updatedBase  = updated.WithoutBotBindings()
+		start        bool
+		err          error
+		platformErrs []error
+	)
+	check := func(platform string, currentEnabled, updatedEnabled bool, currentCfg, updatedCfg any) bool {
+		start, err = platformChange(platform, currentEnabled, updatedEnabled, currentCfg, updatedCfg)
+		if err != nil {
+			platformErrs = append(platformErrs, err)
+		}
+		return start
+	}
+
+	if check("Slack", current.Slack.Enabled, updated.Slack.Enabled, currentBase.Slack, updatedBase.Slack) {
+		out.Slack = updated.Slack
+	}
+	if check("SocketSlack", current.SocketSlack.Enabled, updated.SocketSlack.Enabled, currentBase.SocketSlack, updatedBase.SocketSlack) {
+		out.SocketSlack = updated.SocketSlack
+	}
+	if check("CloudSlack", current.CloudSlack.Enabled, updated.CloudSlack.Enabled, currentBase.CloudSlack, updatedBase.CloudSlack) {
+		out.CloudSlack = updated.CloudSlack
+	}
+	if check("Mattermost", current.Mattermost.Enabled, updated.Mattermost.Enabled, currentBase.Mattermost, updatedBase.Mattermost) {
+		out.Mattermost = updated.Mattermost
+	}
+	if check("Discord", current.Discord.Enabled, updated.Discord.Enabled, currentBase.Discord, updatedBase.Discord) {
+		out.Discord = updated.Discord
+	}
+	if check("Teams", current.Teams.Enabled, updated.Teams.Enabled, currentBase.Teams, updatedBase.Teams) {
+		out.Teams = updated.Teams
+	}
+	if check("Webhook", current.Webhook.Enabled, updated.Webhook.Enabled, current.Webhook, updated.Webhook) {
+		out.Webhook = updated.Webhook
+	}
+	if check("Elasticsearch", current.Elasticsearch.Enabled, updated.Elasticsearch.Enabled, current.Elasticsearch, updated.Elasticsearch) {
+		out.Elasticsearch = updated.Elasticsearch
+	}
+
+	if len(platformErrs) > 0 {
+		return config.Communications{}, platformErrs[0]
+	}
+	return out, nil
+}
+
+// platformChange returns true if a given platform should be started.
+func platformChange(platform string, currentEnabled, updatedEnabled bool, currentCfg, updatedCfg any) (bool, error) {
+	switch {
+	case !currentEnabled && updatedEnabled:
+		return true, nil
+	case currentEnabled && !updatedEnabled:
+		return false, fmt.Errorf("%s was disabled", platform)
+	case currentEnabled && !reflect.DeepEqual(currentCfg, updatedCfg):
+		return false, fmt.Errorf("%s settings other than channel bindings changed", platform)
+	default:
+		return false, nil
+	}
+}
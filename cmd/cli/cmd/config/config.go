@@ -12,6 +12,7 @@ func NewCmd() *cobra.Command {
 
 	root.AddCommand(
 		NewGet(),
+		NewValidate(),
 	)
 	return root
 }
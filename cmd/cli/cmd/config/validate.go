@@ -0,0 +1,61 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kubeshop/botkube/internal/cli"
+	"github.com/kubeshop/botkube/internal/cli/config"
+	"github.com/kubeshop/botkube/internal/cli/heredoc"
+)
+
+// NewValidate returns a cobra.Command for validating Botkube configuration files.
+func NewValidate() *cobra.Command {
+	var opts config.ValidateOptions
+
+	cmd := &cobra.Command{
+		Use:   "validate",
+		Short: "Validates Botkube configuration files without connecting to a cluster",
+		Long: heredoc.WithCLIName(`
+			Validates Botkube configuration files in the same way as Botkube does on startup.
+			Files are merged in the given order. Plugin configurations are validated against JSON schemas
+			from plugin repository indexes, which are read from local files or the plugins cache directory.
+
+			The command exits with a non-zero code if any critical issue is found, so it can be used in CI pipelines.
+		`, cli.Name),
+		Example: heredoc.WithCLIName(`
+			# Validate Helm chart values
+			<cli> config validate -f values.yaml -f extra.yaml
+
+			# Validate plugin configuration against a local plugin index
+			<cli> config validate -f values.yaml --plugin-index botkube=./plugins-index.yaml
+		`, cli.Name),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			report, err := config.Validate(opts)
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			for _, issue := range report.Issues {
+				fmt.Fprintln(out, issue.String())
+			}
+
+			criticals, warnings := report.Count(config.SeverityCritical), report.Count(config.SeverityWarning)
+			if report.HasCriticals() {
+				return fmt.Errorf("found %d critical issue(s) and %d warning(s)", criticals, warnings)
+			}
+
+			fmt.Fprintf(out, "Configuration is valid (%d warning(s))\n", warnings)
+			return nil
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringSliceVarP(&opts.Files, "file", "f", nil, "Configuration files to validate, merged in the given order")
+	flags.StringToStringVar(&opts.PluginIndexes, "plugin-index", nil, "Local plugin repository index files, in the repository=path format")
+	flags.StringVar(&opts.PluginsCacheDir, "plugins-cache-dir", "", "Directory with cached plugin indexes. Defaults to the plugins.cacheDir configuration property")
+
+	return cmd
+}
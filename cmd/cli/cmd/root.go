@@ -48,6 +48,7 @@ func NewRoot() *cobra.Command {
 		NewDocs(),
 		NewInstall(),
 		NewUninstall(),
+		NewSimulate(),
 		config.NewCmd(),
 		extension.NewVersionCobraCmd(
 			extension.WithUpgradeNotice(orgName, repoName),
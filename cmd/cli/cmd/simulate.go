@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/kubeshop/botkube/internal/cli"
+	"github.com/kubeshop/botkube/internal/cli/heredoc"
+	"github.com/kubeshop/botkube/internal/cli/simulate"
+	"github.com/kubeshop/botkube/internal/loggerx"
+)
+
+// NewSimulate returns a cobra.Command for previewing notifications for recorded Kubernetes objects.
+func NewSimulate() *cobra.Command {
+	var opts simulate.Options
+
+	simulateCmd := &cobra.Command{
+		Use:   "simulate",
+		Short: "Previews notifications for a recorded Kubernetes object without a cluster",
+		Long: heredoc.WithCLIName(`
+			Feeds a recorded Kubernetes object or Event through the Kubernetes sources defined in the configuration.
+			For each source, it prints the channels and sinks which would receive the event, the rendered automated actions,
+			and the message rendered for each communication platform.
+
+			Objects are not fetched from the cluster, so the enrichment with related objects and logs is skipped.
+		`, cli.Name),
+		Example: heredoc.WithCLIName(`
+			# Preview notifications for a created Pod
+			<cli> simulate -f values.yaml --object pod.yaml
+
+			# Preview notifications for a Warning Event
+			<cli> simulate -f values.yaml --object event.yaml
+
+			# Preview notifications for an updated Deployment
+			<cli> simulate -f values.yaml --object deploy.yaml --old-object old-deploy.yaml --event-type update
+		`, cli.Name),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			log := loggerx.NewNoop()
+			if cli.VerboseMode.IsEnabled() {
+				logger := logrus.New()
+				logger.SetOutput(os.Stderr)
+				logger.SetLevel(logrus.DebugLevel)
+				log = logger
+			}
+
+			reports, err := simulate.Run(cmd.Context(), log, opts)
+			if err != nil {
+				return err
+			}
+			simulate.Print(cmd.OutOrStdout(), reports)
+			return nil
+		},
+	}
+
+	flags := simulateCmd.Flags()
+	flags.StringSliceVarP(&opts.ConfigFiles, "file", "f", nil, "Configuration files, merged in the given order")
+	flags.StringVar(&opts.ObjectFile, "object", "", "Recorded Kubernetes object or Event in YAML or JSON format")
+	flags.StringVar(&opts.OldObjectFile, "old-object", "", "Previous state of the object, used for update events")
+	flags.StringVar(&opts.EventType, "event-type", "", "Event type: create, update, delete, or error. Defaults to error for Events and create for other objects")
+	flags.StringVar(&opts.BotName, "bot-name", "@Botkube", "Bot name used in rendered messages and actions")
+
+	_ = simulateCmd.MarkFlagRequired("file")
+	_ = simulateCmd.MarkFlagRequired("object")
+
+	return simulateCmd
+}
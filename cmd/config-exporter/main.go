@@ -25,7 +25,13 @@ func main() {
 	if err != nil {
 		panic(err)
 	}
-	conf, _, err := config.LoadWithDefaults(files)
+	// referenced values, such as Secrets, are not resolved, so they are never exported
+	files, err = cfginternal.RedactValueRefs(files)
+	if err != nil {
+		panic(err)
+	}
+	// the redacted values may not pass the validation, e.g. of the Slack token format
+	conf, err := config.MergeWithDefaults(files)
 	if err != nil {
 		panic(err)
 	}
@@ -0,0 +1,133 @@
+package audit
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	remoteapi "github.com/kubeshop/botkube/internal/remote"
+)
+
+// EntryType is the type of audit entries.
+type EntryType string
+
+const (
+	// ExecutorEntryType is the executor audit entry type.
+	ExecutorEntryType EntryType = "executor"
+	// SourceEntryType is the source audit entry type.
+	SourceEntryType EntryType = "source"
+	// ApprovalEntryType is the approval audit entry type.
+	ApprovalEntryType EntryType = "approval"
+)
+
+// Entry is a single audit entry written by local reporters. It is flat, so it can be easily processed by log aggregators.
+type Entry struct {
+	Type        EntryType `json:"type"`
+	CreatedAt   string    `json:"createdAt"`
+	ClusterName string    `json:"clusterName,omitempty"`
+	PluginName  string    `json:"pluginName,omitempty"`
+
+	// Executor and approval entries.
+	PlatformUser string        `json:"platformUser,omitempty"`
+	BotPlatform  string        `json:"botPlatform,omitempty"`
+	Channel      string        `json:"channel,omitempty"`
+	Command      string        `json:"command,omitempty"`
+	Status       CommandStatus `json:"status,omitempty"`
+	DurationMs   int64         `json:"durationMs,omitempty"`
+
+	// Source entries.
+	Event             string `json:"event,omitempty"`
+	SourceName        string `json:"sourceName,omitempty"`
+	SourceDisplayName string `json:"sourceDisplayName,omitempty"`
+
+	// Approval entries. PlatformUser holds the user who requested the command.
+	ApprovalRequestID string           `json:"approvalRequestId,omitempty"`
+	ApprovalDecision  ApprovalDecision `json:"approvalDecision,omitempty"`
+	DecidedBy         string           `json:"decidedBy,omitempty"`
+}
+
+// Query describes which audit entries should be returned.
+type Query struct {
+	// User filters entries by the platform user who ran or approved the command. Case-insensitive.
+	User string
+	// Channel filters entries by the channel where the command was run. Empty means all channels.
+	Channel string
+	// Since filters out entries created before a given time.
+	Since time.Time
+	// Limit is the maximum number of the latest entries returned. Zero means no limit.
+	Limit int
+}
+
+// LocalStore queries locally stored audit entries.
+type LocalStore interface {
+	Query(ctx context.Context, q Query) ([]Entry, error)
+}
+
+// Matches returns true if a given entry matches the query.
+func (q Query) Matches(e Entry) bool {
+	if q.User != "" && !strings.EqualFold(q.User, e.PlatformUser) && !strings.EqualFold(q.User, e.DecidedBy) {
+		return false
+	}
+
+	if q.Channel != "" && q.Channel != e.Channel {
+		return false
+	}
+
+	if !q.Since.IsZero() {
+		createdAt, err := time.Parse(time.RFC3339, e.CreatedAt)
+		if err != nil || createdAt.Before(q.Since) {
+			return false
+		}
+	}
+	return true
+}
+
+func newExecutorEntry(e ExecutorAuditEvent, clusterName string) Entry {
+	return Entry{
+		Type:         ExecutorEntryType,
+		CreatedAt:    e.CreatedAt,
+		ClusterName:  clusterName,
+		PluginName:   e.PluginName,
+		PlatformUser: e.PlatformUser,
+		BotPlatform:  botPlatform(e.BotPlatform),
+		Channel:      e.Channel,
+		Command:      e.Command,
+		Status:       e.Status,
+		DurationMs:   e.Duration.Milliseconds(),
+	}
+}
+
+func newSourceEntry(e SourceAuditEvent, clusterName string) Entry {
+	return Entry{
+		Type:              SourceEntryType,
+		CreatedAt:         e.CreatedAt,
+		ClusterName:       clusterName,
+		PluginName:        e.PluginName,
+		Event:             e.Event,
+		SourceName:        e.Source.Name,
+		SourceDisplayName: e.Source.DisplayName,
+	}
+}
+
+func newApprovalEntry(e ApprovalAuditEvent, clusterName string) Entry {
+	return Entry{
+		Type:              ApprovalEntryType,
+		CreatedAt:         e.CreatedAt,
+		ClusterName:       clusterName,
+		PluginName:        e.PluginName,
+		PlatformUser:      e.RequestedBy,
+		BotPlatform:       botPlatform(e.BotPlatform),
+		Channel:           e.Channel,
+		Command:           e.Command,
+		ApprovalRequestID: e.RequestID,
+		ApprovalDecision:  e.Decision,
+		DecidedBy:         e.DecidedBy,
+	}
+}
+
+func botPlatform(in *remoteapi.BotPlatform) string {
+	if in == nil {
+		return ""
+	}
+	return string(*in)
+}
@@ -0,0 +1,181 @@
+package audit
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+var (
+	_ AuditReporter = (*FileAuditReporter)(nil)
+	_ LocalStore    = (*FileAuditReporter)(nil)
+)
+
+const bytesInMB = 1024 * 1024
+
+// FileAuditReporter appends audit entries to a file, one JSON object per line.
+// Once the file exceeds the max size, it is rotated. Rotated files have the `.1`, `.2`, etc. suffix, the `.1` being the newest one.
+type FileAuditReporter struct {
+	path        string
+	maxSize     int64
+	maxBackups  int
+	clusterName string
+
+	mu sync.Mutex
+}
+
+// NewFileAuditReporter returns a new FileAuditReporter instance.
+func NewFileAuditReporter(cfg config.AuditFile, clusterName string) (*FileAuditReporter, error) {
+	maxSizeMB := cfg.MaxSizeMB
+	if maxSizeMB <= 0 {
+		maxSizeMB = config.DefaultAuditFileMaxSizeMB
+	}
+	maxBackups := cfg.MaxBackups
+	if maxBackups <= 0 {
+		maxBackups = config.DefaultAuditFileMaxBackups
+	}
+
+	path := filepath.Clean(cfg.Path)
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return nil, fmt.Errorf("while creating audit log directory: %w", err)
+	}
+
+	return &FileAuditReporter{
+		path:        path,
+		maxSize:     int64(maxSizeMB) * bytesInMB,
+		maxBackups:  maxBackups,
+		clusterName: clusterName,
+	}, nil
+}
+
+// ReportExecutorAuditEvent appends executor audit event to the file.
+func (r *FileAuditReporter) ReportExecutorAuditEvent(_ context.Context, e ExecutorAuditEvent) error {
+	return r.write(newExecutorEntry(e, r.clusterName))
+}
+
+// ReportSourceAuditEvent appends source audit event to the file.
+func (r *FileAuditReporter) ReportSourceAuditEvent(_ context.Context, e SourceAuditEvent) error {
+	return r.write(newSourceEntry(e, r.clusterName))
+}
+
+// ReportApprovalAuditEvent appends approval audit event to the file.
+func (r *FileAuditReporter) ReportApprovalAuditEvent(_ context.Context, e ApprovalAuditEvent) error {
+	return r.write(newApprovalEntry(e, r.clusterName))
+}
+
+// Query returns executor and approval entries matching a given query, the oldest first. Rotated files are read too.
+func (r *FileAuditReporter) Query(_ context.Context, q Query) ([]Entry, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var out []Entry
+	for i := r.maxBackups; i >= 0; i-- {
+		entries, err := readEntries(r.backupPath(i), q)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, entries...)
+	}
+
+	if q.Limit > 0 && len(out) > q.Limit {
+		out = out[len(out)-q.Limit:]
+	}
+	return out, nil
+}
+
+func (r *FileAuditReporter) write(entry Entry) error {
+	raw, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("while marshaling audit entry: %w", err)
+	}
+	raw = append(raw, '\n')
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.rotateIfNeeded(int64(len(raw))); err != nil {
+		return fmt.Errorf("while rotating audit log: %w", err)
+	}
+
+	file, err := os.OpenFile(r.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("while opening audit log: %w", err)
+	}
+	if _, err := file.Write(raw); err != nil {
+		_ = file.Close()
+		return fmt.Errorf("while writing audit entry: %w", err)
+	}
+	return file.Close()
+}
+
+// rotateIfNeeded rotates files if writing a given number of bytes would exceed the max size. The oldest file is removed.
+func (r *FileAuditReporter) rotateIfNeeded(size int64) error {
+	info, err := os.Stat(r.path)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		return nil
+	case err != nil:
+		return err
+	}
+	if info.Size() == 0 || info.Size()+size <= r.maxSize {
+		return nil
+	}
+
+	if err := os.Remove(r.backupPath(r.maxBackups)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	for i := r.maxBackups - 1; i >= 0; i-- {
+		if err := os.Rename(r.backupPath(i), r.backupPath(i+1)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+	return nil
+}
+
+// backupPath returns path of a given rotated file. Zero means the current file.
+func (r *FileAuditReporter) backupPath(idx int) string {
+	if idx == 0 {
+		return r.path
+	}
+	return fmt.Sprintf("%s.%d", r.path, idx)
+}
+
+func readEntries(path string, q Query) ([]Entry, error) {
+	file, err := os.Open(filepath.Clean(path))
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("while opening %q: %w", path, err)
+	}
+	defer file.Close()
+
+	var out []Entry
+	// bufio.Reader is used instead of bufio.Scanner, as source events may exceed any fixed line limit
+	reader := bufio.NewReader(file)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if len(line) > 0 {
+			var entry Entry
+			// skip lines which were partially written, e.g. when the disk was full
+			if jsonErr := json.Unmarshal(line, &entry); jsonErr == nil && entry.Type != SourceEntryType && q.Matches(entry) {
+				out = append(out, entry)
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("while reading %q: %w", path, err)
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,139 @@
+package audit
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	remoteapi "github.com/kubeshop/botkube/internal/remote"
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+func TestFileAuditReporterQuery(t *testing.T) {
+	// given
+	ctx := context.Background()
+	reporter, err := NewFileAuditReporter(config.AuditFile{Path: filepath.Join(t.TempDir(), "audit", "audit.log")}, "prod")
+	require.NoError(t, err)
+
+	now := time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+	platform := remoteapi.BotPlatformSlack
+	require.NoError(t, reporter.ReportExecutorAuditEvent(ctx, ExecutorAuditEvent{
+		CreatedAt:    now.Add(-2 * time.Hour).Format(time.RFC3339),
+		PlatformUser: "Joe",
+		Command:      "kubectl get pods",
+		Status:       CommandSucceeded,
+	}))
+	require.NoError(t, reporter.ReportSourceAuditEvent(ctx, SourceAuditEvent{
+		CreatedAt: now.Add(-30 * time.Minute).Format(time.RFC3339),
+		Event:     `{"kind": "Pod"}`,
+	}))
+	require.NoError(t, reporter.ReportExecutorAuditEvent(ctx, ExecutorAuditEvent{
+		CreatedAt:    now.Add(-20 * time.Minute).Format(time.RFC3339),
+		PluginName:   "botkube/kubectl",
+		PlatformUser: "Joe",
+		BotPlatform:  &platform,
+		Command:      "kubectl logs nginx",
+		Channel:      "ops",
+		Status:       CommandFailed,
+		Duration:     1500 * time.Millisecond,
+	}))
+	require.NoError(t, reporter.ReportExecutorAuditEvent(ctx, ExecutorAuditEvent{
+		CreatedAt:    now.Add(-10 * time.Minute).Format(time.RFC3339),
+		PlatformUser: "Jane",
+		Command:      "kubectl get nodes",
+	}))
+
+	// when
+	got, err := reporter.Query(ctx, Query{User: "joe", Since: now.Add(-time.Hour)})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []Entry{
+		{
+			Type:         ExecutorEntryType,
+			CreatedAt:    "2023-01-01T11:40:00Z",
+			ClusterName:  "prod",
+			PluginName:   "botkube/kubectl",
+			PlatformUser: "Joe",
+			BotPlatform:  "SLACK",
+			Channel:      "ops",
+			Command:      "kubectl logs nginx",
+			Status:       CommandFailed,
+			DurationMs:   1500,
+		},
+	}, got)
+}
+
+func TestFileAuditReporterRotation(t *testing.T) {
+	// given
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "audit.log")
+	reporter, err := NewFileAuditReporter(config.AuditFile{Path: path, MaxBackups: 2}, "prod")
+	require.NoError(t, err)
+	// a single entry has about 110 bytes, so every entry is written to a new file
+	reporter.maxSize = 200
+
+	// when
+	for _, cmd := range []string{"first", "second", "third", "fourth"} {
+		require.NoError(t, reporter.ReportExecutorAuditEvent(ctx, ExecutorAuditEvent{
+			CreatedAt:    "2023-01-01T12:00:00Z",
+			PlatformUser: "Joe",
+			Command:      cmd,
+		}))
+	}
+
+	// then
+	assertFileContains(t, path, `"command":"fourth"`)
+	assertFileContains(t, path+".1", `"command":"third"`)
+	assertFileContains(t, path+".2", `"command":"second"`)
+	assert.NoFileExists(t, path+".3")
+
+	got, err := reporter.Query(ctx, Query{Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.Equal(t, "third", got[0].Command)
+	assert.Equal(t, "fourth", got[1].Command)
+}
+
+func TestFileAuditReporterQuerySkipsLargeAndBrokenLines(t *testing.T) {
+	// given
+	ctx := context.Background()
+	reporter, err := NewFileAuditReporter(config.AuditFile{Path: filepath.Join(t.TempDir(), "audit.log"), MaxSizeMB: 10}, "prod")
+	require.NoError(t, err)
+
+	require.NoError(t, reporter.ReportSourceAuditEvent(ctx, SourceAuditEvent{
+		CreatedAt: "2023-01-01T12:00:00Z",
+		Event:     strings.Repeat("x", 2*bytesInMB),
+	}))
+	file, err := os.OpenFile(reporter.path, os.O_APPEND|os.O_WRONLY, 0o600)
+	require.NoError(t, err)
+	_, err = file.WriteString(`{"type":"executor","comm` + "\n")
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+	require.NoError(t, reporter.ReportExecutorAuditEvent(ctx, ExecutorAuditEvent{
+		CreatedAt:    "2023-01-01T12:00:00Z",
+		PlatformUser: "Joe",
+		Command:      "kubectl get pods",
+	}))
+
+	// when
+	got, err := reporter.Query(ctx, Query{})
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, "kubectl get pods", got[0].Command)
+}
+
+func assertFileContains(t *testing.T, path, substr string) {
+	t.Helper()
+	raw, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.True(t, strings.Contains(string(raw), substr), "%q doesn't contain %q", path, substr)
+}
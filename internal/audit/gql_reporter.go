@@ -2,6 +2,7 @@ package audit
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hasura/go-graphql-client"
 	"github.com/sirupsen/logrus"
@@ -82,3 +83,35 @@ func (r *GraphQLAuditReporter) ReportSourceAuditEvent(ctx context.Context, e Sou
 
 	return r.gql.Client().Mutate(ctx, &mutation, variables)
 }
+
+// ReportApprovalAuditEvent reports approval audit event using graphql interface.
+// Botkube Cloud doesn't have a dedicated type for approvals, so the decision is reported as an executed command.
+func (r *GraphQLAuditReporter) ReportApprovalAuditEvent(ctx context.Context, e ApprovalAuditEvent) error {
+	r.log.Debugf("Reporting approval audit event for ID %q", r.gql.DeploymentID())
+	var mutation struct {
+		CreateAuditEvent struct {
+			ID graphql.ID
+		} `graphql:"createAuditEvent(input: $input)"`
+	}
+
+	user := e.DecidedBy
+	if e.Decision == ApprovalRequested {
+		user = e.RequestedBy
+	}
+	variables := map[string]interface{}{
+		"input": remoteapi.AuditEventCreateInput{
+			CreatedAt:    e.CreatedAt,
+			PluginName:   e.PluginName,
+			DeploymentID: r.gql.DeploymentID(),
+			Type:         remoteapi.AuditEventTypeCommandExecuted,
+			CommandExecuted: &remoteapi.AuditEventCommandCreateInput{
+				PlatformUser: user,
+				BotPlatform:  e.BotPlatform,
+				Command:      fmt.Sprintf("[approval %s %s] %s", e.RequestID, e.Decision, e.Command),
+				Channel:      e.Channel,
+			},
+		},
+	}
+
+	return r.gql.Client().Mutate(ctx, &mutation, variables)
+}
@@ -28,3 +28,8 @@ func (r *NoopAuditReporter) ReportExecutorAuditEvent(ctx context.Context, e Exec
 func (r *NoopAuditReporter) ReportSourceAuditEvent(ctx context.Context, e SourceAuditEvent) error {
 	return nil
 }
+
+// ReportApprovalAuditEvent is a NOOP
+func (r *NoopAuditReporter) ReportApprovalAuditEvent(ctx context.Context, e ApprovalAuditEvent) error {
+	return nil
+}
@@ -2,18 +2,38 @@ package audit
 
 import (
 	"context"
+	"fmt"
+	"os"
+	"time"
 
 	"github.com/sirupsen/logrus"
 
 	remoteapi "github.com/kubeshop/botkube/internal/remote"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/multierror"
 )
 
 // AuditReporter defines interface for reporting audit events
 type AuditReporter interface {
 	ReportExecutorAuditEvent(ctx context.Context, e ExecutorAuditEvent) error
 	ReportSourceAuditEvent(ctx context.Context, e SourceAuditEvent) error
+	ReportApprovalAuditEvent(ctx context.Context, e ApprovalAuditEvent) error
 }
 
+// CommandStatus describes the result of an executed command.
+type CommandStatus string
+
+const (
+	// CommandSucceeded means that a command was executed successfully.
+	CommandSucceeded CommandStatus = "succeeded"
+	// CommandFailed means that a command was rejected or its execution failed.
+	CommandFailed CommandStatus = "failed"
+	// CommandPendingApproval means that a command waits for approval.
+	CommandPendingApproval CommandStatus = "pending_approval"
+	// CommandRateLimited means that a command was not executed, as a rate limit was exceeded.
+	CommandRateLimited CommandStatus = "rate_limited"
+)
+
 // ExecutorAuditEvent contains audit event data
 type ExecutorAuditEvent struct {
 	CreatedAt    string
@@ -22,6 +42,8 @@ type ExecutorAuditEvent struct {
 	BotPlatform  *remoteapi.BotPlatform
 	Command      string
 	Channel      string
+	Status       CommandStatus
+	Duration     time.Duration
 }
 
 // SourceAuditEvent contains audit event data
@@ -37,10 +59,101 @@ type SourceDetails struct {
 	DisplayName string
 }
 
-// GetReporter creates new AuditReporter
-func GetReporter(remoteCfgSyncEnabled bool, logger logrus.FieldLogger, gql GraphQLClient) AuditReporter {
+// ApprovalDecision describes the approval request state change.
+type ApprovalDecision string
+
+const (
+	// ApprovalRequested means that a command is waiting for approval.
+	ApprovalRequested ApprovalDecision = "requested"
+	// ApprovalApproved means that a command was approved and executed.
+	ApprovalApproved ApprovalDecision = "approved"
+	// ApprovalRejected means that a command was rejected.
+	ApprovalRejected ApprovalDecision = "rejected"
+)
+
+// ApprovalAuditEvent contains approval workflow audit event data
+type ApprovalAuditEvent struct {
+	CreatedAt   string
+	RequestID   string
+	Decision    ApprovalDecision
+	PluginName  string
+	Command     string
+	Channel     string
+	BotPlatform *remoteapi.BotPlatform
+	RequestedBy string
+	// DecidedBy is empty for ApprovalRequested decision.
+	DecidedBy string
+}
+
+// GetReporter creates new AuditReporter. Configured local reporters are used in addition to the Botkube Cloud one.
+// The returned LocalStore is nil if the audit log file is not enabled. The webhook reporter sends entries until the context is canceled.
+func GetReporter(ctx context.Context, remoteCfgSyncEnabled bool, logger logrus.FieldLogger, gql GraphQLClient, cfg config.Audit, clusterName string) (AuditReporter, LocalStore, error) {
+	var (
+		reporters multiReporter
+		store     LocalStore
+	)
 	if remoteCfgSyncEnabled {
-		return newGraphQLAuditReporter(logger.WithField("component", "GraphQLAuditReporter"), gql)
+		reporters = append(reporters, newGraphQLAuditReporter(logger.WithField("component", "GraphQLAuditReporter"), gql))
+	}
+	if cfg.File.Enabled {
+		fileReporter, err := NewFileAuditReporter(cfg.File, clusterName)
+		if err != nil {
+			return nil, nil, fmt.Errorf("while creating file audit reporter: %w", err)
+		}
+		reporters = append(reporters, fileReporter)
+		store = fileReporter
+	}
+	if cfg.Stdout.Enabled {
+		reporters = append(reporters, NewWriterAuditReporter(os.Stdout, clusterName))
+	}
+	if cfg.Webhook.Enabled {
+		webhookReporter := NewWebhookAuditReporter(logger.WithField("component", "WebhookAuditReporter"), cfg.Webhook, clusterName)
+		go webhookReporter.Run(ctx)
+		reporters = append(reporters, webhookReporter)
+	}
+
+	switch len(reporters) {
+	case 0:
+		return newNoopAuditReporter(nil), nil, nil
+	case 1:
+		return reporters[0], store, nil
+	default:
+		return reporters, store, nil
+	}
+}
+
+var _ AuditReporter = multiReporter{}
+
+// multiReporter reports audit events to all reporters.
+type multiReporter []AuditReporter
+
+// ReportExecutorAuditEvent reports executor audit event to all reporters.
+func (m multiReporter) ReportExecutorAuditEvent(ctx context.Context, e ExecutorAuditEvent) error {
+	return m.forEach(func(r AuditReporter) error {
+		return r.ReportExecutorAuditEvent(ctx, e)
+	})
+}
+
+// ReportSourceAuditEvent reports source audit event to all reporters.
+func (m multiReporter) ReportSourceAuditEvent(ctx context.Context, e SourceAuditEvent) error {
+	return m.forEach(func(r AuditReporter) error {
+		return r.ReportSourceAuditEvent(ctx, e)
+	})
+}
+
+// ReportApprovalAuditEvent reports approval audit event to all reporters.
+func (m multiReporter) ReportApprovalAuditEvent(ctx context.Context, e ApprovalAuditEvent) error {
+	return m.forEach(func(r AuditReporter) error {
+		return r.ReportApprovalAuditEvent(ctx, e)
+	})
+}
+
+func (m multiReporter) forEach(report func(r AuditReporter) error) error {
+	errs := multierror.New()
+	for _, r := range m {
+		if err := report(r); err != nil {
+			errs = multierror.Append(errs, err)
+		}
 	}
-	return newNoopAuditReporter(nil)
+	return errs.ErrorOrNil()
 }
@@ -0,0 +1,106 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+var _ AuditReporter = (*WebhookAuditReporter)(nil)
+
+const webhookTimeout = 10 * time.Second
+
+// WebhookAuditReporter sends audit entries as JSON to a given HTTP endpoint.
+// Entries are queued and sent in the background, so a slow endpoint doesn't delay command execution.
+type WebhookAuditReporter struct {
+	log         logrus.FieldLogger
+	url         string
+	headers     map[string]string
+	clusterName string
+	client      *http.Client
+	queue       chan Entry
+}
+
+// NewWebhookAuditReporter returns a new WebhookAuditReporter instance. Queued entries are sent once Run is called.
+func NewWebhookAuditReporter(log logrus.FieldLogger, cfg config.AuditWebhook, clusterName string) *WebhookAuditReporter {
+	return &WebhookAuditReporter{
+		log:         log,
+		url:         cfg.URL,
+		headers:     cfg.Headers,
+		clusterName: clusterName,
+		client:      &http.Client{Timeout: webhookTimeout},
+		queue:       make(chan Entry, cfg.GetQueueSize()),
+	}
+}
+
+// Run sends queued entries until the context is canceled.
+func (r *WebhookAuditReporter) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case entry := <-r.queue:
+			if err := r.send(ctx, entry); err != nil {
+				r.log.Errorf("while sending %s audit entry: %s", entry.Type, err.Error())
+			}
+		}
+	}
+}
+
+// ReportExecutorAuditEvent queues executor audit event.
+func (r *WebhookAuditReporter) ReportExecutorAuditEvent(_ context.Context, e ExecutorAuditEvent) error {
+	return r.enqueue(newExecutorEntry(e, r.clusterName))
+}
+
+// ReportSourceAuditEvent queues source audit event.
+func (r *WebhookAuditReporter) ReportSourceAuditEvent(_ context.Context, e SourceAuditEvent) error {
+	return r.enqueue(newSourceEntry(e, r.clusterName))
+}
+
+// ReportApprovalAuditEvent queues approval audit event.
+func (r *WebhookAuditReporter) ReportApprovalAuditEvent(_ context.Context, e ApprovalAuditEvent) error {
+	return r.enqueue(newApprovalEntry(e, r.clusterName))
+}
+
+func (r *WebhookAuditReporter) enqueue(entry Entry) error {
+	select {
+	case r.queue <- entry:
+		return nil
+	default:
+		return fmt.Errorf("audit webhook queue is full, dropping %s audit entry", entry.Type)
+	}
+}
+
+func (r *WebhookAuditReporter) send(ctx context.Context, entry Entry) error {
+	raw, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("while marshaling audit entry: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.url, bytes.NewReader(raw))
+	if err != nil {
+		return fmt.Errorf("while creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for name, val := range r.headers {
+		req.Header.Set(name, val)
+	}
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("while sending audit entry: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("got unexpected status code %d while sending audit entry", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+func TestWebhookAuditReporterSendsEntriesInBackground(t *testing.T) {
+	// given
+	received := make(chan Entry, 1)
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		var entry Entry
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&entry))
+		assert.Equal(t, "secret", r.Header.Get("Authorization"))
+		received <- entry
+	}))
+	defer srv.Close()
+	defer close(release)
+
+	reporter := NewWebhookAuditReporter(loggerx.NewNoop(), config.AuditWebhook{
+		URL: srv.URL,
+		Headers: map[string]string{
+			"Authorization": "secret",
+		},
+		QueueSize: 1,
+	}, "prod")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go reporter.Run(ctx)
+
+	// when
+	err := reporter.ReportExecutorAuditEvent(ctx, ExecutorAuditEvent{PlatformUser: "Joe", Command: "kubectl get pods"})
+
+	// then
+	require.NoError(t, err)
+
+	// when the endpoint is slow and the queue is full
+	require.Eventually(t, func() bool {
+		return len(reporter.queue) == 0
+	}, time.Second, 10*time.Millisecond)
+	require.NoError(t, reporter.ReportExecutorAuditEvent(ctx, ExecutorAuditEvent{Command: "kubectl get nodes"}))
+	err = reporter.ReportExecutorAuditEvent(ctx, ExecutorAuditEvent{Command: "kubectl get ns"})
+
+	// then
+	assert.EqualError(t, err, "audit webhook queue is full, dropping executor audit entry")
+
+	release <- struct{}{}
+	select {
+	case entry := <-received:
+		assert.Equal(t, "kubectl get pods", entry.Command)
+		assert.Equal(t, "prod", entry.ClusterName)
+	case <-time.After(time.Second):
+		t.Fatal("audit entry was not sent")
+	}
+}
@@ -0,0 +1,54 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+)
+
+var _ AuditReporter = (*WriterAuditReporter)(nil)
+
+// WriterAuditReporter writes audit entries to a given writer, one JSON object per line. It is used to print entries to the standard output.
+type WriterAuditReporter struct {
+	out         io.Writer
+	clusterName string
+
+	mu sync.Mutex
+}
+
+// NewWriterAuditReporter returns a new WriterAuditReporter instance.
+func NewWriterAuditReporter(out io.Writer, clusterName string) *WriterAuditReporter {
+	return &WriterAuditReporter{
+		out:         out,
+		clusterName: clusterName,
+	}
+}
+
+// ReportExecutorAuditEvent writes executor audit event.
+func (r *WriterAuditReporter) ReportExecutorAuditEvent(_ context.Context, e ExecutorAuditEvent) error {
+	return r.write(newExecutorEntry(e, r.clusterName))
+}
+
+// ReportSourceAuditEvent writes source audit event.
+func (r *WriterAuditReporter) ReportSourceAuditEvent(_ context.Context, e SourceAuditEvent) error {
+	return r.write(newSourceEntry(e, r.clusterName))
+}
+
+// ReportApprovalAuditEvent writes approval audit event.
+func (r *WriterAuditReporter) ReportApprovalAuditEvent(_ context.Context, e ApprovalAuditEvent) error {
+	return r.write(newApprovalEntry(e, r.clusterName))
+}
+
+func (r *WriterAuditReporter) write(entry Entry) error {
+	raw, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("while marshaling audit entry: %w", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	_, err = r.out.Write(append(raw, '\n'))
+	return err
+}
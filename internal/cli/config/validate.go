@@ -0,0 +1,414 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	semver "github.com/hashicorp/go-version"
+	"github.com/xeipuuv/gojsonschema"
+	"gopkg.in/yaml.v3"
+
+	"github.com/kubeshop/botkube/internal/plugin"
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+// Severity describes the importance of a given validation issue.
+type Severity string
+
+const (
+	// SeverityCritical marks issues which prevent Botkube from starting.
+	SeverityCritical Severity = "critical"
+	// SeverityWarning marks issues which don't prevent Botkube from starting.
+	SeverityWarning Severity = "warning"
+)
+
+var (
+	validationKeyRegex = regexp.MustCompile(`^Key: '([^']+)' `)
+	pathSegmentRegex   = regexp.MustCompile(`([^.\[\]]+)|\[([^\]]*)\]`)
+)
+
+// ValidateOptions holds options for offline configuration validation.
+type ValidateOptions struct {
+	Files []string
+	// PluginIndexes maps the plugin repository name to a local index file path.
+	PluginIndexes map[string]string
+	// PluginsCacheDir is a directory with plugin indexes downloaded by Botkube. If empty, the configured one is used.
+	PluginsCacheDir string
+}
+
+// ValidationIssue describes a single configuration problem.
+type ValidationIssue struct {
+	Severity Severity
+	Message  string
+	// File and Line point to the definition of the invalid value. They are empty if the location is unknown.
+	File string
+	Line int
+}
+
+// String returns the issue in the `file:line: severity: message` format.
+func (i ValidationIssue) String() string {
+	switch {
+	case i.File != "" && i.Line > 0:
+		return fmt.Sprintf("%s:%d: %s: %s", i.File, i.Line, i.Severity, i.Message)
+	case i.File != "":
+		return fmt.Sprintf("%s: %s: %s", i.File, i.Severity, i.Message)
+	}
+	return fmt.Sprintf("%s: %s", i.Severity, i.Message)
+}
+
+// ValidationReport holds all issues found during validation.
+type ValidationReport struct {
+	Issues []ValidationIssue
+}
+
+// HasCriticals returns true if the report contains at least one critical issue.
+func (r ValidationReport) HasCriticals() bool {
+	for _, issue := range r.Issues {
+		if issue.Severity == SeverityCritical {
+			return true
+		}
+	}
+	return false
+}
+
+// Count returns the number of issues with a given severity.
+func (r ValidationReport) Count(severity Severity) int {
+	cnt := 0
+	for _, issue := range r.Issues {
+		if issue.Severity == severity {
+			cnt++
+		}
+	}
+	return cnt
+}
+
+type sourceFile struct {
+	name string
+	raw  []byte
+	root *yaml.Node
+}
+
+// Validate validates the configuration files without connecting to a cluster. The files are merged in a given order,
+// in the same way as Botkube does. Plugin configurations are validated against JSON schemas from local plugin indexes.
+func Validate(opts ValidateOptions) (ValidationReport, error) {
+	files, err := readSourceFiles(opts.Files)
+	if err != nil {
+		return ValidationReport{}, err
+	}
+
+	var raw [][]byte
+	for _, f := range files {
+		raw = append(raw, f.raw)
+	}
+
+	cfg, err := config.MergeWithDefaults(raw)
+	if err != nil {
+		return ValidationReport{
+			Issues: []ValidationIssue{{Severity: SeverityCritical, Message: fmt.Sprintf("while loading configuration: %s", err)}},
+		}, nil
+	}
+
+	result, err := config.ValidateStruct(*cfg)
+	if err != nil {
+		return ValidationReport{}, fmt.Errorf("while validating configuration: %w", err)
+	}
+
+	var report ValidationReport
+	report.addStructIssues(files, SeverityCritical, result.Criticals)
+	report.addStructIssues(files, SeverityWarning, result.Warnings)
+
+	issues, err := validatePluginConfigs(files, *cfg, opts)
+	if err != nil {
+		return ValidationReport{}, err
+	}
+	report.Issues = append(report.Issues, issues...)
+
+	return report, nil
+}
+
+func readSourceFiles(paths []string) ([]sourceFile, error) {
+	if len(paths) == 0 {
+		return nil, errors.New("at least one configuration file is required")
+	}
+
+	var out []sourceFile
+	for _, path := range paths {
+		raw, err := os.ReadFile(filepath.Clean(path))
+		if err != nil {
+			return nil, fmt.Errorf("while reading %q: %w", path, err)
+		}
+
+		var root yaml.Node
+		if err := yaml.Unmarshal(raw, &root); err != nil {
+			return nil, fmt.Errorf("while parsing %q: %w", path, err)
+		}
+		out = append(out, sourceFile{name: path, raw: raw, root: &root})
+	}
+	return out, nil
+}
+
+func (r *ValidationReport) addStructIssues(files []sourceFile, severity Severity, errs *multierror.Error) {
+	if errs == nil {
+		return
+	}
+	for _, err := range errs.Errors {
+		issue := ValidationIssue{Severity: severity, Message: err.Error()}
+
+		// e.g. "Key: 'Config.Communications[default-group].SocketSlack.BotToken' BotToken is a required field"
+		if match := validationKeyRegex.FindStringSubmatch(issue.Message); len(match) == 2 {
+			path := splitStructNamespace(match[1])
+			issue.File, issue.Line = locate(files, path)
+		}
+		r.Issues = append(r.Issues, issue)
+	}
+}
+
+// splitStructNamespace converts the validator struct namespace into configuration path segments.
+func splitStructNamespace(in string) []string {
+	in = strings.TrimPrefix(in, "Config.")
+
+	var out []string
+	for _, match := range pathSegmentRegex.FindAllStringSubmatch(in, -1) {
+		if match[1] != "" {
+			out = append(out, match[1])
+			continue
+		}
+		out = append(out, match[2])
+	}
+	return out
+}
+
+// locate returns the file and line where a given configuration path is defined. Files are merged in order,
+// so the last file which defines the deepest part of the path wins.
+func locate(files []sourceFile, path []string) (string, int) {
+	var (
+		file      string
+		line      int
+		bestDepth int
+	)
+	for _, f := range files {
+		node, depth := lookupNode(f.root, path)
+		if node == nil || depth == 0 || depth < bestDepth {
+			continue
+		}
+		file, line, bestDepth = f.name, node.Line, depth
+	}
+	return file, line
+}
+
+// lookupNode returns the deepest node matching a given path, and the number of matched segments.
+// Keys are matched case-insensitively, as the configuration is. Segments which don't exist in YAML,
+// such as embedded struct names, are skipped.
+func lookupNode(root *yaml.Node, path []string) (*yaml.Node, int) {
+	node := root
+	if node.Kind == yaml.DocumentNode && len(node.Content) > 0 {
+		node = node.Content[0]
+	}
+
+	var (
+		found *yaml.Node
+		depth int
+	)
+	for _, segment := range path {
+		key, child := childNode(node, segment)
+		if child == nil {
+			continue
+		}
+		found = key
+		depth++
+		node = child
+	}
+	return found, depth
+}
+
+// childNode returns the key and value nodes for a given mapping key or sequence index.
+func childNode(node *yaml.Node, segment string) (*yaml.Node, *yaml.Node) {
+	switch node.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if strings.EqualFold(node.Content[i].Value, segment) {
+				return node.Content[i], node.Content[i+1]
+			}
+		}
+	case yaml.SequenceNode:
+		idx, err := strconv.Atoi(segment)
+		if err != nil || idx < 0 || idx >= len(node.Content) {
+			return nil, nil
+		}
+		return node.Content[idx], node.Content[idx]
+	}
+	return nil, nil
+}
+
+type pluginRef struct {
+	path    []string
+	key     string
+	typ     plugin.Type
+	plugin  config.Plugin
+	version string
+}
+
+// validatePluginConfigs validates enabled plugin configurations against the JSON schemas from plugin indexes.
+func validatePluginConfigs(files []sourceFile, cfg config.Config, opts ValidateOptions) ([]ValidationIssue, error) {
+	var refs []pluginRef
+	for name, src := range cfg.Sources {
+		for key, p := range src.Plugins {
+			refs = append(refs, pluginRef{path: []string{"sources", name, key}, key: key, typ: plugin.TypeSource, plugin: p})
+		}
+	}
+	for name, exec := range cfg.Executors {
+		for key, p := range exec.Plugins {
+			refs = append(refs, pluginRef{path: []string{"executors", name, key}, key: key, typ: plugin.TypeExecutor, plugin: p})
+		}
+	}
+	sort.Slice(refs, func(i, j int) bool {
+		return strings.Join(refs[i].path, ".") < strings.Join(refs[j].path, ".")
+	})
+
+	cacheDir := opts.PluginsCacheDir
+	if cacheDir == "" {
+		cacheDir = cfg.Plugins.CacheDir
+	}
+
+	indexes := map[string]*plugin.Index{}
+	var issues []ValidationIssue
+	for _, ref := range refs {
+		if !ref.plugin.Enabled {
+			continue
+		}
+
+		repo, name, ver, err := config.DecomposePluginKey(ref.key)
+		if err != nil {
+			// reported by the struct validation
+			continue
+		}
+
+		index, found := indexes[repo]
+		if !found {
+			index, err = loadPluginIndex(repo, opts.PluginIndexes, cacheDir)
+			if err != nil {
+				issues = append(issues, ValidationIssue{
+					Severity: SeverityWarning,
+					Message:  fmt.Sprintf("Skipping JSON schema validation of %q plugins: %s", repo, err),
+				})
+			}
+			indexes[repo] = index
+		}
+		if index == nil {
+			continue
+		}
+
+		file, line := locate(files, ref.path)
+		entry, found := findIndexEntry(*index, ref.typ, name, ver)
+		if !found {
+			issues = append(issues, ValidationIssue{
+				Severity: SeverityCritical,
+				Message:  fmt.Sprintf("%s plugin %q not found in %q repository index", ref.typ, ref.key, repo),
+				File:     file,
+				Line:     line,
+			})
+			continue
+		}
+
+		errs, err := validateAgainstSchema(entry.JSONSchema, ref.plugin.Config)
+		if err != nil {
+			issues = append(issues, ValidationIssue{
+				Severity: SeverityWarning,
+				Message:  fmt.Sprintf("Skipping JSON schema validation of %q plugin: %s", ref.key, err),
+				File:     file,
+				Line:     line,
+			})
+			continue
+		}
+		for _, schemaErr := range errs {
+			path := append(append([]string{}, ref.path...), "config")
+			if field := schemaErr.Field(); field != "(root)" {
+				path = append(path, strings.Split(field, ".")...)
+			}
+			file, line := locate(files, path)
+			issues = append(issues, ValidationIssue{
+				Severity: SeverityCritical,
+				Message:  fmt.Sprintf("Key: '%s' %s", strings.Join(path, "."), schemaErr.Description()),
+				File:     file,
+				Line:     line,
+			})
+		}
+	}
+	return issues, nil
+}
+
+// loadPluginIndex reads an index of a given repository from a provided path or from the plugins cache directory.
+func loadPluginIndex(repo string, paths map[string]string, cacheDir string) (*plugin.Index, error) {
+	path, found := paths[repo]
+	if !found {
+		path = filepath.Join(cacheDir, filepath.Clean(fmt.Sprintf("%s.yaml", repo)))
+	}
+
+	raw, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, fmt.Errorf("while reading plugin index: %w", err)
+	}
+
+	var index plugin.Index
+	if err := yaml.Unmarshal(raw, &index); err != nil {
+		return nil, fmt.Errorf("while unmarshaling plugin index %q: %w", path, err)
+	}
+	return &index, nil
+}
+
+// findIndexEntry returns the plugin entry with a given version, or the latest one if the version is empty.
+func findIndexEntry(index plugin.Index, typ plugin.Type, name, ver string) (plugin.IndexEntry, bool) {
+	var (
+		out    plugin.IndexEntry
+		latest *semver.Version
+		found  bool
+	)
+	for _, entry := range index.Entries {
+		if entry.Type != typ || entry.Name != name {
+			continue
+		}
+		if ver != "" {
+			if entry.Version == ver {
+				return entry, true
+			}
+			continue
+		}
+
+		entryVer, err := semver.NewVersion(entry.Version)
+		if err != nil {
+			continue
+		}
+		if latest == nil || entryVer.GreaterThan(latest) {
+			out, latest, found = entry, entryVer, true
+		}
+	}
+	return out, found
+}
+
+func validateAgainstSchema(schema plugin.JSONSchema, in any) ([]gojsonschema.ResultError, error) {
+	var loader gojsonschema.JSONLoader
+	switch {
+	case schema.Value != "":
+		loader = gojsonschema.NewStringLoader(schema.Value)
+	case schema.RefURL != "":
+		loader = gojsonschema.NewReferenceLoader(schema.RefURL)
+	default:
+		return nil, nil
+	}
+
+	if in == nil {
+		in = map[string]any{}
+	}
+	result, err := gojsonschema.Validate(loader, gojsonschema.NewGoLoader(in))
+	if err != nil {
+		return nil, fmt.Errorf("while validating against JSON schema: %w", err)
+	}
+	return result.Errors(), nil
+}
@@ -0,0 +1,117 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	// given
+	dir := t.TempDir()
+	values := writeFile(t, dir, "values.yaml", `
+communications:
+  default-group:
+    socketSlack:
+      enabled: true
+      appToken: xapp-1
+      botToken: xoxb-1
+      channels:
+        default:
+          name: botkube
+          bindings:
+            sources: [k8s]
+sources:
+  k8s:
+    botkube/kubernetes:
+      enabled: true
+      config:
+        namespaces: {}
+plugins:
+  repositories:
+    botkube:
+      url: http://localhost/index.yaml
+`)
+	extra := writeFile(t, dir, "extra.yaml", `
+communications:
+  default-group:
+    socketSlack:
+      botToken: invalid
+sources:
+  k8s:
+    botkube/kubernetes:
+      config:
+        namespaces: 5
+`)
+	index := writeFile(t, dir, "index.yaml", `
+entries:
+  - name: kubernetes
+    type: source
+    version: v1.0.0
+    jsonSchema:
+      value: '{"type": "object", "properties": {"namespaces": {"type": "object"}}}'
+`)
+
+	// when
+	report, err := Validate(ValidateOptions{
+		Files:         []string{values, extra},
+		PluginIndexes: map[string]string{"botkube": index},
+	})
+
+	// then
+	require.NoError(t, err)
+	assert.True(t, report.HasCriticals())
+	assert.Zero(t, report.Count(SeverityWarning))
+	assert.Equal(t, []ValidationIssue{
+		{
+			Severity: SeverityCritical,
+			Message:  "Key: 'Config.Communications[default-group].SocketSlack.BotToken' BotToken must have the xoxb- prefix. Learn more at https://docs.botkube.io/installation/socketslack/#obtain-bot-token",
+			File:     extra,
+			Line:     5,
+		},
+		{
+			Severity: SeverityCritical,
+			Message:  "Key: 'sources.k8s.botkube/kubernetes.config.namespaces' Invalid type. Expected: object, given: integer",
+			File:     extra,
+			Line:     10,
+		},
+	}, report.Issues)
+}
+
+func TestValidateMissingPluginIndex(t *testing.T) {
+	// given
+	dir := t.TempDir()
+	values := writeFile(t, dir, "values.yaml", `
+communications:
+  default-group:
+    socketSlack:
+      enabled: false
+executors:
+  helm:
+    botkube/helm:
+      enabled: true
+`)
+
+	// when
+	report, err := Validate(ValidateOptions{
+		Files:           []string{values},
+		PluginsCacheDir: dir,
+	})
+
+	// then
+	require.NoError(t, err)
+	assert.False(t, report.HasCriticals())
+	require.Len(t, report.Issues, 1)
+	assert.Equal(t, SeverityWarning, report.Issues[0].Severity)
+	assert.Contains(t, report.Issues[0].Message, `Skipping JSON schema validation of "botkube" plugins`)
+}
+
+func writeFile(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
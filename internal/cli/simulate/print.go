@@ -0,0 +1,73 @@
+package simulate
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+const indent = "  "
+
+// Print prints simulation reports in a human-readable format.
+func Print(w io.Writer, reports []SourceReport) {
+	if len(reports) == 0 {
+		fmt.Fprintln(w, "No enabled Kubernetes sources found in the configuration.")
+		return
+	}
+
+	for idx, report := range reports {
+		if idx > 0 {
+			fmt.Fprintln(w)
+		}
+		fmt.Fprintf(w, "Source %q (%s): %s event for %s\n", report.SourceName, report.PluginKey, report.EventType, report.Resource)
+
+		if !report.Sent {
+			fmt.Fprintln(w, indent+"Not sent: the event doesn't match any route, or it was filtered out. Use --verbose to see the reason.")
+			continue
+		}
+
+		fmt.Fprintln(w, indent+"Channels:")
+		if len(report.Channels) == 0 {
+			fmt.Fprintln(w, indent+indent+"none")
+		}
+		for _, ch := range report.Channels {
+			mode := ""
+			if ch.Digest {
+				mode = " (digest)"
+			}
+			fmt.Fprintf(w, "%s- %s/%s: %s%s\n", indent+indent, ch.CommGroup, ch.Platform, ch.Channel, mode)
+		}
+
+		fmt.Fprintln(w, indent+"Sinks:")
+		if len(report.Sinks) == 0 {
+			fmt.Fprintln(w, indent+indent+"none")
+		}
+		for _, sink := range report.Sinks {
+			fmt.Fprintf(w, "%s- %s\n", indent+indent, sink)
+		}
+
+		fmt.Fprintln(w, indent+"Actions:")
+		if len(report.Actions) == 0 {
+			fmt.Fprintln(w, indent+indent+"none")
+		}
+		for _, act := range report.Actions {
+			fmt.Fprintf(w, "%s- %s: %s\n", indent+indent, act.DisplayName, act.Command)
+		}
+
+		for _, msg := range report.Messages {
+			fmt.Fprintf(w, "%sMessage for %s (%s):\n", indent, msg.Platform, msg.Format)
+			fmt.Fprintln(w, indentLines(msg.Body, indent+indent))
+		}
+	}
+}
+
+func indentLines(in, prefix string) string {
+	lines := strings.Split(in, "\n")
+	for idx, line := range lines {
+		if line == "" {
+			continue
+		}
+		lines[idx] = prefix + line
+	}
+	return strings.Join(lines, "\n")
+}
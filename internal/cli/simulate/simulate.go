@@ -0,0 +1,380 @@
+package simulate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8syaml "sigs.k8s.io/yaml"
+
+	"github.com/kubeshop/botkube/internal/source"
+	"github.com/kubeshop/botkube/internal/source/kubernetes"
+	k8sconfig "github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/pkg/action"
+	"github.com/kubeshop/botkube/pkg/api"
+	pkgsource "github.com/kubeshop/botkube/pkg/api/source"
+	"github.com/kubeshop/botkube/pkg/bot"
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/notifier"
+	"github.com/kubeshop/botkube/pkg/sliceutil"
+)
+
+const (
+	kubernetesPluginRepo = "botkube"
+	// teamsConversations is displayed instead of the channel name, as Teams bindings apply to all conversations.
+	teamsConversations = "all conversations"
+)
+
+var supportedEventTypes = []k8sconfig.EventType{k8sconfig.CreateEvent, k8sconfig.UpdateEvent, k8sconfig.DeleteEvent, k8sconfig.ErrorEvent}
+
+// Options holds parameters for the event simulation.
+type Options struct {
+	ConfigFiles   []string
+	ObjectFile    string
+	OldObjectFile string
+	EventType     string
+	BotName       string
+}
+
+// SourceReport describes what a given source would do with the simulated event.
+type SourceReport struct {
+	SourceName string
+	PluginKey  string
+	Resource   string
+	EventType  string
+	// Sent is false if the event doesn't match the routes, or it's filtered out.
+	Sent     bool
+	Channels []ChannelReceiver
+	Sinks    []string
+	Actions  []action.Action
+	Messages []PlatformMessage
+}
+
+// ChannelReceiver describes a channel which would receive the event.
+type ChannelReceiver struct {
+	CommGroup string
+	Platform  config.CommPlatformIntegration
+	Channel   string
+	Digest    bool
+}
+
+// PlatformMessage holds the message rendered for a given platform.
+type PlatformMessage struct {
+	Platform config.CommPlatformIntegration
+	bot.MessagePreview
+}
+
+// Run loads the configuration and feeds a recorded Kubernetes object through all enabled Kubernetes sources.
+func Run(ctx context.Context, log logrus.FieldLogger, opts Options) ([]SourceReport, error) {
+	cfg, err := loadConfig(opts.ConfigFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	eventType := k8sconfig.EventType(strings.ToLower(opts.EventType))
+	if eventType != "" && !containsEventType(supportedEventTypes, eventType) {
+		return nil, fmt.Errorf("unsupported event type %q. Supported types: %s", opts.EventType, eventTypesString(supportedEventTypes))
+	}
+
+	obj, err := loadObject(opts.ObjectFile)
+	if err != nil {
+		return nil, err
+	}
+	var oldObj *unstructured.Unstructured
+	if opts.OldObjectFile != "" {
+		oldObj, err = loadObject(opts.OldObjectFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	in := kubernetes.SimulationInput{
+		Object:      obj,
+		OldObject:   oldObj,
+		EventType:   eventType,
+		ClusterName: cfg.Settings.ClusterName,
+	}
+
+	sourceNames := make([]string, 0, len(cfg.Sources))
+	for name := range cfg.Sources {
+		sourceNames = append(sourceNames, name)
+	}
+	sort.Strings(sourceNames)
+
+	var out []SourceReport
+	for _, sourceName := range sourceNames {
+		for key, plugin := range cfg.Sources[sourceName].Plugins {
+			repo, name, _, err := config.DecomposePluginKey(key)
+			if err != nil {
+				return nil, err
+			}
+			if !plugin.Enabled || repo != kubernetesPluginRepo || name != kubernetes.PluginName {
+				continue
+			}
+
+			report, err := simulateSource(ctx, log, *cfg, sourceName, key, plugin, in, opts.BotName)
+			if err != nil {
+				return nil, fmt.Errorf("while simulating %q source: %w", sourceName, err)
+			}
+			out = append(out, report)
+		}
+	}
+	return out, nil
+}
+
+func simulateSource(ctx context.Context, log logrus.FieldLogger, cfg config.Config, sourceName, pluginKey string, plugin config.Plugin, in kubernetes.SimulationInput, botName string) (SourceReport, error) {
+	log = log.WithField("source", sourceName)
+
+	rawCfg, err := yaml.Marshal(plugin.Config)
+	if err != nil {
+		return SourceReport{}, fmt.Errorf("while marshaling plugin configuration: %w", err)
+	}
+	k8sCfg, err := k8sconfig.MergeConfigs([]*pkgsource.Config{{RawYAML: rawCfg}})
+	if err != nil {
+		return SourceReport{}, fmt.Errorf("while merging plugin configuration: %w", err)
+	}
+
+	simulated := map[bool]*pkgsource.Event{}
+	simulate := func(isInteractive bool) (kubernetes.SimulationResult, error) {
+		in.IsInteractivitySupported = isInteractive
+		res, err := kubernetes.Simulate(ctx, log, k8sCfg, in)
+		if err != nil {
+			return kubernetes.SimulationResult{}, err
+		}
+		simulated[isInteractive] = res.Event
+		return res, nil
+	}
+
+	res, err := simulate(false)
+	if err != nil {
+		return SourceReport{}, err
+	}
+	out := SourceReport{
+		SourceName: sourceName,
+		PluginKey:  pluginKey,
+		Resource:   res.Resource,
+		EventType:  res.EventType.String(),
+	}
+	if res.Event == nil {
+		return out, nil
+	}
+	out.Sent = true
+
+	// the event is sent to Botkube core as JSON, so the raw object is a map when it's routed and rendered in actions
+	rawObject, err := toMap(res.Event.RawObject)
+	if err != nil {
+		return SourceReport{}, err
+	}
+	evt := pkgsource.Event{Message: res.Event.Message, RawObject: rawObject, Labels: res.Event.Labels}
+
+	receivers, err := source.NewRouter(cfg.Routing).Route(sourceName, evt)
+	if err != nil {
+		return SourceReport{}, fmt.Errorf("while evaluating routing rules: %w", err)
+	}
+	out.Channels = boundChannels(cfg.Communications, sourceName, receivers)
+	out.Sinks = boundSinks(cfg.Communications, sourceName, receivers)
+
+	actions, err := action.NewProvider(log, cfg.Actions, nil).RenderedActions(rawObject, []string{sourceName})
+	if err != nil {
+		return SourceReport{}, fmt.Errorf("while rendering automated actions: %w", err)
+	}
+	for idx := range actions {
+		actions[idx].Command = strings.ReplaceAll(actions[idx].Command, api.MessageBotNamePlaceholder, botName)
+	}
+	sort.Slice(actions, func(i, j int) bool { return actions[i].DisplayName < actions[j].DisplayName })
+	out.Actions = actions
+
+	rendered := map[config.CommPlatformIntegration]struct{}{}
+	for _, ch := range out.Channels {
+		if _, found := rendered[ch.Platform]; found {
+			continue
+		}
+		rendered[ch.Platform] = struct{}{}
+
+		isInteractive := ch.Platform.IsInteractive()
+		if _, found := simulated[isInteractive]; !found {
+			if _, err := simulate(isInteractive); err != nil {
+				return SourceReport{}, err
+			}
+		}
+		msgEvent := simulated[isInteractive]
+		if msgEvent == nil {
+			continue
+		}
+
+		preview, err := bot.RenderPreview(ch.Platform, botName, interactive.CoreMessage{Message: msgEvent.Message})
+		if err != nil {
+			return SourceReport{}, fmt.Errorf("while rendering %s message: %w", ch.Platform, err)
+		}
+		out.Messages = append(out.Messages, PlatformMessage{Platform: ch.Platform, MessagePreview: preview})
+	}
+
+	return out, nil
+}
+
+// boundChannels returns channels with notifications enabled, which are bound to a given source and selected by routing rules.
+func boundChannels(comms map[string]config.Communications, sourceName string, receivers *notifier.Receivers) []ChannelReceiver {
+	groups := make([]string, 0, len(comms))
+	for name := range comms {
+		groups = append(groups, name)
+	}
+	sort.Strings(groups)
+
+	var out []ChannelReceiver
+	for _, group := range groups {
+		comm := comms[group]
+		add := func(platform config.CommPlatformIntegration, channel string, notification config.ChannelNotification, bindings config.BotBindings) {
+			if notification.Disabled || !sliceutil.Intersect([]string{sourceName}, bindings.Sources) || !receivers.AllowsChannel(channel) {
+				return
+			}
+			out = append(out, ChannelReceiver{
+				CommGroup: group,
+				Platform:  platform,
+				Channel:   channel,
+				Digest:    notification.IsDigest(),
+			})
+		}
+
+		if comm.SocketSlack.Enabled {
+			for _, ch := range sortedChannels(comm.SocketSlack.Channels) {
+				add(config.SocketSlackCommPlatformIntegration, ch.Identifier(), ch.Notification, ch.Bindings)
+			}
+		}
+		if comm.CloudSlack.Enabled {
+			for _, ch := range sortedChannels(comm.CloudSlack.Channels) {
+				add(config.CloudSlackCommPlatformIntegration, ch.Identifier(), ch.Notification, ch.Bindings)
+			}
+		}
+		if comm.Slack.Enabled {
+			for _, ch := range sortedChannels(comm.Slack.Channels) {
+				add(config.SlackCommPlatformIntegration, ch.Identifier(), ch.Notification, ch.Bindings)
+			}
+		}
+		if comm.Mattermost.Enabled {
+			for _, ch := range sortedChannels(comm.Mattermost.Channels) {
+				add(config.MattermostCommPlatformIntegration, ch.Identifier(), ch.Notification, ch.Bindings)
+			}
+		}
+		if comm.Discord.Enabled {
+			for _, ch := range sortedChannels(comm.Discord.Channels) {
+				add(config.DiscordCommPlatformIntegration, ch.Identifier(), ch.Notification, ch.Bindings)
+			}
+		}
+		if comm.Teams.Enabled && sliceutil.Intersect([]string{sourceName}, comm.Teams.Bindings.Sources) {
+			out = append(out, ChannelReceiver{
+				CommGroup: group,
+				Platform:  config.TeamsCommPlatformIntegration,
+				Channel:   teamsConversations,
+			})
+		}
+	}
+	return out
+}
+
+// boundSinks returns sinks bound to a given source and selected by routing rules.
+// Sinks are described with the names which select them in routing rules, e.g. "default-group/webhook".
+func boundSinks(comms map[string]config.Communications, sourceName string, receivers *notifier.Receivers) []string {
+	var out []string
+	add := func(commGroupName string, sink config.CommPlatformIntegration) {
+		if receivers.AllowsSink(commGroupName, sink) {
+			out = append(out, config.SinkReceiverName(commGroupName, sink))
+		}
+	}
+	for commGroupName, comm := range comms {
+		if comm.Webhook.Enabled && sliceutil.Intersect([]string{sourceName}, comm.Webhook.Bindings.Sources) {
+			add(commGroupName, config.WebhookCommPlatformIntegration)
+		}
+		if !comm.Elasticsearch.Enabled {
+			continue
+		}
+		for _, index := range comm.Elasticsearch.Indices {
+			if sliceutil.Intersect([]string{sourceName}, index.Bindings.Sources) {
+				add(commGroupName, config.ElasticsearchCommPlatformIntegration)
+				break
+			}
+		}
+	}
+
+	sort.Strings(out)
+	return out
+}
+
+func sortedChannels[T config.Identifiable](in config.IdentifiableMap[T]) []T {
+	out := make([]T, 0, len(in))
+	for _, ch := range in {
+		out = append(out, ch)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Identifier() < out[j].Identifier() })
+	return out
+}
+
+func loadConfig(files []string) (*config.Config, error) {
+	var configs [][]byte
+	for _, path := range files {
+		raw, err := os.ReadFile(filepath.Clean(path))
+		if err != nil {
+			return nil, fmt.Errorf("while reading %q: %w", path, err)
+		}
+		configs = append(configs, raw)
+	}
+
+	cfg, _, err := config.LoadWithDefaults(configs)
+	if err != nil {
+		return nil, fmt.Errorf("while loading configuration: %w", err)
+	}
+	return cfg, nil
+}
+
+// loadObject reads a Kubernetes object from a YAML or JSON file.
+func loadObject(path string) (*unstructured.Unstructured, error) {
+	raw, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, fmt.Errorf("while reading %q: %w", path, err)
+	}
+
+	var obj unstructured.Unstructured
+	if err := k8syaml.Unmarshal(raw, &obj.Object); err != nil {
+		return nil, fmt.Errorf("while parsing %q: %w", path, err)
+	}
+	if obj.GetKind() == "" || obj.GetAPIVersion() == "" {
+		return nil, fmt.Errorf("object from %q must have the apiVersion and kind fields", path)
+	}
+	return &obj, nil
+}
+
+func toMap(in any) (map[string]any, error) {
+	raw, err := json.Marshal(in)
+	if err != nil {
+		return nil, fmt.Errorf("while marshaling event: %w", err)
+	}
+	var out map[string]any
+	if err := json.Unmarshal(raw, &out); err != nil {
+		return nil, fmt.Errorf("while unmarshaling event: %w", err)
+	}
+	return out, nil
+}
+
+func containsEventType(in []k8sconfig.EventType, val k8sconfig.EventType) bool {
+	for _, item := range in {
+		if item == val {
+			return true
+		}
+	}
+	return false
+}
+
+func eventTypesString(in []k8sconfig.EventType) string {
+	out := make([]string, 0, len(in))
+	for _, item := range in {
+		out = append(out, item.String())
+	}
+	return strings.Join(out, ", ")
+}
@@ -0,0 +1,567 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/exp/slices"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+const (
+	// CRDGroup is the API group of the Botkube configuration custom resources.
+	CRDGroup = "botkube.io"
+	// CRDVersion is the API version of the Botkube configuration custom resources.
+	CRDVersion = "v1alpha1"
+
+	validConditionType        = "Valid"
+	validationSucceededReason = "ValidationSucceeded"
+	validationFailedReason    = "ValidationFailed"
+	crdFieldManager           = "botkube"
+	defaultCommunicationGroup = "default-group"
+	kubernetesSourcePlugin    = "botkube/kubernetes"
+	serviceAccountRBACKey     = "serviceAccount"
+
+	// crdChangeDebounce groups the changes applied at once, e.g. by a GitOps tool, into a single reload.
+	crdChangeDebounce = 2 * time.Second
+)
+
+var (
+	sourceGVR         = crdGVR("botkubesources")
+	executorGVR       = crdGVR("botkubeexecutors")
+	aliasGVR          = crdGVR("botkubealiases")
+	actionGVR         = crdGVR("botkubeactions")
+	channelBindingGVR = crdGVR("botkubechannelbindings")
+
+	// crdGVRs are ordered, so the resources are validated after the ones they refer to.
+	crdGVRs = []schema.GroupVersionResource{sourceGVR, executorGVR, aliasGVR, actionGVR, channelBindingGVR}
+)
+
+// CRDProvider provides configuration defined as Kubernetes custom resources, such as BotkubeSource or BotkubeChannelBinding.
+// The custom resources are merged on top of the configuration returned by the base provider.
+//
+// Sources, executors and actions are named after the custom resource namespace and name, e.g. "team-a/k8s-events",
+// so teams can own their configuration in their namespaces. Bindings refer to resources from the same namespace,
+// with or without the namespace prefix. Other sources and executors, e.g. the ones defined in the base configuration,
+// can be bound only if they are allowed for a given namespace.
+type CRDProvider struct {
+	log  logrus.FieldLogger
+	cli  dynamic.Interface
+	base config.Provider
+	cfg  config.CRDCfgWatcher
+}
+
+// NewCRDProvider returns new CRDProvider.
+func NewCRDProvider(log logrus.FieldLogger, cli dynamic.Interface, base config.Provider, cfg config.CRDCfgWatcher) *CRDProvider {
+	return &CRDProvider{
+		log:  log,
+		cli:  cli,
+		base: base,
+		cfg:  cfg,
+	}
+}
+
+// Configs returns the base configuration followed by the configuration of valid custom resources.
+// Each custom resource is validated together with the configuration loaded so far, and the result is reported
+// in its `Valid` status condition. Invalid custom resources are skipped.
+func (p *CRDProvider) Configs(ctx context.Context) (config.YAMLFiles, int, error) {
+	configs, version, err := p.base.Configs(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	baseCfg, err := config.MergeWithDefaults(configs)
+	if err != nil {
+		return nil, 0, fmt.Errorf("while merging base configuration: %w", err)
+	}
+	// aliases are not namespaced, so custom resources cannot override the existing ones
+	takenAliases := map[string]struct{}{}
+	for name := range baseCfg.Aliases {
+		takenAliases[name] = struct{}{}
+	}
+
+	accepted := map[schema.GroupVersionResource]map[string]struct{}{}
+	for _, gvr := range crdGVRs {
+		items, err := p.list(ctx, gvr)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		accepted[gvr] = map[string]struct{}{}
+		for idx := range items {
+			item := &items[idx]
+			fragment, err := p.configFragment(gvr, item, accepted, takenAliases)
+			if err == nil {
+				err = p.validate(configs, fragment)
+			}
+			p.reportStatus(ctx, gvr, item, err)
+			if err != nil {
+				p.log.Warnf("Skipping invalid %s %q: %s", item.GetKind(), crdKey(item), err.Error())
+				continue
+			}
+
+			accepted[gvr][crdKey(item)] = struct{}{}
+			if gvr == aliasGVR {
+				takenAliases[koanfSafeName(item.GetName())] = struct{}{}
+			}
+			configs = append(configs, fragment)
+		}
+	}
+
+	return configs, version, nil
+}
+
+// Watch calls onChange when the custom resources are created, updated or deleted.
+func (p *CRDProvider) Watch(ctx context.Context, onChange func(ctx context.Context)) error {
+	factory := dynamicinformer.NewFilteredDynamicSharedInformerFactory(p.cli, 0, p.informerNamespace(), nil)
+
+	changed := make(chan struct{}, 1)
+	notify := func() {
+		select {
+		case changed <- struct{}{}:
+		default:
+		}
+	}
+	handler := cache.ResourceEventHandlerFuncs{
+		AddFunc: func(any) { notify() },
+		UpdateFunc: func(oldObj, newObj any) {
+			oldItem, oldOK := oldObj.(*unstructured.Unstructured)
+			newItem, newOK := newObj.(*unstructured.Unstructured)
+			// status updates don't change the generation, so they don't trigger another reload
+			if oldOK && newOK && oldItem.GetGeneration() == newItem.GetGeneration() {
+				return
+			}
+			notify()
+		},
+		DeleteFunc: func(any) { notify() },
+	}
+	for _, gvr := range crdGVRs {
+		if _, err := factory.ForResource(gvr).Informer().AddEventHandler(handler); err != nil {
+			return fmt.Errorf("while adding event handler for %q: %w", gvr.Resource, err)
+		}
+	}
+
+	factory.Start(ctx.Done())
+	factory.WaitForCacheSync(ctx.Done())
+	// the initial list was already loaded by Configs
+	select {
+	case <-changed:
+	default:
+	}
+
+	p.log.Info("Watching configuration custom resources...")
+	for {
+		select {
+		case <-ctx.Done():
+			factory.Shutdown()
+			return nil
+		case <-changed:
+			select {
+			case <-ctx.Done():
+				factory.Shutdown()
+				return nil
+			case <-time.After(crdChangeDebounce):
+			}
+			p.log.Debug("Configuration custom resources changed. Reloading configuration...")
+			onChange(ctx)
+		}
+	}
+}
+
+func (p *CRDProvider) list(ctx context.Context, gvr schema.GroupVersionResource) ([]unstructured.Unstructured, error) {
+	var out []unstructured.Unstructured
+	for _, ns := range p.namespaces() {
+		list, err := p.cli.Resource(gvr).Namespace(ns).List(ctx, metav1.ListOptions{})
+		if apierrors.IsNotFound(err) {
+			p.log.Warnf("Custom resource %q is not installed in the cluster. Skipping...", gvr.Resource)
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("while listing %q: %w", gvr.Resource, err)
+		}
+		out = append(out, list.Items...)
+	}
+
+	// the order is stable, so the configuration doesn't change between reloads
+	sort.Slice(out, func(i, j int) bool {
+		return crdKey(&out[i]) < crdKey(&out[j])
+	})
+	return out, nil
+}
+
+func (p *CRDProvider) namespaces() []string {
+	if len(p.cfg.Namespaces) == 0 {
+		return []string{metav1.NamespaceAll}
+	}
+	return p.cfg.Namespaces
+}
+
+func (p *CRDProvider) informerNamespace() string {
+	if len(p.cfg.Namespaces) == 1 {
+		return p.cfg.Namespaces[0]
+	}
+	// events from other namespaces are also reloaded, but they are filtered out when listing resources
+	return metav1.NamespaceAll
+}
+
+// configFragment converts a given custom resource into a configuration file which is merged with the rest of the configuration.
+// Custom resources are owned by namespace users, so they are restricted to their namespace and cannot override the existing configuration.
+func (p *CRDProvider) configFragment(gvr schema.GroupVersionResource, item *unstructured.Unstructured, accepted map[schema.GroupVersionResource]map[string]struct{}, takenAliases map[string]struct{}) ([]byte, error) {
+	spec, found, err := unstructured.NestedMap(item.Object, "spec")
+	if err != nil {
+		return nil, fmt.Errorf("while getting spec: %w", err)
+	}
+	if !found {
+		return nil, errors.New("spec is required")
+	}
+
+	resolveBindings := func(fields ...string) error {
+		for _, field := range fields {
+			bindingGVR := sourceGVR
+			if field == "executors" {
+				bindingGVR = executorGVR
+			}
+
+			names, _, err := unstructured.NestedStringSlice(spec, "bindings", field)
+			if err != nil {
+				return fmt.Errorf("while getting %s bindings: %w", field, err)
+			}
+			for idx, name := range names {
+				resolved, err := resolveBinding(item.GetNamespace(), name, accepted[bindingGVR], p.cfg.AllowedBindings[item.GetNamespace()])
+				if err != nil {
+					return fmt.Errorf("%s binding: %w", field, err)
+				}
+				names[idx] = resolved
+			}
+			if len(names) > 0 {
+				if err := unstructured.SetNestedStringSlice(spec, names, "bindings", field); err != nil {
+					return fmt.Errorf("while setting %s bindings: %w", field, err)
+				}
+			}
+		}
+		return nil
+	}
+
+	var fragment map[string]any
+	switch gvr {
+	case sourceGVR:
+		if err := restrictPluginRBAC(item.GetNamespace(), spec); err != nil {
+			return nil, err
+		}
+		if err := restrictSourceNamespaces(item.GetNamespace(), spec); err != nil {
+			return nil, err
+		}
+		fragment = map[string]any{"sources": map[string]any{crdKey(item): spec}}
+	case executorGVR:
+		if err := restrictExecutorPlugins(item.GetNamespace(), spec, p.cfg.AllowedExecutorPlugins[item.GetNamespace()]); err != nil {
+			return nil, err
+		}
+		if err := restrictPluginRBAC(item.GetNamespace(), spec); err != nil {
+			return nil, err
+		}
+		fragment = map[string]any{"executors": map[string]any{crdKey(item): spec}}
+	case aliasGVR:
+		// aliases are typed in chat, so they are not prefixed with the namespace
+		name := koanfSafeName(item.GetName())
+		if _, found := takenAliases[name]; found {
+			return nil, fmt.Errorf("alias %q is already defined", name)
+		}
+		fragment = map[string]any{"aliases": map[string]any{name: spec}}
+	case actionGVR:
+		if err := resolveBindings("sources", "executors"); err != nil {
+			return nil, err
+		}
+		fragment = map[string]any{"actions": map[string]any{crdKey(item): spec}}
+	case channelBindingGVR:
+		if err := resolveBindings("sources", "executors"); err != nil {
+			return nil, err
+		}
+		fragment, err = channelBindingFragment(item, spec, p.cfg.AllowedChannels[item.GetNamespace()])
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported resource %q", gvr.Resource)
+	}
+
+	out, err := yaml.Marshal(fragment)
+	if err != nil {
+		return nil, fmt.Errorf("while marshaling configuration: %w", err)
+	}
+
+	// custom resources are owned by namespace users, who must not read Secrets with the Botkube permissions
+	hasRefs, err := HasValueRefs(out)
+	if err != nil {
+		return nil, err
+	}
+	if hasRefs {
+		return nil, fmt.Errorf("%q references are not supported in custom resources", valueFromKey)
+	}
+	return out, nil
+}
+
+// channelBindingFragment converts BotkubeChannelBinding into the channel configuration of a given communication platform.
+// Only channels allowed for the resource namespace can be bound.
+func channelBindingFragment(item *unstructured.Unstructured, spec map[string]any, allowedChannels []string) (map[string]any, error) {
+	group, _, _ := unstructured.NestedString(spec, "communicationGroup")
+	if group == "" {
+		group = defaultCommunicationGroup
+	}
+	platform, _, _ := unstructured.NestedString(spec, "platform")
+	channel, _, _ := unstructured.NestedString(spec, "channel")
+	if channel == "" {
+		return nil, errors.New("spec.channel is required")
+	}
+	if !slices.Contains(allowedChannels, channel) {
+		return nil, fmt.Errorf("channel %q is not allowed for namespace %q. Add it to the `configWatcher.crd.allowedChannels` configuration", channel, item.GetNamespace())
+	}
+
+	channelCfg := map[string]any{
+		"bindings": spec["bindings"],
+	}
+	if notification, found := spec["notification"]; found {
+		channelCfg["notification"] = notification
+	}
+
+	switch config.CommPlatformIntegration(platform) {
+	case config.SlackCommPlatformIntegration, config.SocketSlackCommPlatformIntegration, config.CloudSlackCommPlatformIntegration, config.MattermostCommPlatformIntegration:
+		channelCfg["name"] = channel
+	case config.DiscordCommPlatformIntegration:
+		channelCfg["id"] = channel
+	default:
+		return nil, fmt.Errorf("unsupported spec.platform %q. Supported platforms: %s", platform, strings.Join([]string{
+			string(config.SocketSlackCommPlatformIntegration),
+			string(config.CloudSlackCommPlatformIntegration),
+			string(config.SlackCommPlatformIntegration),
+			string(config.MattermostCommPlatformIntegration),
+			string(config.DiscordCommPlatformIntegration),
+		}, ", "))
+	}
+
+	return map[string]any{
+		"communications": map[string]any{
+			group: map[string]any{
+				platform: map[string]any{
+					"channels": map[string]any{crdKey(item): channelCfg},
+				},
+			},
+		},
+	}, nil
+}
+
+// restrictPluginRBAC ensures that plugins of a given custom resource can impersonate only ServiceAccounts from its namespace.
+// The `serviceAccount` RBAC property is converted into the static user and groups of a given ServiceAccount.
+func restrictPluginRBAC(namespace string, spec map[string]any) error {
+	for key, raw := range spec {
+		plugin, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		rbac, found, err := unstructured.NestedMap(plugin, "context", "rbac")
+		if err != nil {
+			return fmt.Errorf("while getting %s RBAC: %w", key, err)
+		}
+		if !found {
+			continue
+		}
+
+		name, _, err := unstructured.NestedString(rbac, serviceAccountRBACKey)
+		if err != nil || len(rbac) != 1 || name == "" {
+			return fmt.Errorf("%s: only %q is supported in context.rbac of custom resources", key, serviceAccountRBACKey)
+		}
+		if errs := validation.IsDNS1123Subdomain(name); len(errs) > 0 {
+			return fmt.Errorf("%s: invalid ServiceAccount name %q: %s", key, name, strings.Join(errs, ", "))
+		}
+
+		policy := map[string]any{
+			"user": map[string]any{
+				"type":   string(config.StaticPolicySubjectType),
+				"static": map[string]any{"value": fmt.Sprintf("system:serviceaccount:%s:%s", namespace, name)},
+			},
+			"group": map[string]any{
+				"type":   string(config.StaticPolicySubjectType),
+				"static": map[string]any{"values": []any{"system:serviceaccounts", "system:serviceaccounts:" + namespace}},
+			},
+		}
+		if err := unstructured.SetNestedMap(plugin, policy, "context", "rbac"); err != nil {
+			return fmt.Errorf("while setting %s RBAC: %w", key, err)
+		}
+	}
+	return nil
+}
+
+// restrictSourceNamespaces limits the Kubernetes source plugins of a given custom resource to its namespace.
+// Namespaces of all watched resources are overridden. Other source plugins cannot be restricted, so they are rejected.
+func restrictSourceNamespaces(namespace string, spec map[string]any) error {
+	namespaces := map[string]any{
+		"include": []any{fmt.Sprintf("^%s$", regexp.QuoteMeta(namespace))},
+	}
+	for key, raw := range spec {
+		plugin, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		repo, name, _, err := config.DecomposePluginKey(key)
+		if err != nil {
+			return err
+		}
+		if fmt.Sprintf("%s/%s", repo, name) != kubernetesSourcePlugin {
+			return fmt.Errorf("source plugin %q is not allowed, as it cannot be restricted to namespace %q. Only %q plugin is supported", key, namespace, kubernetesSourcePlugin)
+		}
+
+		if err := unstructured.SetNestedField(plugin, namespaces, "config", "namespaces"); err != nil {
+			return fmt.Errorf("while setting %s namespaces: %w", key, err)
+		}
+
+		resources, found, err := unstructured.NestedSlice(plugin, "config", "resources")
+		if err != nil {
+			return fmt.Errorf("while getting %s resources: %w", key, err)
+		}
+		if !found {
+			continue
+		}
+		for _, rawResource := range resources {
+			if resource, ok := rawResource.(map[string]any); ok {
+				resource["namespaces"] = runtime.DeepCopyJSONValue(namespaces)
+			}
+		}
+		if err := unstructured.SetNestedSlice(plugin, resources, "config", "resources"); err != nil {
+			return fmt.Errorf("while setting %s resources: %w", key, err)
+		}
+	}
+	return nil
+}
+
+// restrictExecutorPlugins ensures that a given custom resource enables only the executor plugins allowed for its namespace.
+func restrictExecutorPlugins(namespace string, spec map[string]any, allowedPlugins []string) error {
+	for key, raw := range spec {
+		if _, ok := raw.(map[string]any); !ok {
+			continue
+		}
+		repo, name, _, err := config.DecomposePluginKey(key)
+		if err != nil {
+			return err
+		}
+		if !slices.Contains(allowedPlugins, fmt.Sprintf("%s/%s", repo, name)) {
+			return fmt.Errorf("executor plugin %q is not allowed for namespace %q. Add it to the `configWatcher.crd.allowedExecutorPlugins` configuration", key, namespace)
+		}
+	}
+	return nil
+}
+
+// validate loads a given fragment together with the configuration loaded so far.
+func (p *CRDProvider) validate(configs config.YAMLFiles, fragment []byte) error {
+	all := append(append(config.YAMLFiles{}, configs...), fragment)
+	_, _, err := config.LoadWithDefaults(all)
+	return err
+}
+
+// reportStatus sets the `Valid` condition of a given custom resource. The resource is updated only if the condition changes.
+func (p *CRDProvider) reportStatus(ctx context.Context, gvr schema.GroupVersionResource, item *unstructured.Unstructured, validationErr error) {
+	condition := metav1.Condition{
+		Type:               validConditionType,
+		Status:             metav1.ConditionTrue,
+		Reason:             validationSucceededReason,
+		Message:            "Configuration is valid and loaded.",
+		ObservedGeneration: item.GetGeneration(),
+	}
+	if validationErr != nil {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = validationFailedReason
+		condition.Message = validationErr.Error()
+	}
+
+	var conditions []metav1.Condition
+	rawConditions, _, _ := unstructured.NestedSlice(item.Object, "status", "conditions")
+	for _, raw := range rawConditions {
+		rawMap, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		var c metav1.Condition
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(rawMap, &c); err != nil {
+			continue
+		}
+		conditions = append(conditions, c)
+	}
+
+	current := meta.FindStatusCondition(conditions, validConditionType)
+	if current != nil && current.Status == condition.Status && current.Message == condition.Message && current.ObservedGeneration == condition.ObservedGeneration {
+		return
+	}
+	meta.SetStatusCondition(&conditions, condition)
+
+	out := make([]any, 0, len(conditions))
+	for _, c := range conditions {
+		raw, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&c)
+		if err != nil {
+			p.log.Errorf("while converting status condition of %q: %s", crdKey(item), err.Error())
+			return
+		}
+		out = append(out, raw)
+	}
+
+	updated := item.DeepCopy()
+	if err := unstructured.SetNestedSlice(updated.Object, out, "status", "conditions"); err != nil {
+		p.log.Errorf("while setting status condition of %q: %s", crdKey(item), err.Error())
+		return
+	}
+	_, err := p.cli.Resource(gvr).Namespace(item.GetNamespace()).UpdateStatus(ctx, updated, metav1.UpdateOptions{FieldManager: crdFieldManager})
+	if err != nil {
+		p.log.Errorf("while updating status of %q: %s", crdKey(item), err.Error())
+	}
+}
+
+// resolveBinding returns the name of an accepted resource from a given namespace. The name can be specified with or without
+// the namespace prefix. Names of other resources are returned as they are only if they are explicitly allowed.
+func resolveBinding(namespace, name string, accepted map[string]struct{}, allowedBindings []string) (string, error) {
+	if slices.Contains(allowedBindings, name) {
+		return name, nil
+	}
+
+	resourceName := name
+	if ns, nsName, found := strings.Cut(name, "/"); found {
+		if ns != namespace {
+			return "", fmt.Errorf("%q refers to a different namespace than %q. Add it to the `configWatcher.crd.allowedBindings` configuration", name, namespace)
+		}
+		resourceName = nsName
+	}
+
+	namespaced := fmt.Sprintf("%s/%s", namespace, koanfSafeName(resourceName))
+	if _, found := accepted[namespaced]; !found {
+		return "", fmt.Errorf("%q doesn't refer to a valid resource from namespace %q. To bind other resources, add them to the `configWatcher.crd.allowedBindings` configuration", name, namespace)
+	}
+	return namespaced, nil
+}
+
+// crdKey returns the configuration name of a given custom resource.
+func crdKey(item *unstructured.Unstructured) string {
+	return fmt.Sprintf("%s/%s", item.GetNamespace(), koanfSafeName(item.GetName()))
+}
+
+// koanfSafeName replaces dots, which are used as the configuration key delimiter.
+func koanfSafeName(in string) string {
+	return strings.ReplaceAll(in, ".", "-")
+}
+
+func crdGVR(resource string) schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: CRDGroup, Version: CRDVersion, Resource: resource}
+}
@@ -0,0 +1,361 @@
+package config
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+type fakeBaseProvider struct {
+	configs config.YAMLFiles
+}
+
+func (f *fakeBaseProvider) Configs(context.Context) (config.YAMLFiles, int, error) {
+	return f.configs, 0, nil
+}
+
+func TestCRDProviderConfigs(t *testing.T) {
+	// given
+	base := &fakeBaseProvider{configs: config.YAMLFiles{[]byte(`
+communications:
+  default-group:
+    socketSlack:
+      enabled: false
+`)}}
+	source := fixCRDObject("BotkubeSource", "team-a", "k8s.events", map[string]any{
+		"displayName": "Team A events",
+	})
+	binding := fixCRDObject("BotkubeChannelBinding", "team-a", "alerts", map[string]any{
+		"platform": "socketSlack",
+		"channel":  "team-a-alerts",
+		"bindings": map[string]any{
+			"sources": []any{"k8s.events"},
+		},
+	})
+	invalidAlias := fixCRDObject("BotkubeAlias", "team-a", "kgp", map[string]any{
+		"displayName": "Missing command",
+	})
+	cli := fixCRDClient(source, binding, invalidAlias)
+	provider := NewCRDProvider(loggerx.NewNoop(), cli, base, config.CRDCfgWatcher{
+		Enabled: true,
+		AllowedChannels: map[string][]string{
+			"team-a": {"team-a-alerts"},
+		},
+	})
+
+	// when
+	configs, _, err := provider.Configs(context.Background())
+
+	// then
+	require.NoError(t, err)
+	cfg, _, err := config.LoadWithDefaults(configs)
+	require.NoError(t, err)
+
+	assert.Contains(t, cfg.Sources, "team-a/k8s-events")
+	assert.Equal(t, "Team A events", cfg.Sources["team-a/k8s-events"].DisplayName)
+	assert.NotContains(t, cfg.Aliases, "kgp")
+
+	channel, found := cfg.Communications["default-group"].SocketSlack.Channels["team-a/alerts"]
+	require.True(t, found)
+	assert.Equal(t, "team-a-alerts", channel.Name)
+	assert.Equal(t, []string{"team-a/k8s-events"}, channel.Bindings.Sources)
+
+	assertValidCondition(t, cli, sourceGVR, source, metav1.ConditionTrue)
+	assertValidCondition(t, cli, channelBindingGVR, binding, metav1.ConditionTrue)
+	assertValidCondition(t, cli, aliasGVR, invalidAlias, metav1.ConditionFalse)
+}
+
+func TestCRDProviderConfigsRejectsValueRefs(t *testing.T) {
+	// given
+	executor := fixCRDObject("BotkubeExecutor", "team-a", "gh", map[string]any{
+		"botkube/gh": map[string]any{
+			"enabled": true,
+			"config": map[string]any{
+				"token": map[string]any{
+					"valueFrom": map[string]any{
+						"secretKeyRef": map[string]any{"namespace": "botkube", "name": "slack-creds", "key": "botToken"},
+					},
+				},
+			},
+		},
+	})
+	cli := fixCRDClient(executor)
+	provider := NewCRDProvider(loggerx.NewNoop(), cli, &fakeBaseProvider{}, config.CRDCfgWatcher{
+		Enabled: true,
+		AllowedExecutorPlugins: map[string][]string{
+			"team-a": {"botkube/gh"},
+		},
+	})
+
+	// when
+	configs, _, err := provider.Configs(context.Background())
+
+	// then
+	require.NoError(t, err)
+	assert.Empty(t, configs)
+	assertValidCondition(t, cli, executorGVR, executor, metav1.ConditionFalse)
+}
+
+func TestCRDProviderConfigsRestrictsResourcesToNamespace(t *testing.T) {
+	// given
+	base := &fakeBaseProvider{configs: config.YAMLFiles{[]byte(`
+aliases:
+  kc:
+    command: list executors
+communications:
+  default-group:
+    socketSlack:
+      enabled: false
+`)}}
+	source := fixCRDObject("BotkubeSource", "team-a", "k8s-events", map[string]any{
+		"botkube/kubernetes": map[string]any{
+			"enabled": true,
+			"context": map[string]any{
+				"rbac": map[string]any{"serviceAccount": "botkube-events"},
+			},
+			"config": map[string]any{
+				"namespaces": map[string]any{"include": []any{".*"}},
+				"resources": []any{
+					map[string]any{
+						"type":       "v1/pods",
+						"namespaces": map[string]any{"include": []any{"kube-system"}},
+					},
+				},
+			},
+		},
+	})
+	otherSource := fixCRDObject("BotkubeSource", "team-a", "prometheus", map[string]any{
+		"botkube/prometheus": map[string]any{
+			"enabled": true,
+		},
+	})
+	executor := fixCRDObject("BotkubeExecutor", "team-a", "kubectl", map[string]any{
+		"botkube/kubectl": map[string]any{
+			"enabled": true,
+			"context": map[string]any{
+				"rbac": map[string]any{"serviceAccount": "botkube-kubectl"},
+			},
+		},
+	})
+	staticRBACExecutor := fixCRDObject("BotkubeExecutor", "team-a", "admin", map[string]any{
+		"botkube/kubectl": map[string]any{
+			"enabled": true,
+			"context": map[string]any{
+				"rbac": map[string]any{
+					"user":  map[string]any{"type": "Static", "static": map[string]any{"value": "admin"}},
+					"group": map[string]any{"type": "Static", "static": map[string]any{"values": []any{"system:masters"}}},
+				},
+			},
+		},
+	})
+	clashingAlias := fixCRDObject("BotkubeAlias", "team-a", "kc", map[string]any{
+		"command": "list sources",
+	})
+	notAllowedBinding := fixCRDObject("BotkubeChannelBinding", "team-a", "general", map[string]any{
+		"platform": "socketSlack",
+		"channel":  "general",
+	})
+	otherNamespaceBinding := fixCRDObject("BotkubeChannelBinding", "team-a", "alerts", map[string]any{
+		"platform": "socketSlack",
+		"channel":  "team-a-alerts",
+		"bindings": map[string]any{
+			"executors": []any{"team-b/kubectl"},
+		},
+	})
+	cli := fixCRDClient(source, otherSource, executor, staticRBACExecutor, clashingAlias, notAllowedBinding, otherNamespaceBinding)
+	provider := NewCRDProvider(loggerx.NewNoop(), cli, base, config.CRDCfgWatcher{
+		Enabled: true,
+		AllowedChannels: map[string][]string{
+			"team-a": {"team-a-alerts"},
+		},
+		AllowedExecutorPlugins: map[string][]string{
+			"team-a": {"botkube/kubectl"},
+		},
+	})
+
+	// when
+	configs, _, err := provider.Configs(context.Background())
+
+	// then
+	require.NoError(t, err)
+	cfg, _, err := config.LoadWithDefaults(configs)
+	require.NoError(t, err)
+
+	expRBAC := func(name string) *config.PolicyRule {
+		return &config.PolicyRule{
+			User: config.UserPolicySubject{
+				Type:   config.StaticPolicySubjectType,
+				Static: config.UserStaticSubject{Value: "system:serviceaccount:team-a:" + name},
+			},
+			Group: config.GroupPolicySubject{
+				Type:   config.StaticPolicySubjectType,
+				Static: config.GroupStaticSubject{Values: []string{"system:serviceaccounts", "system:serviceaccounts:team-a"}},
+			},
+		}
+	}
+
+	sourcePlugin := cfg.Sources["team-a/k8s-events"].Plugins["botkube/kubernetes"]
+	assert.Equal(t, expRBAC("botkube-events"), sourcePlugin.Context.RBAC)
+	assert.Equal(t, map[string]any{
+		"namespaces": map[string]any{"include": []any{"^team-a$"}},
+		"resources": []any{
+			map[string]any{
+				"type":       "v1/pods",
+				"namespaces": map[string]any{"include": []any{"^team-a$"}},
+			},
+		},
+	}, sourcePlugin.Config)
+
+	assert.NotContains(t, cfg.Sources, "team-a/prometheus")
+	assert.Equal(t, expRBAC("botkube-kubectl"), cfg.Executors["team-a/kubectl"].Plugins["botkube/kubectl"].Context.RBAC)
+	assert.NotContains(t, cfg.Executors, "team-a/admin")
+	assert.Equal(t, "list executors", cfg.Aliases["kc"].Command)
+	assert.Empty(t, cfg.Communications["default-group"].SocketSlack.Channels)
+
+	assertValidCondition(t, cli, sourceGVR, source, metav1.ConditionTrue)
+	assertValidCondition(t, cli, sourceGVR, otherSource, metav1.ConditionFalse)
+	assertValidCondition(t, cli, executorGVR, executor, metav1.ConditionTrue)
+	assertValidCondition(t, cli, executorGVR, staticRBACExecutor, metav1.ConditionFalse)
+	assertValidCondition(t, cli, aliasGVR, clashingAlias, metav1.ConditionFalse)
+	assertValidCondition(t, cli, channelBindingGVR, notAllowedBinding, metav1.ConditionFalse)
+	assertValidCondition(t, cli, channelBindingGVR, otherNamespaceBinding, metav1.ConditionFalse)
+}
+
+func TestCRDProviderConfigsRestrictsBindingsAndExecutorPlugins(t *testing.T) {
+	// given
+	base := &fakeBaseProvider{configs: config.YAMLFiles{[]byte(`
+sources:
+  k8s-err-events:
+    displayName: Errors
+  k8s-all-events:
+    displayName: All events
+communications:
+  default-group:
+    socketSlack:
+      enabled: false
+`)}}
+	executor := fixCRDObject("BotkubeExecutor", "team-a", "kubectl", map[string]any{
+		"botkube/kubectl": map[string]any{"enabled": true},
+	})
+	notAllowedExecutor := fixCRDObject("BotkubeExecutor", "team-a", "helm", map[string]any{
+		"botkube/helm": map[string]any{"enabled": true},
+	})
+	otherNamespaceExecutor := fixCRDObject("BotkubeExecutor", "team-b", "kubectl", map[string]any{
+		"botkube/kubectl": map[string]any{"enabled": true},
+	})
+	binding := fixCRDObject("BotkubeChannelBinding", "team-a", "alerts", map[string]any{
+		"platform": "socketSlack",
+		"channel":  "team-a-alerts",
+		"bindings": map[string]any{
+			"sources":   []any{"k8s-err-events"},
+			"executors": []any{"kubectl", "team-a/kubectl"},
+		},
+	})
+	baseSourceBinding := fixCRDObject("BotkubeChannelBinding", "team-a", "all-events", map[string]any{
+		"platform": "socketSlack",
+		"channel":  "team-a-alerts",
+		"bindings": map[string]any{
+			"sources": []any{"k8s-all-events"},
+		},
+	})
+	notAcceptedBinding := fixCRDObject("BotkubeChannelBinding", "team-a", "helm", map[string]any{
+		"platform": "socketSlack",
+		"channel":  "team-a-alerts",
+		"bindings": map[string]any{
+			"executors": []any{"team-a/helm"},
+		},
+	})
+	otherNamespaceBinding := fixCRDObject("BotkubeChannelBinding", "team-a", "team-b", map[string]any{
+		"platform": "socketSlack",
+		"channel":  "team-a-alerts",
+		"bindings": map[string]any{
+			"executors": []any{"team-b/kubectl"},
+		},
+	})
+	cli := fixCRDClient(executor, notAllowedExecutor, otherNamespaceExecutor, binding, baseSourceBinding, notAcceptedBinding, otherNamespaceBinding)
+	provider := NewCRDProvider(loggerx.NewNoop(), cli, base, config.CRDCfgWatcher{
+		Enabled: true,
+		AllowedChannels: map[string][]string{
+			"team-a": {"team-a-alerts"},
+		},
+		AllowedBindings: map[string][]string{
+			"team-a": {"k8s-err-events"},
+		},
+		AllowedExecutorPlugins: map[string][]string{
+			"team-a": {"botkube/kubectl"},
+		},
+	})
+
+	// when
+	configs, _, err := provider.Configs(context.Background())
+
+	// then
+	require.NoError(t, err)
+	cfg, _, err := config.LoadWithDefaults(configs)
+	require.NoError(t, err)
+
+	assert.Contains(t, cfg.Executors, "team-a/kubectl")
+	assert.NotContains(t, cfg.Executors, "team-a/helm")
+	assert.NotContains(t, cfg.Executors, "team-b/kubectl")
+
+	channels := cfg.Communications["default-group"].SocketSlack.Channels
+	require.Len(t, channels, 1)
+	assert.Equal(t, []string{"k8s-err-events"}, channels["team-a/alerts"].Bindings.Sources)
+	assert.Equal(t, []string{"team-a/kubectl", "team-a/kubectl"}, channels["team-a/alerts"].Bindings.Executors)
+
+	assertValidCondition(t, cli, executorGVR, executor, metav1.ConditionTrue)
+	assertValidCondition(t, cli, executorGVR, notAllowedExecutor, metav1.ConditionFalse)
+	assertValidCondition(t, cli, executorGVR, otherNamespaceExecutor, metav1.ConditionFalse)
+	assertValidCondition(t, cli, channelBindingGVR, binding, metav1.ConditionTrue)
+	assertValidCondition(t, cli, channelBindingGVR, baseSourceBinding, metav1.ConditionFalse)
+	assertValidCondition(t, cli, channelBindingGVR, notAcceptedBinding, metav1.ConditionFalse)
+	assertValidCondition(t, cli, channelBindingGVR, otherNamespaceBinding, metav1.ConditionFalse)
+}
+
+func fixCRDClient(objects ...runtime.Object) *dynamicfake.FakeDynamicClient {
+	return dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		sourceGVR:         "BotkubeSourceList",
+		executorGVR:       "BotkubeExecutorList",
+		aliasGVR:          "BotkubeAliasList",
+		actionGVR:         "BotkubeActionList",
+		channelBindingGVR: "BotkubeChannelBindingList",
+	}, objects...)
+}
+
+func fixCRDObject(kind, namespace, name string, spec map[string]any) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": CRDGroup + "/" + CRDVersion,
+		"kind":       kind,
+		"metadata": map[string]any{
+			"namespace":  namespace,
+			"name":       name,
+			"generation": int64(1),
+		},
+		"spec": spec,
+	}}
+}
+
+func assertValidCondition(t *testing.T, cli *dynamicfake.FakeDynamicClient, gvr schema.GroupVersionResource, item *unstructured.Unstructured, exp metav1.ConditionStatus) {
+	t.Helper()
+
+	got, err := cli.Resource(gvr).Namespace(item.GetNamespace()).Get(context.Background(), item.GetName(), metav1.GetOptions{})
+	require.NoError(t, err)
+
+	conditions, _, err := unstructured.NestedSlice(got.Object, "status", "conditions")
+	require.NoError(t, err)
+	require.Len(t, conditions, 1)
+	condition, ok := conditions[0].(map[string]any)
+	require.True(t, ok)
+	assert.Equal(t, validConditionType, condition["type"])
+	assert.Equal(t, string(exp), condition["status"])
+}
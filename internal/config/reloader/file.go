@@ -0,0 +1,65 @@
+package reloader
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+var _ Reloader = (*FileConfigReloader)(nil)
+
+// FileConfigReloader reloads configuration from files. It's called by the config watcher once the files change.
+type FileConfigReloader struct {
+	log         logrus.FieldLogger
+	provider    config.Provider
+	hotReloader *HotReloader
+
+	mu         sync.Mutex
+	currentCfg config.Config
+}
+
+// NewFileConfigReloader returns new FileConfigReloader.
+func NewFileConfigReloader(log logrus.FieldLogger, provider config.Provider, hotReloader *HotReloader, cfg config.Config) *FileConfigReloader {
+	return &FileConfigReloader{
+		log:         log,
+		provider:    provider,
+		hotReloader: hotReloader,
+		currentCfg:  cfg,
+	}
+}
+
+// Do loads the configuration files and applies changes.
+func (r *FileConfigReloader) Do(ctx context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	configs, _, err := r.provider.Configs(ctx)
+	if err != nil {
+		return fmt.Errorf("while loading configuration files: %w", err)
+	}
+
+	newCfg, _, err := config.LoadWithDefaults(configs)
+	if err != nil {
+		return fmt.Errorf("while loading new config: %w", err)
+	}
+	if newCfg == nil {
+		return fmt.Errorf("new config is nil")
+	}
+
+	changed, err := ChangedSubsystems(r.currentCfg, *newCfg)
+	if err != nil {
+		return err
+	}
+	if len(changed) == 0 {
+		r.log.Debug("Configuration files didn't change. No need to reload config")
+		return nil
+	}
+	r.log.Debugf("Detected config changes of %s", subsystemsString(changed))
+
+	r.currentCfg = *newCfg
+	return r.hotReloader.Apply(ctx, changed, *newCfg)
+}
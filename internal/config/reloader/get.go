@@ -7,9 +7,9 @@ import (
 )
 
 // Get returns Reloader based on remoteCfgEnabled flag.
-func Get(remoteCfgEnabled bool, log logrus.FieldLogger, deployCli DeploymentClient, restarter *Restarter, cfg config.Config, cfgVer int, resVerHolders ...ResourceVersionHolder) Reloader {
+func Get(remoteCfgEnabled bool, log logrus.FieldLogger, deployCli DeploymentClient, valueRefs ValueRefResolver, hotReloader *HotReloader, cfg config.Config, cfgVer int, resVerHolders ...ResourceVersionHolder) Reloader {
 	if remoteCfgEnabled {
-		return NewRemote(log, deployCli, restarter, cfg, cfgVer, resVerHolders...)
+		return NewRemote(log, deployCli, valueRefs, hotReloader, cfg, cfgVer, resVerHolders...)
 	}
 
 	return NewNoopReloader()
@@ -0,0 +1,131 @@
+package reloader
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/r3labs/diff/v3"
+	"github.com/sirupsen/logrus"
+
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+// Subsystem is a top-level part of the configuration, such as aliases or actions.
+type Subsystem string
+
+const (
+	// AliasesSubsystem represents the command aliases configuration.
+	AliasesSubsystem Subsystem = "Aliases"
+	// ActionsSubsystem represents the automated actions configuration.
+	ActionsSubsystem Subsystem = "Actions"
+	// ExecutorsSubsystem represents the executors configuration.
+	ExecutorsSubsystem Subsystem = "Executors"
+	// RoutingSubsystem represents the routing rules for source notifications.
+	RoutingSubsystem Subsystem = "Routing"
+	// SourcesSubsystem represents the sources configuration.
+	SourcesSubsystem Subsystem = "Sources"
+	// CommunicationsSubsystem represents the communication platforms configuration.
+	CommunicationsSubsystem Subsystem = "Communications"
+)
+
+// ErrRestartRequired is returned by ApplyFn when a given change cannot be applied in-process.
+var ErrRestartRequired = errors.New("restart required")
+
+// ApplyFn applies a new configuration of a given subsystem in-process.
+type ApplyFn func(ctx context.Context, cfg config.Config) error
+
+// HotReloader applies configuration changes without restarting the app. Changes to subsystems
+// which don't have an ApplyFn registered fall back to the full restart.
+type HotReloader struct {
+	log       logrus.FieldLogger
+	restarter Reloader
+
+	mu       sync.RWMutex
+	appliers map[Subsystem]ApplyFn
+}
+
+// NewHotReloader returns new HotReloader.
+func NewHotReloader(log logrus.FieldLogger, restarter Reloader) *HotReloader {
+	return &HotReloader{
+		log:       log,
+		restarter: restarter,
+		appliers:  map[Subsystem]ApplyFn{},
+	}
+}
+
+// Register registers a function which applies the configuration of a given subsystem in-process.
+func (r *HotReloader) Register(subsystem Subsystem, fn ApplyFn) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.appliers[subsystem] = fn
+}
+
+// Apply applies the new configuration for changed subsystems. If any of them can't be applied in-process,
+// the app is restarted.
+func (r *HotReloader) Apply(ctx context.Context, changed []Subsystem, cfg config.Config) error {
+	if len(changed) == 0 {
+		return nil
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var fns []ApplyFn
+	for _, subsystem := range changed {
+		fn, found := r.appliers[subsystem]
+		if !found {
+			r.log.Infof("Configuration of %q requires restart. Reloading configuration...", subsystem)
+			return r.restarter.Do(ctx)
+		}
+		fns = append(fns, fn)
+	}
+
+	for idx, fn := range fns {
+		if err := fn(ctx, cfg); err != nil {
+			if errors.Is(err, ErrRestartRequired) {
+				r.log.Infof("Configuration change of %q requires restart: %s. Reloading configuration...", changed[idx], err.Error())
+				return r.restarter.Do(ctx)
+			}
+			r.log.Errorf("while applying configuration of %q: %s. Reloading configuration...", changed[idx], err.Error())
+			return r.restarter.Do(ctx)
+		}
+	}
+
+	r.log.Infof("Applied configuration changes of %s without restart.", subsystemsString(changed))
+	return nil
+}
+
+// ChangedSubsystems returns sorted subsystems which differ between the two configurations.
+func ChangedSubsystems(current, updated config.Config) ([]Subsystem, error) {
+	changelog, err := diff.Diff(current, updated, diff.DisableStructValues(), diff.SliceOrdering(false), diff.AllowTypeMismatch(true))
+	if err != nil {
+		return nil, fmt.Errorf("while diffing configs: %w", err)
+	}
+
+	set := map[Subsystem]struct{}{}
+	for _, change := range changelog {
+		if len(change.Path) == 0 {
+			continue
+		}
+		set[Subsystem(change.Path[0])] = struct{}{}
+	}
+
+	out := make([]Subsystem, 0, len(set))
+	for subsystem := range set {
+		out = append(out, subsystem)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out, nil
+}
+
+func subsystemsString(in []Subsystem) string {
+	out := make([]string, 0, len(in))
+	for _, subsystem := range in {
+		out = append(out, fmt.Sprintf("%q", subsystem))
+	}
+	return strings.Join(out, ", ")
+}
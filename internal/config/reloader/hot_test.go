@@ -0,0 +1,140 @@
+package reloader
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+func TestChangedSubsystems(t *testing.T) {
+	// given
+	current := fixConfig(false)
+	updated := fixConfig(true)
+	updated.Aliases = config.Aliases{
+		"k": {Command: "kubectl"},
+	}
+
+	// when
+	changed, err := ChangedSubsystems(current, updated)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []Subsystem{ActionsSubsystem, AliasesSubsystem}, changed)
+}
+
+func TestHotReloader_Apply(t *testing.T) {
+	testCases := []struct {
+		Name            string
+		Changed         []Subsystem
+		ApplyErr        error
+		ExpectedApplied []Subsystem
+		ExpectedRestart bool
+	}{
+		{
+			Name:            "Apply changes in-process",
+			Changed:         []Subsystem{ActionsSubsystem, AliasesSubsystem},
+			ExpectedApplied: []Subsystem{ActionsSubsystem, AliasesSubsystem},
+		},
+		{
+			Name:            "Restart when subsystem can't be reloaded in-process",
+			Changed:         []Subsystem{AliasesSubsystem, "Settings"},
+			ExpectedRestart: true,
+		},
+		{
+			Name:            "Restart when applying changes fails",
+			Changed:         []Subsystem{AliasesSubsystem},
+			ApplyErr:        errors.New("test error"),
+			ExpectedApplied: []Subsystem{AliasesSubsystem},
+			ExpectedRestart: true,
+		},
+		{
+			Name:            "Restart when change requires it",
+			Changed:         []Subsystem{AliasesSubsystem},
+			ApplyErr:        fmt.Errorf("%w: Slack was disabled", ErrRestartRequired),
+			ExpectedApplied: []Subsystem{AliasesSubsystem},
+			ExpectedRestart: true,
+		},
+		{
+			Name: "No changes",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// given
+			restarter := &fakeReloader{}
+			hotReloader := NewHotReloader(loggerx.NewNoop(), restarter)
+
+			var applied []Subsystem
+			for _, subsystem := range []Subsystem{ActionsSubsystem, AliasesSubsystem} {
+				subsystem := subsystem
+				hotReloader.Register(subsystem, func(context.Context, config.Config) error {
+					applied = append(applied, subsystem)
+					return testCase.ApplyErr
+				})
+			}
+
+			// when
+			err := hotReloader.Apply(context.Background(), testCase.Changed, config.Config{})
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, testCase.ExpectedApplied, applied)
+			assert.Equal(t, testCase.ExpectedRestart, restarter.called)
+		})
+	}
+}
+
+func TestFileConfigReloader_Do(t *testing.T) {
+	// given
+	restarter := &fakeReloader{}
+	hotReloader := NewHotReloader(loggerx.NewNoop(), restarter)
+	var appliedCfg config.Config
+	hotReloader.Register(ActionsSubsystem, func(_ context.Context, cfg config.Config) error {
+		appliedCfg = cfg
+		return nil
+	})
+	provider := &fakeProvider{configs: config.YAMLFiles{[]byte(fixConfigStr(true))}}
+	fileReloader := NewFileConfigReloader(loggerx.NewNoop(), provider, hotReloader, fixConfig(false))
+
+	// when
+	err := fileReloader.Do(context.Background())
+
+	// then
+	require.NoError(t, err)
+	assert.False(t, restarter.called)
+	assert.Equal(t, fixConfig(true).Actions, appliedCfg.Actions)
+
+	// when the files didn't change since the last reload
+	appliedCfg = config.Config{}
+	err = fileReloader.Do(context.Background())
+
+	// then
+	require.NoError(t, err)
+	assert.False(t, restarter.called)
+	assert.Empty(t, appliedCfg.Actions)
+}
+
+type fakeReloader struct {
+	called bool
+}
+
+func (r *fakeReloader) Do(context.Context) error {
+	r.called = true
+	return nil
+}
+
+type fakeProvider struct {
+	configs config.YAMLFiles
+}
+
+func (p *fakeProvider) Configs(context.Context) (config.YAMLFiles, int, error) {
+	return p.configs, 0, nil
+}
@@ -3,10 +3,9 @@ package reloader
 import (
 	"context"
 	"fmt"
-	"strings"
+	"sync"
 	"time"
 
-	"github.com/r3labs/diff/v3"
 	"github.com/sirupsen/logrus"
 	"gopkg.in/yaml.v3"
 
@@ -20,16 +19,22 @@ type DeploymentClient interface {
 	GetConfigWithResourceVersion(ctx context.Context) (remote.Deployment, error)
 }
 
+// ValueRefResolver resolves `valueFrom` references in the configuration.
+type ValueRefResolver interface {
+	ResolveRefs(ctx context.Context, configs config.YAMLFiles) (config.YAMLFiles, error)
+}
+
 // NewRemote returns new RemoteConfigReloader.
-func NewRemote(log logrus.FieldLogger, deployCli DeploymentClient, restarter *Restarter, cfg config.Config, cfgVer int, resVerHolders ...ResourceVersionHolder) *RemoteConfigReloader {
+func NewRemote(log logrus.FieldLogger, deployCli DeploymentClient, valueRefs ValueRefResolver, hotReloader *HotReloader, cfg config.Config, cfgVer int, resVerHolders ...ResourceVersionHolder) *RemoteConfigReloader {
 	return &RemoteConfigReloader{
 		log:           log,
 		currentCfg:    cfg,
 		resVersion:    cfgVer,
 		interval:      cfg.ConfigWatcher.Remote.PollInterval,
 		deployCli:     deployCli,
+		valueRefs:     valueRefs,
 		resVerHolders: resVerHolders,
-		restarter:     restarter,
+		hotReloader:   hotReloader,
 	}
 }
 
@@ -39,11 +44,13 @@ type RemoteConfigReloader struct {
 	interval      time.Duration
 	resVerHolders []ResourceVersionHolder
 
+	mu         sync.Mutex
 	currentCfg config.Config
 	resVersion int
 
-	deployCli DeploymentClient
-	restarter *Restarter
+	deployCli   DeploymentClient
+	valueRefs   ValueRefResolver
+	hotReloader *HotReloader
 }
 
 // Do starts the remote config reloader.
@@ -59,52 +66,73 @@ func (u *RemoteConfigReloader) Do(ctx context.Context) error {
 			u.log.Info("Shutdown requested. Finishing...")
 			return nil
 		case <-ticker.C:
-			u.log.Debug("Querying the latest configuration...")
-
-			resVer, err := u.queryResourceVersion(ctx)
-			if err != nil {
+			// changes which can't be applied in-process fall back to the restarter, so errors are only logged
+			if err := u.update(ctx); err != nil {
 				u.log.Error(err.Error())
-				continue
 			}
+		}
+	}
+}
 
-			shouldUpdateCfg, err := u.compareResVer(resVer)
-			if err != nil {
-				u.log.Error(err.Error())
-				continue
-			}
+// Reload applies the latest configuration even if its version didn't change, e.g. after a referenced Secret was rotated.
+func (u *RemoteConfigReloader) Reload(ctx context.Context) error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
 
-			if !shouldUpdateCfg {
-				u.log.Debugf("Config version (%d) is the same as the latest one. Skipping...", resVer)
-				continue
-			}
+	cfgBytes, resVer, err := u.queryConfig(ctx)
+	if err != nil {
+		return fmt.Errorf("while getting latest config: %w", err)
+	}
+	if _, err := u.compareResVer(resVer); err != nil {
+		return err
+	}
+	u.setResourceVersionForAll(resVer)
 
-			u.log.Debugf("Config version changed (%d). Querying the latest config...", resVer)
-			cfgBytes, resVer, err := u.queryConfig(ctx)
-			if err != nil {
-				wrappedErr := fmt.Errorf("while getting latest config: %w", err)
-				u.log.Error(wrappedErr.Error())
-				continue
-			}
+	cfgDiff, err := u.updateConfig(ctx, cfgBytes, resVer)
+	if err != nil {
+		return fmt.Errorf("while processing new config: %w", err)
+	}
+	if err := u.hotReloader.Apply(ctx, cfgDiff.changed, u.currentCfg); err != nil {
+		return fmt.Errorf("while applying new config: %w", err)
+	}
+	return nil
+}
 
-			cfgDiff, err := u.processNewConfig(cfgBytes, resVer)
-			if err != nil {
-				wrappedErr := fmt.Errorf("while processing new config: %w", err)
-				u.log.Error(wrappedErr.Error())
-				continue
-			}
+func (u *RemoteConfigReloader) update(ctx context.Context) error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
 
-			if !cfgDiff.shouldRestart {
-				continue
-			}
+	u.log.Debug("Querying the latest configuration...")
+	resVer, err := u.queryResourceVersion(ctx)
+	if err != nil {
+		return err
+	}
 
-			u.log.Info("Reloading configuration...")
-			err = u.restarter.Do(ctx)
-			if err != nil {
-				u.log.Errorf("while restarting the app: %s", err.Error())
-				return fmt.Errorf("while restarting the app: %w", err)
-			}
-		}
+	shouldUpdateCfg, err := u.compareResVer(resVer)
+	if err != nil {
+		return err
+	}
+
+	if !shouldUpdateCfg {
+		u.log.Debugf("Config version (%d) is the same as the latest one. Skipping...", resVer)
+		return nil
 	}
+
+	u.log.Debugf("Config version changed (%d). Querying the latest config...", resVer)
+	cfgBytes, resVer, err := u.queryConfig(ctx)
+	if err != nil {
+		return fmt.Errorf("while getting latest config: %w", err)
+	}
+
+	cfgDiff, err := u.processNewConfig(ctx, cfgBytes, resVer)
+	if err != nil {
+		return fmt.Errorf("while processing new config: %w", err)
+	}
+
+	if err := u.hotReloader.Apply(ctx, cfgDiff.changed, u.currentCfg); err != nil {
+		return fmt.Errorf("while applying new config: %w", err)
+	}
+	return nil
 }
 
 func (u *RemoteConfigReloader) queryResourceVersion(ctx context.Context) (int, error) {
@@ -143,10 +171,10 @@ func (u *RemoteConfigReloader) compareResVer(newResVer int) (bool, error) {
 }
 
 type configDiff struct {
-	shouldRestart bool
+	changed []Subsystem
 }
 
-func (u *RemoteConfigReloader) processNewConfig(newCfgBytes []byte, newResVer int) (configDiff, error) {
+func (u *RemoteConfigReloader) processNewConfig(ctx context.Context, newCfgBytes []byte, newResVer int) (configDiff, error) {
 	// another resource version check, because it can change between the first and second query
 	shouldUpdate, err := u.compareResVer(newResVer)
 	if err != nil {
@@ -159,7 +187,21 @@ func (u *RemoteConfigReloader) processNewConfig(newCfgBytes []byte, newResVer in
 	}
 	u.setResourceVersionForAll(newResVer)
 
-	newCfg, _, err := config.LoadWithDefaults([][]byte{newCfgBytes})
+	return u.updateConfig(ctx, newCfgBytes, newResVer)
+}
+
+// updateConfig loads a given configuration with resolved value references and returns the changed subsystems.
+func (u *RemoteConfigReloader) updateConfig(ctx context.Context, newCfgBytes []byte, newResVer int) (configDiff, error) {
+	configs := config.YAMLFiles{newCfgBytes}
+	if u.valueRefs != nil {
+		var err error
+		configs, err = u.valueRefs.ResolveRefs(ctx, configs)
+		if err != nil {
+			return configDiff{}, fmt.Errorf("while resolving configuration references: %w", err)
+		}
+	}
+
+	newCfg, _, err := config.LoadWithDefaults(configs)
 	if err != nil {
 		return configDiff{}, fmt.Errorf("while loading new config: %w", err)
 	}
@@ -167,28 +209,21 @@ func (u *RemoteConfigReloader) processNewConfig(newCfgBytes []byte, newResVer in
 		return configDiff{}, fmt.Errorf("new config is nil")
 	}
 
-	changelog, err := diff.Diff(u.currentCfg, *newCfg, diff.DisableStructValues(), diff.SliceOrdering(false), diff.AllowTypeMismatch(true))
+	changed, err := ChangedSubsystems(u.currentCfg, *newCfg)
 	if err != nil {
-		return configDiff{}, fmt.Errorf("while diffing configs: %w", err)
+		return configDiff{}, err
 	}
 
-	if len(changelog) == 0 {
+	if len(changed) == 0 {
 		u.log.Debugf("Config with higher version (%d) is the same as the latest one. No need to reload config", newResVer)
 		return configDiff{}, nil
 	}
-
-	var paths []string
-	for _, change := range changelog {
-		paths = append(paths, fmt.Sprintf(`- "%s"`, strings.Join(change.Path, ".")))
-	}
-	u.log.Debugf("detected config changes on paths:\n%s", strings.Join(paths, "\n"))
-
-	// TODO(https://github.com/kubeshop/botkube/issues/1012): check if notifications are enabled and if so, do not restart the app
+	u.log.Debugf("Detected config changes of %s", subsystemsString(changed))
 
 	u.currentCfg = *newCfg
 	u.log.Debugf("Successfully set newer config version (%d). Config should be reloaded soon", newResVer)
 	return configDiff{
-		shouldRestart: true,
+		changed: changed,
 	}, nil
 }
 
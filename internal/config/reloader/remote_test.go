@@ -1,6 +1,8 @@
 package reloader
 
 import (
+	"context"
+	"strings"
 	"testing"
 	"time"
 
@@ -8,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	intconfig "github.com/kubeshop/botkube/internal/config"
 	"github.com/kubeshop/botkube/internal/loggerx"
 	"github.com/kubeshop/botkube/pkg/config"
 )
@@ -62,7 +65,19 @@ func TestRemote_ProcessConfig(t *testing.T) {
 			ExpectedCfgDiff:    configDiff{},
 		},
 		{
-			Name:          "Different config, should restart",
+			Name:          "Value references resolved",
+			InitialCfg:    fixConfig(false),
+			InitialResVer: 2,
+			NewConfig:     strings.Replace(fixConfigStr(true), "botToken: xoxb-123", "botToken: {valueFrom: {env: SLACK_BOT_TOKEN}}", 1),
+			NewResVer:     3,
+
+			ExpectedErrMessage: "",
+			ExpectedCfg:        fixConfig(true),
+			ExpectedResVer:     3,
+			ExpectedCfgDiff:    configDiff{changed: []Subsystem{ActionsSubsystem}},
+		},
+		{
+			Name:          "Different config, should reload actions",
 			InitialCfg:    fixConfig(false),
 			InitialResVer: 2,
 			NewConfig:     fixConfigStr(true),
@@ -71,7 +86,7 @@ func TestRemote_ProcessConfig(t *testing.T) {
 			ExpectedErrMessage: "",
 			ExpectedCfg:        fixConfig(true),
 			ExpectedResVer:     3,
-			ExpectedCfgDiff:    configDiff{shouldRestart: true},
+			ExpectedCfgDiff:    configDiff{changed: []Subsystem{ActionsSubsystem}},
 		},
 	}
 
@@ -82,12 +97,13 @@ func TestRemote_ProcessConfig(t *testing.T) {
 				log:           loggerx.NewNoop(),
 				interval:      time.Minute,
 				deployCli:     nil,
+				valueRefs:     &fakeValueRefResolver{value: "xoxb-123"},
 				resVerHolders: []ResourceVersionHolder{resVerHldr},
 				currentCfg:    testCase.InitialCfg,
 				resVersion:    testCase.InitialResVer,
 			}
 
-			cfgDiff, err := remoteReloader.processNewConfig([]byte(testCase.NewConfig), testCase.NewResVer)
+			cfgDiff, err := remoteReloader.processNewConfig(context.Background(), []byte(testCase.NewConfig), testCase.NewResVer)
 			if testCase.ExpectedErrMessage != "" {
 				require.Error(t, err)
 				assert.EqualError(t, err, testCase.ExpectedErrMessage)
@@ -104,6 +120,24 @@ func TestRemote_ProcessConfig(t *testing.T) {
 	}
 }
 
+type fakeValueRefResolver struct {
+	value string
+}
+
+func (f *fakeValueRefResolver) ResolveRefs(_ context.Context, configs config.YAMLFiles) (config.YAMLFiles, error) {
+	out := make(config.YAMLFiles, 0, len(configs))
+	for _, cfg := range configs {
+		resolved, err := intconfig.ReplaceValueRefs(cfg, func(intconfig.ValueFrom) (string, error) {
+			return f.value, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, resolved)
+	}
+	return out, nil
+}
+
 type sampleResVerHolder struct {
 	resVer int
 }
@@ -153,6 +187,16 @@ func fixConfig(actionEnabled bool) config.Config {
 				Name:      "botkube-system",
 				Namespace: "botkube",
 			},
+			SinkDelivery: config.SinkDelivery{
+				QueueSize:      1000,
+				Concurrency:    2,
+				MaxRetries:     5,
+				InitialBackoff: time.Second,
+				MaxBackoff:     time.Minute,
+				DeadLetter: config.SinkDeadLetter{
+					MaxEntries: 100,
+				},
+			},
 		},
 		Plugins: config.PluginManagement{
 			CacheDir: "/tmp",
@@ -161,6 +205,9 @@ func fixConfig(actionEnabled bool) config.Config {
 			Remote: config.RemoteCfgWatcher{
 				PollInterval: 15 * time.Second,
 			},
+			ValueRefs: config.ValueRefsCfgWatcher{
+				PollInterval: time.Minute,
+			},
 		},
 	}
 }
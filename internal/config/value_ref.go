@@ -0,0 +1,407 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+const valueFromKey = "valueFrom"
+
+// ValueFrom describes where a configuration value is read from. It can be used instead of any string value,
+// including the plugin configuration, e.g.:
+//
+//	botToken:
+//	  valueFrom:
+//	    secretKeyRef:
+//	      name: slack-creds
+//	      key: botToken
+type ValueFrom struct {
+	// SecretKeyRef selects a key of a Kubernetes Secret from the Botkube namespace.
+	SecretKeyRef *SecretKeySelector `yaml:"secretKeyRef,omitempty"`
+	// File is a path to a file, e.g. a mounted Secret, from one of the allowed directories.
+	// Leading and trailing white space is trimmed.
+	File string `yaml:"file,omitempty"`
+	// Env is a name of an environment variable.
+	Env string `yaml:"env,omitempty"`
+}
+
+// SecretKeySelector selects a key of a Kubernetes Secret. The namespace, if set, must be the Botkube namespace.
+type SecretKeySelector struct {
+	Namespace string `yaml:"namespace,omitempty"`
+	Name      string `yaml:"name"`
+	Key       string `yaml:"key"`
+}
+
+// String returns a description of the reference, which doesn't contain the value itself.
+func (v ValueFrom) String() string {
+	switch {
+	case v.SecretKeyRef != nil:
+		name := v.SecretKeyRef.Name
+		if v.SecretKeyRef.Namespace != "" {
+			name = v.SecretKeyRef.Namespace + "/" + name
+		}
+		return fmt.Sprintf("Secret %q key %q", name, v.SecretKeyRef.Key)
+	case v.File != "":
+		return fmt.Sprintf("file %q", v.File)
+	default:
+		return fmt.Sprintf("env %q", v.Env)
+	}
+}
+
+func (v ValueFrom) validate() error {
+	defined := 0
+	if v.SecretKeyRef != nil {
+		defined++
+		if v.SecretKeyRef.Name == "" || v.SecretKeyRef.Key == "" {
+			return errors.New("secretKeyRef must define both name and key")
+		}
+	}
+	if v.File != "" {
+		defined++
+	}
+	if v.Env != "" {
+		defined++
+	}
+	if defined != 1 {
+		return errors.New("must define exactly one of secretKeyRef, file and env")
+	}
+	return nil
+}
+
+// ResolveValueFn returns the value for a given reference.
+type ResolveValueFn func(ref ValueFrom) (string, error)
+
+// ReplaceValueRefs replaces all `valueFrom` references in a given YAML document with values returned by resolve.
+// If there are no references, the input is returned unchanged.
+func ReplaceValueRefs(raw []byte, resolve ResolveValueFn) ([]byte, error) {
+	var root yaml.Node
+	if err := yaml.Unmarshal(raw, &root); err != nil {
+		return nil, fmt.Errorf("while unmarshaling configuration: %w", err)
+	}
+
+	replaced, err := replaceValueRefs(&root, nil, resolve)
+	if err != nil {
+		return nil, err
+	}
+	if !replaced {
+		return raw, nil
+	}
+
+	var buff bytes.Buffer
+	enc := yaml.NewEncoder(&buff)
+	enc.SetIndent(2)
+	if err := enc.Encode(&root); err != nil {
+		return nil, fmt.Errorf("while marshaling configuration: %w", err)
+	}
+	return buff.Bytes(), nil
+}
+
+// HasValueRefs returns true if a given YAML document contains `valueFrom` references.
+func HasValueRefs(raw []byte) (bool, error) {
+	found := false
+	_, err := ReplaceValueRefs(raw, func(ValueFrom) (string, error) {
+		found = true
+		return "", nil
+	})
+	return found, err
+}
+
+// RedactValueRefs replaces all `valueFrom` references with a description of the reference, so the configuration
+// can be printed without exposing the values.
+func RedactValueRefs(configs config.YAMLFiles) (config.YAMLFiles, error) {
+	out := make(config.YAMLFiles, 0, len(configs))
+	for _, cfg := range configs {
+		redacted, err := ReplaceValueRefs(cfg, func(ref ValueFrom) (string, error) {
+			return fmt.Sprintf("<redacted: %s>", ref), nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, redacted)
+	}
+	return out, nil
+}
+
+func replaceValueRefs(node *yaml.Node, path []string, resolve ResolveValueFn) (bool, error) {
+	switch node.Kind {
+	case yaml.DocumentNode, yaml.SequenceNode:
+		replaced := false
+		for idx, child := range node.Content {
+			childPath := path
+			if node.Kind == yaml.SequenceNode {
+				childPath = append(path[:len(path):len(path)], fmt.Sprintf("[%d]", idx))
+			}
+			ok, err := replaceValueRefs(child, childPath, resolve)
+			if err != nil {
+				return false, err
+			}
+			replaced = replaced || ok
+		}
+		return replaced, nil
+	case yaml.MappingNode:
+		if len(node.Content) == 2 && node.Content[0].Value == valueFromKey {
+			return true, replaceValueRef(node, path, resolve)
+		}
+
+		replaced := false
+		for idx := 0; idx+1 < len(node.Content); idx += 2 {
+			childPath := append(path[:len(path):len(path)], node.Content[idx].Value)
+			ok, err := replaceValueRefs(node.Content[idx+1], childPath, resolve)
+			if err != nil {
+				return false, err
+			}
+			replaced = replaced || ok
+		}
+		return replaced, nil
+	}
+
+	// aliases point to the anchored nodes, which are replaced in place
+	return false, nil
+}
+
+func replaceValueRef(node *yaml.Node, path []string, resolve ResolveValueFn) error {
+	field := strings.Join(path, ".")
+
+	var ref ValueFrom
+	if err := node.Content[1].Decode(&ref); err != nil {
+		return fmt.Errorf("while decoding %q reference of %q: %w", valueFromKey, field, err)
+	}
+	if err := ref.validate(); err != nil {
+		return fmt.Errorf("invalid %q reference of %q: %w", valueFromKey, field, err)
+	}
+
+	val, err := resolve(ref)
+	if err != nil {
+		return fmt.Errorf("while resolving %q: %w", field, err)
+	}
+
+	*node = yaml.Node{
+		Kind:  yaml.ScalarNode,
+		Tag:   "!!str",
+		Value: val,
+	}
+	return nil
+}
+
+// ValueRefProvider resolves `valueFrom` references in the configuration returned by the base provider.
+// The resolved values are masked in logs.
+type ValueRefProvider struct {
+	log              logrus.FieldLogger
+	base             config.Provider
+	secrets          corev1client.SecretsGetter
+	defaultNamespace string
+	allowedFileDirs  []string
+
+	mu       sync.Mutex
+	checksum string
+}
+
+// NewValueRefProvider returns new ValueRefProvider. Secrets are read only from the defaultNamespace, which is the only
+// namespace Botkube has access to, and files only from the allowedFileDirs.
+func NewValueRefProvider(log logrus.FieldLogger, base config.Provider, secrets corev1client.SecretsGetter, defaultNamespace string, allowedFileDirs []string) *ValueRefProvider {
+	return &ValueRefProvider{
+		log:              log,
+		base:             base,
+		secrets:          secrets,
+		defaultNamespace: defaultNamespace,
+		allowedFileDirs:  allowedFileDirs,
+	}
+}
+
+// Configs returns the base configuration with resolved references.
+func (p *ValueRefProvider) Configs(ctx context.Context) (config.YAMLFiles, int, error) {
+	configs, version, err := p.base.Configs(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	resolved, err := p.ResolveRefs(ctx, configs)
+	if err != nil {
+		return nil, 0, err
+	}
+	return resolved, version, nil
+}
+
+// ResolveRefs resolves the references in a given configuration, e.g. the one fetched by the remote config reloader.
+func (p *ValueRefProvider) ResolveRefs(ctx context.Context, configs config.YAMLFiles) (config.YAMLFiles, error) {
+	out, values, err := p.resolveConfigs(ctx, configs)
+	if err != nil {
+		return nil, err
+	}
+
+	loggerx.AddRedactedValues(values...)
+
+	p.mu.Lock()
+	p.checksum = checksum(values)
+	p.mu.Unlock()
+
+	return out, nil
+}
+
+// Watch resolves the references periodically and calls onChange when any of the values changed, e.g. after
+// a Secret rotation.
+func (p *ValueRefProvider) Watch(ctx context.Context, pollInterval time.Duration, onChange func(ctx context.Context)) error {
+	if pollInterval <= 0 {
+		return nil
+	}
+
+	p.log.Info("Watching referenced configuration values...")
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			_, _, values, err := p.resolve(ctx)
+			if err != nil {
+				p.log.Errorf("while resolving referenced configuration values: %s", err.Error())
+				continue
+			}
+
+			p.mu.Lock()
+			changed := p.checksum != checksum(values)
+			p.mu.Unlock()
+			if !changed {
+				continue
+			}
+
+			// the new values must be masked before they are passed anywhere
+			loggerx.AddRedactedValues(values...)
+			p.log.Debug("Referenced configuration values changed. Reloading configuration...")
+			onChange(ctx)
+		}
+	}
+}
+
+func (p *ValueRefProvider) resolve(ctx context.Context) (config.YAMLFiles, int, []string, error) {
+	configs, version, err := p.base.Configs(ctx)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+
+	out, values, err := p.resolveConfigs(ctx, configs)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	return out, version, values, nil
+}
+
+func (p *ValueRefProvider) resolveConfigs(ctx context.Context, configs config.YAMLFiles) (config.YAMLFiles, []string, error) {
+	var values []string
+	out := make(config.YAMLFiles, 0, len(configs))
+	for _, cfg := range configs {
+		resolved, err := ReplaceValueRefs(cfg, func(ref ValueFrom) (string, error) {
+			val, err := p.resolveValue(ctx, ref)
+			if err != nil {
+				return "", err
+			}
+			values = append(values, val)
+			return val, nil
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		out = append(out, resolved)
+	}
+
+	return out, values, nil
+}
+
+func (p *ValueRefProvider) resolveValue(ctx context.Context, ref ValueFrom) (string, error) {
+	switch {
+	case ref.SecretKeyRef != nil:
+		ns := ref.SecretKeyRef.Namespace
+		if ns == "" {
+			ns = p.defaultNamespace
+		}
+		if ns != p.defaultNamespace {
+			return "", fmt.Errorf("secret %s/%s is not allowed: only Secrets from the %q namespace can be referenced", ns, ref.SecretKeyRef.Name, p.defaultNamespace)
+		}
+		secret, err := p.secrets.Secrets(ns).Get(ctx, ref.SecretKeyRef.Name, metav1.GetOptions{})
+		if err != nil {
+			return "", fmt.Errorf("while getting Secret: %w", err)
+		}
+		val, found := secret.Data[ref.SecretKeyRef.Key]
+		if !found {
+			return "", fmt.Errorf("key %q not found in Secret %s/%s", ref.SecretKeyRef.Key, ns, ref.SecretKeyRef.Name)
+		}
+		return string(val), nil
+	case ref.File != "":
+		path, err := p.allowedFilePath(ref.File)
+		if err != nil {
+			return "", err
+		}
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			return "", fmt.Errorf("while reading file: %w", err)
+		}
+		return strings.TrimSpace(string(raw)), nil
+	default:
+		val, found := os.LookupEnv(ref.Env)
+		if !found {
+			return "", fmt.Errorf("environment variable %q is not set", ref.Env)
+		}
+		return val, nil
+	}
+}
+
+// allowedFilePath returns the path with resolved symbolic links. Both paths are checked, so a link can't point
+// outside the allowed directories.
+func (p *ValueRefProvider) allowedFilePath(file string) (string, error) {
+	notAllowedErr := fmt.Errorf("file %q is not allowed: only files from the configWatcher.valueRefs.allowedFileDirs directories can be referenced", file)
+	if !filepath.IsAbs(file) || !p.isInAllowedFileDir(filepath.Clean(file)) {
+		return "", notAllowedErr
+	}
+
+	path, err := filepath.EvalSymlinks(file)
+	if err != nil {
+		return "", fmt.Errorf("while reading file: %w", err)
+	}
+	if !p.isInAllowedFileDir(path) {
+		return "", notAllowedErr
+	}
+	return path, nil
+}
+
+func (p *ValueRefProvider) isInAllowedFileDir(path string) bool {
+	for _, dir := range p.allowedFileDirs {
+		dirs := []string{filepath.Clean(dir)}
+		if resolved, err := filepath.EvalSymlinks(dir); err == nil {
+			dirs = append(dirs, resolved)
+		}
+		for _, dir := range dirs {
+			rel, err := filepath.Rel(dir, path)
+			if err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func checksum(values []string) string {
+	h := sha256.New()
+	for _, val := range values {
+		h.Write([]byte(val))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,283 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+const valueRefsConfig = `
+communications:
+  default-group:
+    socketSlack:
+      enabled: true
+      channels:
+        default:
+          name: botkube
+      botToken:
+        valueFrom:
+          secretKeyRef:
+            name: slack-creds
+            key: botToken
+      appToken:
+        valueFrom:
+          env: TEST_SLACK_APP_TOKEN
+executors:
+  gh:
+    botkube/gh:
+      enabled: true
+      config:
+        github:
+          token:
+            valueFrom:
+              file: %s
+`
+
+func TestValueRefProviderConfigs(t *testing.T) {
+	// given
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("ghp_token\n"), 0o600))
+	t.Setenv("TEST_SLACK_APP_TOKEN", "xapp-token")
+
+	base := &fakeBaseProvider{configs: config.YAMLFiles{[]byte(fixValueRefsConfig(tokenFile))}}
+	cli := fake.NewSimpleClientset(fixSecret("botkube", "slack-creds", "botToken", "xoxb-token"))
+	provider := NewValueRefProvider(loggerx.NewNoop(), base, cli.CoreV1(), "botkube", []string{filepath.Dir(tokenFile)})
+
+	// when
+	configs, _, err := provider.Configs(context.Background())
+
+	// then
+	require.NoError(t, err)
+	cfg, _, err := config.LoadWithDefaults(configs)
+	require.NoError(t, err)
+
+	slack := cfg.Communications["default-group"].SocketSlack
+	assert.Equal(t, "xoxb-token", slack.BotToken)
+	assert.Equal(t, "xapp-token", slack.AppToken)
+	assert.Equal(t, map[string]any{
+		"github": map[string]any{"token": "ghp_token"},
+	}, cfg.Executors["gh"].Plugins["botkube/gh"].Config)
+}
+
+func TestValueRefProviderConfigsErrors(t *testing.T) {
+	tests := []struct {
+		name           string
+		givenConfig    string
+		expErrContains string
+	}{
+		{
+			name: "Multiple sources",
+			givenConfig: `
+settings:
+  clusterName:
+    valueFrom:
+      env: CLUSTER_NAME
+      file: /tmp/cluster-name
+`,
+			expErrContains: `invalid "valueFrom" reference of "settings.clusterName": must define exactly one of secretKeyRef, file and env`,
+		},
+		{
+			name: "Missing Secret key",
+			givenConfig: `
+settings:
+  clusterName:
+    valueFrom:
+      secretKeyRef:
+        name: slack-creds
+        key: clusterName
+`,
+			expErrContains: `while resolving "settings.clusterName": key "clusterName" not found in Secret botkube/slack-creds`,
+		},
+		{
+			name: "Missing Secret",
+			givenConfig: `
+settings:
+  clusterName:
+    valueFrom:
+      secretKeyRef:
+        name: other-creds
+        key: botToken
+`,
+			expErrContains: `while resolving "settings.clusterName": while getting Secret: secrets "other-creds" not found`,
+		},
+		{
+			name: "Secret from other namespace",
+			givenConfig: `
+settings:
+  clusterName:
+    valueFrom:
+      secretKeyRef:
+        namespace: other
+        name: slack-creds
+        key: botToken
+`,
+			expErrContains: `while resolving "settings.clusterName": secret other/slack-creds is not allowed: only Secrets from the "botkube" namespace can be referenced`,
+		},
+		{
+			name: "File outside allowed directories",
+			givenConfig: `
+settings:
+  clusterName:
+    valueFrom:
+      file: /var/run/secrets/kubernetes.io/serviceaccount/token
+`,
+			expErrContains: `while resolving "settings.clusterName": file "/var/run/secrets/kubernetes.io/serviceaccount/token" is not allowed`,
+		},
+		{
+			name: "File escaping allowed directory",
+			givenConfig: `
+settings:
+  clusterName:
+    valueFrom:
+      file: /etc/botkube/../../var/run/secrets/kubernetes.io/serviceaccount/token
+`,
+			expErrContains: `is not allowed: only files from the configWatcher.valueRefs.allowedFileDirs directories can be referenced`,
+		},
+		{
+			name: "Relative file path",
+			givenConfig: `
+settings:
+  clusterName:
+    valueFrom:
+      file: etc/botkube/token
+`,
+			expErrContains: `file "etc/botkube/token" is not allowed`,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			base := &fakeBaseProvider{configs: config.YAMLFiles{[]byte(tc.givenConfig)}}
+			cli := fake.NewSimpleClientset(fixSecret("botkube", "slack-creds", "botToken", "xoxb-token"))
+			provider := NewValueRefProvider(loggerx.NewNoop(), base, cli.CoreV1(), "botkube", []string{"/etc/botkube"})
+
+			// when
+			_, _, err := provider.Configs(context.Background())
+
+			// then
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.expErrContains)
+		})
+	}
+}
+
+func TestValueRefProviderConfigsRejectsSymlinkOutsideAllowedDir(t *testing.T) {
+	// given
+	allowedDir, otherDir := t.TempDir(), t.TempDir()
+	secretFile := filepath.Join(otherDir, "token")
+	require.NoError(t, os.WriteFile(secretFile, []byte("sa-token"), 0o600))
+	link := filepath.Join(allowedDir, "token")
+	require.NoError(t, os.Symlink(secretFile, link))
+
+	base := &fakeBaseProvider{configs: config.YAMLFiles{[]byte(fmt.Sprintf("settings:\n  clusterName:\n    valueFrom:\n      file: %s\n", link))}}
+	provider := NewValueRefProvider(loggerx.NewNoop(), base, fake.NewSimpleClientset().CoreV1(), "botkube", []string{allowedDir})
+
+	// when
+	_, _, err := provider.Configs(context.Background())
+
+	// then
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), fmt.Sprintf("file %q is not allowed", link))
+}
+
+func TestValueRefProviderWatch(t *testing.T) {
+	// given
+	base := &fakeBaseProvider{configs: config.YAMLFiles{[]byte(`
+settings:
+  clusterName:
+    valueFrom:
+      secretKeyRef:
+        name: slack-creds
+        key: botToken
+`)}}
+	cli := fake.NewSimpleClientset(fixSecret("botkube", "slack-creds", "botToken", "xoxb-token"))
+	provider := NewValueRefProvider(loggerx.NewNoop(), base, cli.CoreV1(), "botkube", nil)
+
+	_, _, err := provider.Configs(context.Background())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	changed := make(chan struct{})
+	go func() {
+		_ = provider.Watch(ctx, 10*time.Millisecond, func(context.Context) {
+			close(changed)
+			cancel()
+		})
+	}()
+
+	// when
+	_, err = cli.CoreV1().Secrets("botkube").Update(ctx, fixSecret("botkube", "slack-creds", "botToken", "xoxb-rotated"), metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	// then
+	select {
+	case <-changed:
+	case <-ctx.Done():
+		t.Fatal("onChange wasn't called after the Secret rotation")
+	}
+}
+
+func TestRedactValueRefs(t *testing.T) {
+	// given
+	configs := config.YAMLFiles{[]byte(fixValueRefsConfig("/etc/gh/token"))}
+
+	// when
+	out, err := RedactValueRefs(configs)
+
+	// then
+	require.NoError(t, err)
+	cfg, err := config.MergeWithDefaults(out)
+	require.NoError(t, err)
+
+	slack := cfg.Communications["default-group"].SocketSlack
+	assert.Equal(t, `<redacted: Secret "slack-creds" key "botToken">`, slack.BotToken)
+	assert.Equal(t, `<redacted: env "TEST_SLACK_APP_TOKEN">`, slack.AppToken)
+	assert.Equal(t, map[string]any{
+		"github": map[string]any{"token": `<redacted: file "/etc/gh/token">`},
+	}, cfg.Executors["gh"].Plugins["botkube/gh"].Config)
+}
+
+func TestReplaceValueRefsWithoutRefs(t *testing.T) {
+	// given
+	raw := []byte("settings:\n  clusterName: dev # comment\n")
+
+	// when
+	out, err := ReplaceValueRefs(raw, func(ValueFrom) (string, error) {
+		t.Fatal("resolve shouldn't be called")
+		return "", nil
+	})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, raw, out)
+}
+
+func fixValueRefsConfig(tokenFile string) string {
+	return fmt.Sprintf(valueRefsConfig, tokenFile)
+}
+
+func fixSecret(namespace, name, key, value string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+		},
+		Data: map[string][]byte{
+			key: []byte(value),
+		},
+	}
+}
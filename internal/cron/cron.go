@@ -0,0 +1,209 @@
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule represents a parsed cron expression in the standard 5-field format:
+// minute, hour, day of month, month and day of week.
+type Schedule struct {
+	minute, hour, dom, month, dow fieldSet
+
+	// as in the standard cron, if both day of month and day of week are restricted, a time matches when either of them matches
+	domRestricted, dowRestricted bool
+}
+
+type fieldSet map[int]struct{}
+
+type fieldBounds struct {
+	name     string
+	min, max int
+}
+
+var (
+	minuteBounds = fieldBounds{name: "minute", min: 0, max: 59}
+	hourBounds   = fieldBounds{name: "hour", min: 0, max: 23}
+	domBounds    = fieldBounds{name: "day of month", min: 1, max: 31}
+	monthBounds  = fieldBounds{name: "month", min: 1, max: 12}
+	dowBounds    = fieldBounds{name: "day of week", min: 0, max: 7}
+)
+
+// Parse parses a given cron expression. Each field supports wildcards (*), lists (1,2), ranges (1-5) and steps (*/15, 1-10/2).
+// Both 0 and 7 represent Sunday.
+func Parse(spec string) (*Schedule, error) {
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("expected exactly 5 fields, got %d in %q", len(fields), spec)
+	}
+
+	var (
+		out Schedule
+		err error
+	)
+	if out.minute, err = parseField(fields[0], minuteBounds); err != nil {
+		return nil, err
+	}
+	if out.hour, err = parseField(fields[1], hourBounds); err != nil {
+		return nil, err
+	}
+	if out.dom, err = parseField(fields[2], domBounds); err != nil {
+		return nil, err
+	}
+	if out.month, err = parseField(fields[3], monthBounds); err != nil {
+		return nil, err
+	}
+	if out.dow, err = parseField(fields[4], dowBounds); err != nil {
+		return nil, err
+	}
+	if _, found := out.dow[7]; found {
+		out.dow[0] = struct{}{}
+	}
+
+	out.domRestricted = fields[2] != "*"
+	out.dowRestricted = fields[4] != "*"
+	return &out, nil
+}
+
+// Matches returns true if a given time, truncated to minutes, matches the schedule.
+func (s *Schedule) Matches(t time.Time) bool {
+	return s.minute.has(t.Minute()) && s.hour.has(t.Hour()) && s.matchesDay(t)
+}
+
+// matchesDay returns true if the month, day of month and day of week of a given time match the schedule.
+func (s *Schedule) matchesDay(t time.Time) bool {
+	if !s.month.has(int(t.Month())) {
+		return false
+	}
+
+	domMatch := s.dom.has(t.Day())
+	dowMatch := s.dow.has(int(t.Weekday()))
+	if s.domRestricted && s.dowRestricted {
+		return domMatch || dowMatch
+	}
+	return domMatch && dowMatch
+}
+
+// LastBefore returns the latest time, truncated to minutes, which matches the schedule and is not after a given time.
+// Only the time range limited by the lookback duration is checked. Returns false if there is no match in that range.
+// Days are checked one by one, and within the matching day the latest hour and minute are selected directly.
+func (s *Schedule) LastBefore(t time.Time, lookback time.Duration) (time.Time, bool) {
+	current := t.Truncate(time.Minute)
+	earliest := t.Add(-lookback)
+	for !current.Before(earliest) {
+		if match, found := s.lastInDay(current); found {
+			if match.Before(earliest) {
+				return time.Time{}, false
+			}
+			return match, true
+		}
+
+		// continue with the last minute of the previous day
+		year, month, day := current.Date()
+		current = time.Date(year, month, day, 0, 0, 0, 0, current.Location()).Add(-time.Minute)
+	}
+	return time.Time{}, false
+}
+
+// lastInDay returns the latest time matching the schedule which is on the same day as a given time and not after it.
+func (s *Schedule) lastInDay(t time.Time) (time.Time, bool) {
+	if !s.matchesDay(t) {
+		return time.Time{}, false
+	}
+
+	for hour := t.Hour(); hour >= hourBounds.min; hour-- {
+		if !s.hour.has(hour) {
+			continue
+		}
+		maxMinute := minuteBounds.max
+		if hour == t.Hour() {
+			maxMinute = t.Minute()
+		}
+		if minute, found := s.minute.lastUpTo(maxMinute, minuteBounds.min); found {
+			year, month, day := t.Date()
+			return time.Date(year, month, day, hour, minute, 0, 0, t.Location()), true
+		}
+	}
+	return time.Time{}, false
+}
+
+func (f fieldSet) has(val int) bool {
+	_, found := f[val]
+	return found
+}
+
+// lastUpTo returns the highest value from the set which is in the [min, max] range.
+func (f fieldSet) lastUpTo(max, min int) (int, bool) {
+	for val := max; val >= min; val-- {
+		if f.has(val) {
+			return val, true
+		}
+	}
+	return 0, false
+}
+
+func parseField(field string, bounds fieldBounds) (fieldSet, error) {
+	out := fieldSet{}
+	for _, part := range strings.Split(field, ",") {
+		if err := parseFieldPart(out, part, bounds); err != nil {
+			return nil, fmt.Errorf("invalid %s field %q: %w", bounds.name, field, err)
+		}
+	}
+	return out, nil
+}
+
+func parseFieldPart(out fieldSet, part string, bounds fieldBounds) error {
+	rng, stepStr, hasStep := strings.Cut(part, "/")
+	step := 1
+	if hasStep {
+		var err error
+		step, err = strconv.Atoi(stepStr)
+		if err != nil || step <= 0 {
+			return fmt.Errorf("invalid step %q", stepStr)
+		}
+	}
+
+	start, end := bounds.min, bounds.max
+	switch {
+	case rng == "*":
+	case strings.Contains(rng, "-"):
+		startStr, endStr, _ := strings.Cut(rng, "-")
+		var err error
+		if start, err = parseValue(startStr, bounds); err != nil {
+			return err
+		}
+		if end, err = parseValue(endStr, bounds); err != nil {
+			return err
+		}
+		if start > end {
+			return fmt.Errorf("invalid range %q", rng)
+		}
+	default:
+		val, err := parseValue(rng, bounds)
+		if err != nil {
+			return err
+		}
+		start = val
+		if !hasStep {
+			end = val
+		}
+	}
+
+	for i := start; i <= end; i += step {
+		out[i] = struct{}{}
+	}
+	return nil
+}
+
+func parseValue(in string, bounds fieldBounds) (int, error) {
+	val, err := strconv.Atoi(in)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q", in)
+	}
+	if val < bounds.min || val > bounds.max {
+		return 0, fmt.Errorf("value %d out of range [%d, %d]", val, bounds.min, bounds.max)
+	}
+	return val, nil
+}
@@ -0,0 +1,122 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduleMatches(t *testing.T) {
+	// Sunday
+	sunday := time.Date(2023, 1, 1, 22, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		spec     string
+		time     time.Time
+		expMatch bool
+	}{
+		{name: "Wildcards", spec: "* * * * *", time: sunday, expMatch: true},
+		{name: "Exact time", spec: "30 22 * * *", time: sunday, expMatch: true},
+		{name: "Different minute", spec: "0 22 * * *", time: sunday, expMatch: false},
+		{name: "Step", spec: "*/15 * * * *", time: sunday, expMatch: true},
+		{name: "Step not matching", spec: "*/20 * * * *", time: sunday, expMatch: false},
+		{name: "Range", spec: "30 20-23 * * *", time: sunday, expMatch: true},
+		{name: "List", spec: "30 8,22 * * *", time: sunday, expMatch: true},
+		{name: "Weekdays only", spec: "30 22 * * 1-5", time: sunday, expMatch: false},
+		{name: "Sunday as 7", spec: "30 22 * * 7", time: sunday, expMatch: true},
+		{name: "Either day of month or day of week", spec: "30 22 15 * 0", time: sunday, expMatch: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			schedule, err := Parse(tc.spec)
+			require.NoError(t, err)
+
+			// when
+			match := schedule.Matches(tc.time)
+
+			// then
+			assert.Equal(t, tc.expMatch, match)
+		})
+	}
+}
+
+func TestScheduleLastBefore(t *testing.T) {
+	// given
+	schedule, err := Parse("0 22 * * *")
+	require.NoError(t, err)
+
+	now := time.Date(2023, 1, 2, 3, 15, 45, 0, time.UTC)
+
+	// when
+	last, found := schedule.LastBefore(now, 8*time.Hour)
+	_, foundInShortLookback := schedule.LastBefore(now, time.Hour)
+
+	// then
+	assert.True(t, found)
+	assert.Equal(t, time.Date(2023, 1, 1, 22, 0, 0, 0, time.UTC), last)
+	assert.False(t, foundInShortLookback)
+}
+
+func TestScheduleLastBefore_MatchesEveryMinuteLookup(t *testing.T) {
+	specs := []string{"0 22 * * *", "*/15 9-17 * * 1-5", "30 2 1 * 0", "0 0 29 2 *", "* * * * *", "45 23 31 12 *"}
+	lookback := 72 * time.Hour
+	for _, spec := range specs {
+		t.Run(spec, func(t *testing.T) {
+			// given
+			schedule, err := Parse(spec)
+			require.NoError(t, err)
+
+			for _, now := range []time.Time{
+				time.Date(2023, 1, 2, 3, 15, 45, 0, time.UTC),
+				time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2023, 12, 31, 23, 59, 0, 0, time.UTC),
+			} {
+				// when
+				last, found := schedule.LastBefore(now, lookback)
+
+				// then
+				expLast, expFound := lastBeforeByMinutes(schedule, now, lookback)
+				assert.Equal(t, expFound, found, now)
+				assert.Equal(t, expLast, last, now)
+			}
+		})
+	}
+}
+
+// lastBeforeByMinutes checks every minute of the lookback range.
+func lastBeforeByMinutes(s *Schedule, t time.Time, lookback time.Duration) (time.Time, bool) {
+	earliest := t.Add(-lookback)
+	for current := t.Truncate(time.Minute); !current.Before(earliest); current = current.Add(-time.Minute) {
+		if s.Matches(current) {
+			return current, true
+		}
+	}
+	return time.Time{}, false
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		spec   string
+		expErr string
+	}{
+		{name: "Too few fields", spec: "* * *", expErr: `expected exactly 5 fields, got 3 in "* * *"`},
+		{name: "Out of range", spec: "60 * * * *", expErr: `invalid minute field "60": value 60 out of range [0, 59]`},
+		{name: "Invalid step", spec: "* */0 * * *", expErr: `invalid hour field "*/0": invalid step "0"`},
+		{name: "Invalid range", spec: "* * * 5-2 *", expErr: `invalid month field "5-2": invalid range "5-2"`},
+		{name: "Not a number", spec: "* * * * mon", expErr: `invalid day of week field "mon": invalid value "mon"`},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			_, err := Parse(tc.spec)
+
+			// then
+			assert.EqualError(t, err, tc.expErr)
+		})
+	}
+}
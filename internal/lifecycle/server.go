@@ -30,17 +30,18 @@ func NewServer(log logrus.FieldLogger, cfg config.LifecycleServer, restarter Res
 
 func newReloadHandler(log logrus.FieldLogger, restarter Restarter) http.HandlerFunc {
 	return func(writer http.ResponseWriter, request *http.Request) {
-		log.Debug("Reload handler called. Executing restart...")
+		log.Debug("Reload handler called. Reloading configuration...")
 
 		err := restarter.Do(request.Context())
 		if err != nil {
-			errMsg := fmt.Sprintf("while restarting the Deployment: %s", err.Error())
+			errMsg := fmt.Sprintf("while reloading configuration: %s", err.Error())
 			log.Error(errMsg)
 			http.Error(writer, errMsg, http.StatusInternalServerError)
+			return
 		}
 
 		writer.WriteHeader(http.StatusOK)
-		_, err = writer.Write([]byte("Deployment restarted successfully."))
+		_, err = writer.Write([]byte("Configuration reloaded successfully."))
 		if err != nil {
 			log.Errorf("while writing success response: %s", err.Error())
 		}
@@ -15,7 +15,7 @@ import (
 
 func TestNewReloadHandler_HappyPath(t *testing.T) {
 	// given
-	expectedResponse := `Deployment restarted successfully.`
+	expectedResponse := `Configuration reloaded successfully.`
 	expectedStatusCode := http.StatusOK
 
 	restarter := &fakeRestarter{}
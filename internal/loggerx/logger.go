@@ -26,6 +26,7 @@ func New(cfg config.Logger) logrus.FieldLogger {
 	} else {
 		logger.Formatter = &logrus.TextFormatter{FullTimestamp: true, DisableColors: cfg.DisableColors, ForceColors: true}
 	}
+	logger.AddHook(defaultRedactor)
 
 	return logger
 }
@@ -0,0 +1,117 @@
+package loggerx
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+// RedactedValue replaces sensitive values in log entries.
+const RedactedValue = "***"
+
+// minRedactedValueLen prevents masking short, common strings, which would make the logs unreadable.
+const minRedactedValueLen = 4
+
+var defaultRedactor = NewRedactor()
+
+// AddRedactedValues registers sensitive values, such as resolved secrets, which are masked
+// in log entries of all loggers created with New.
+func AddRedactedValues(values ...string) {
+	defaultRedactor.Add(values...)
+}
+
+// Redact returns the input with all values registered with AddRedactedValues masked.
+func Redact(in string) string {
+	return defaultRedactor.Redact(in)
+}
+
+// Redactor is a logrus hook which masks registered sensitive values in log messages and string fields.
+type Redactor struct {
+	mu       sync.RWMutex
+	values   map[string]struct{}
+	replacer *strings.Replacer
+}
+
+// NewRedactor returns new Redactor.
+func NewRedactor() *Redactor {
+	return &Redactor{values: map[string]struct{}{}}
+}
+
+// Add registers sensitive values. Values shorter than 4 characters are ignored.
+func (r *Redactor) Add(values ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	changed := false
+	for _, val := range values {
+		if len(val) < minRedactedValueLen {
+			continue
+		}
+		if _, found := r.values[val]; found {
+			continue
+		}
+		r.values[val] = struct{}{}
+		changed = true
+	}
+	if !changed {
+		return
+	}
+
+	// longer values go first, so a value containing another one is masked as a whole
+	sorted := make([]string, 0, len(r.values))
+	for val := range r.values {
+		sorted = append(sorted, val)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if len(sorted[i]) != len(sorted[j]) {
+			return len(sorted[i]) > len(sorted[j])
+		}
+		return sorted[i] < sorted[j]
+	})
+
+	oldNew := make([]string, 0, 2*len(sorted))
+	for _, val := range sorted {
+		oldNew = append(oldNew, val, RedactedValue)
+	}
+	r.replacer = strings.NewReplacer(oldNew...)
+}
+
+// Redact returns the input with all registered values masked.
+func (r *Redactor) Redact(in string) string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if r.replacer == nil {
+		return in
+	}
+	return r.replacer.Replace(in)
+}
+
+// Levels returns all log levels, as sensitive values must not be printed on any of them.
+func (r *Redactor) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+// Fire masks registered values in the entry message and fields.
+func (r *Redactor) Fire(entry *logrus.Entry) error {
+	entry.Message = r.Redact(entry.Message)
+	for key, val := range entry.Data {
+		switch v := val.(type) {
+		case string:
+			entry.Data[key] = r.Redact(v)
+		case error:
+			if redacted := r.Redact(v.Error()); redacted != v.Error() {
+				entry.Data[key] = errors.New(redacted)
+			}
+		case fmt.Stringer:
+			if redacted := r.Redact(v.String()); redacted != v.String() {
+				entry.Data[key] = redacted
+			}
+		}
+	}
+	return nil
+}
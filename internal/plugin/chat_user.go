@@ -0,0 +1,206 @@
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+const claimsLookupHTTPTimeout = 10 * time.Second
+
+// ChatUser describes the communication platform user who runs a given command.
+type ChatUser struct {
+	Platform    config.CommPlatformIntegration
+	ID          string
+	Email       string
+	DisplayName string
+}
+
+// ChatUserResolver resolves Kubernetes identities of chat users based on static mappings or the claims lookup.
+type ChatUserResolver struct {
+	cfg     config.ChatUsers
+	urlTpl  *template.Template
+	httpCli *http.Client
+	now     func() time.Time
+
+	mu    sync.Mutex
+	cache map[string]cachedIdentity
+}
+
+type cachedIdentity struct {
+	identity  *K8sIdentity
+	expiresAt time.Time
+}
+
+// NewChatUserResolver returns a new ChatUserResolver instance.
+func NewChatUserResolver(cfg config.ChatUsers) (*ChatUserResolver, error) {
+	resolver := &ChatUserResolver{
+		cfg:     cfg,
+		httpCli: &http.Client{Timeout: claimsLookupHTTPTimeout},
+		now:     time.Now,
+		cache:   map[string]cachedIdentity{},
+	}
+
+	if cfg.ClaimsLookup.URL != "" {
+		tpl, err := template.New("claims-lookup-url").Option("missingkey=error").Parse(cfg.ClaimsLookup.URL)
+		if err != nil {
+			return nil, fmt.Errorf("while parsing claims lookup URL template: %w", err)
+		}
+		resolver.urlTpl = tpl
+	}
+
+	return resolver, nil
+}
+
+// Resolve returns the Kubernetes identity for a given chat user. It returns nil if the user is not mapped.
+func (r *ChatUserResolver) Resolve(ctx context.Context, user ChatUser) (*K8sIdentity, error) {
+	for _, mapping := range r.cfg.Mappings {
+		if mapping.Platform != "" && mapping.Platform != user.Platform {
+			continue
+		}
+		if mapping.PlatformUser != user.ID && (user.Email == "" || !strings.EqualFold(mapping.PlatformUser, user.Email)) {
+			continue
+		}
+		return &K8sIdentity{
+			User:   mapping.KubernetesUser,
+			Groups: mapping.KubernetesGroups,
+		}, nil
+	}
+
+	if r.urlTpl == nil {
+		return nil, nil
+	}
+
+	cacheKey := fmt.Sprintf("%s/%s", user.Platform, user.ID)
+	if identity, found := r.cached(cacheKey); found {
+		return identity, nil
+	}
+
+	identity, err := r.lookupClaims(ctx, user)
+	if err != nil {
+		return nil, fmt.Errorf("while looking up claims: %w", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cache[cacheKey] = cachedIdentity{
+		identity:  identity,
+		expiresAt: r.now().Add(r.cacheTTL()),
+	}
+	return identity, nil
+}
+
+func (r *ChatUserResolver) cached(key string) (*K8sIdentity, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	item, found := r.cache[key]
+	if !found || r.now().After(item.expiresAt) {
+		return nil, false
+	}
+	return item.identity, true
+}
+
+func (r *ChatUserResolver) lookupClaims(ctx context.Context, user ChatUser) (*K8sIdentity, error) {
+	var endpoint strings.Builder
+	if err := r.urlTpl.Execute(&endpoint, escapedChatUser(user)); err != nil {
+		return nil, fmt.Errorf("while rendering URL: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	lookup := r.cfg.ClaimsLookup
+	if lookup.BearerToken != "" {
+		req.Header.Set("Authorization", "Bearer "+lookup.BearerToken)
+	}
+
+	resp, err := r.httpCli.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("got unexpected status code %d", resp.StatusCode)
+	}
+
+	var claims map[string]any
+	if err := json.NewDecoder(resp.Body).Decode(&claims); err != nil {
+		return nil, fmt.Errorf("while decoding claims: %w", err)
+	}
+
+	k8sUser, _ := claims[claimOrDefault(lookup.UserClaim, config.DefaultClaimsLookupUserClaim)].(string)
+	if k8sUser == "" {
+		return nil, nil
+	}
+
+	return &K8sIdentity{
+		User:   k8sUser,
+		Groups: groupsClaim(claims[claimOrDefault(lookup.GroupsClaim, config.DefaultClaimsLookupGroupsClaim)]),
+	}, nil
+}
+
+// escapedChatUser returns a copy of the user with all fields percent-encoded,
+// so they can be safely used both in the URL path and in query parameters.
+func escapedChatUser(user ChatUser) ChatUser {
+	escape := func(in string) string {
+		return strings.ReplaceAll(url.QueryEscape(in), "+", "%20")
+	}
+	return ChatUser{
+		Platform:    config.CommPlatformIntegration(escape(string(user.Platform))),
+		ID:          escape(user.ID),
+		Email:       escape(user.Email),
+		DisplayName: escape(user.DisplayName),
+	}
+}
+
+func (r *ChatUserResolver) cacheTTL() time.Duration {
+	if r.cfg.ClaimsLookup.CacheTTL > 0 {
+		return r.cfg.ClaimsLookup.CacheTTL
+	}
+	return config.DefaultClaimsLookupCacheTTL
+}
+
+func claimOrDefault(claim, def string) string {
+	if claim == "" {
+		return def
+	}
+	return claim
+}
+
+// groupsClaim returns groups from the claim, which can be either a list or a single string.
+func groupsClaim(in any) []string {
+	switch val := in.(type) {
+	case string:
+		if val == "" {
+			return nil
+		}
+		return []string{val}
+	case []any:
+		var out []string
+		for _, item := range val {
+			if group, ok := item.(string); ok && group != "" {
+				out = append(out, group)
+			}
+		}
+		return out
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,206 @@
+package plugin
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/rest"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+func TestChatUserResolverStaticMappings(t *testing.T) {
+	// given
+	resolver, err := NewChatUserResolver(config.ChatUsers{
+		Mappings: []config.ChatUserMapping{
+			{
+				PlatformUser:     "U123",
+				Platform:         config.SocketSlackCommPlatformIntegration,
+				KubernetesUser:   "slack-user",
+				KubernetesGroups: []string{"developers"},
+			},
+			{
+				PlatformUser:   "Jane@Example.com",
+				KubernetesUser: "jane",
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		user     ChatUser
+		expected *K8sIdentity
+	}{
+		{
+			name: "matched by ID and platform",
+			user: ChatUser{Platform: config.SocketSlackCommPlatformIntegration, ID: "U123"},
+			expected: &K8sIdentity{
+				User:   "slack-user",
+				Groups: []string{"developers"},
+			},
+		},
+		{
+			name:     "not matched as platform is different",
+			user:     ChatUser{Platform: config.DiscordCommPlatformIntegration, ID: "U123"},
+			expected: nil,
+		},
+		{
+			name:     "matched by email case-insensitively on any platform",
+			user:     ChatUser{Platform: config.MattermostCommPlatformIntegration, ID: "m-1", Email: "jane@example.com"},
+			expected: &K8sIdentity{User: "jane"},
+		},
+		{
+			name:     "not mapped",
+			user:     ChatUser{Platform: config.MattermostCommPlatformIntegration, ID: "m-2"},
+			expected: nil,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			got, err := resolver.Resolve(context.Background(), tc.user)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestChatUserResolverClaimsLookup(t *testing.T) {
+	// given
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+
+		switch r.URL.Path {
+		case "/users/socketSlack/U123":
+			_, _ = w.Write([]byte(`{"email": "jane@example.com", "roles": ["developers", "ops"]}`))
+		case "/users/socketSlack/U456":
+			_, _ = w.Write([]byte(`{"email": "john@example.com", "roles": "viewers"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	resolver, err := NewChatUserResolver(config.ChatUsers{
+		ClaimsLookup: config.ClaimsLookup{
+			URL:         srv.URL + "/users/{{ .Platform }}/{{ .ID }}",
+			BearerToken: "token",
+			GroupsClaim: "roles",
+			CacheTTL:    time.Minute,
+		},
+	})
+	require.NoError(t, err)
+
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	resolver.now = func() time.Time { return now }
+
+	// when
+	listGroups, err := resolver.Resolve(context.Background(), ChatUser{Platform: config.SocketSlackCommPlatformIntegration, ID: "U123"})
+	require.NoError(t, err)
+	singleGroup, err := resolver.Resolve(context.Background(), ChatUser{Platform: config.SocketSlackCommPlatformIntegration, ID: "U456"})
+	require.NoError(t, err)
+	notFound, err := resolver.Resolve(context.Background(), ChatUser{Platform: config.SocketSlackCommPlatformIntegration, ID: "U789"})
+	require.NoError(t, err)
+
+	// then
+	assert.Equal(t, &K8sIdentity{User: "jane@example.com", Groups: []string{"developers", "ops"}}, listGroups)
+	assert.Equal(t, &K8sIdentity{User: "john@example.com", Groups: []string{"viewers"}}, singleGroup)
+	assert.Nil(t, notFound)
+	assert.EqualValues(t, 3, calls.Load())
+
+	// when cached
+	_, err = resolver.Resolve(context.Background(), ChatUser{Platform: config.SocketSlackCommPlatformIntegration, ID: "U123"})
+	require.NoError(t, err)
+
+	// then
+	assert.EqualValues(t, 3, calls.Load())
+
+	// when expired
+	now = now.Add(2 * time.Minute)
+	_, err = resolver.Resolve(context.Background(), ChatUser{Platform: config.SocketSlackCommPlatformIntegration, ID: "U123"})
+	require.NoError(t, err)
+
+	// then
+	assert.EqualValues(t, 4, calls.Load())
+}
+
+func TestChatUserResolverClaimsLookupEscapesURLFields(t *testing.T) {
+	// given
+	var gotQuery url.Values
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.Query()
+		_, _ = w.Write([]byte(`{"email": "jane@example.com"}`))
+	}))
+	defer srv.Close()
+
+	resolver, err := NewChatUserResolver(config.ChatUsers{
+		ClaimsLookup: config.ClaimsLookup{
+			URL: srv.URL + "/userinfo?email={{ .Email }}",
+		},
+	})
+	require.NoError(t, err)
+
+	// when
+	_, err = resolver.Resolve(context.Background(), ChatUser{
+		Platform: config.SocketSlackCommPlatformIntegration,
+		ID:       "U123",
+		Email:    "jane+dev@example.com&admin=true",
+	})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, url.Values{"email": []string{"jane+dev@example.com&admin=true"}}, gotQuery)
+}
+
+func TestGenerateKubeConfigForChatUser(t *testing.T) {
+	// given
+	pluginCtx := config.PluginContext{
+		RBAC: &config.PolicyRule{
+			User: config.UserPolicySubject{
+				Type:   config.ChatUserPolicySubjectType,
+				Prefix: "chat:",
+			},
+			Group: config.GroupPolicySubject{
+				Type: config.ChatUserPolicySubjectType,
+			},
+		},
+	}
+
+	t.Run("impersonates mapped user", func(t *testing.T) {
+		// when
+		raw, err := GenerateKubeConfig(&rest.Config{Host: "https://localhost"}, "", pluginCtx, KubeConfigInput{
+			ChatUser: &K8sIdentity{User: "jane", Groups: []string{"developers"}},
+		})
+
+		// then
+		require.NoError(t, err)
+
+		var got clientcmdapi.Config
+		require.NoError(t, yaml.Unmarshal(raw, &got))
+		require.Len(t, got.AuthInfos, 1)
+		assert.Equal(t, "chat:jane", got.AuthInfos[0].AuthInfo.Impersonate)
+		assert.Equal(t, []string{"developers"}, got.AuthInfos[0].AuthInfo.ImpersonateGroups)
+	})
+
+	t.Run("returns error for not mapped user", func(t *testing.T) {
+		// when
+		_, err := GenerateKubeConfig(&rest.Config{Host: "https://localhost"}, "", pluginCtx, KubeConfigInput{})
+
+		// then
+		assert.ErrorIs(t, err, ErrChatUserNotMapped)
+	})
+}
@@ -8,6 +8,10 @@ import (
 // ErrNotStartedPluginManager is an error returned when Plugin Manager was not yet started and initialized successfully.
 var ErrNotStartedPluginManager = errors.New("plugin manager is not started yet")
 
+// ErrPluginVersionChanged is an error returned when a version of an already started plugin changes.
+// Plugin binaries are not replaced at runtime, so the app needs to be restarted.
+var ErrPluginVersionChanged = errors.New("version of a started plugin changed")
+
 // NotFoundPluginError is an error returned when a given Plugin cannot be found in a given repository.
 type NotFoundPluginError struct {
 	msg string
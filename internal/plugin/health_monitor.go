@@ -54,6 +54,10 @@ func (m *HealthMonitor) monitorSourcePluginHealth(ctx context.Context) {
 		case <-ctx.Done():
 			return
 		case plugin := <-m.sourceSupervisorChan:
+			if _, ok := m.sourcesStore.EnabledPlugins.Get(plugin.pluginKey); !ok {
+				m.log.Infof("Source plugin %q was disabled. Skipping restart...", plugin.pluginKey)
+				continue
+			}
 			m.log.Infof("Restarting source plugin %q, attempt %d/%d...", plugin.pluginKey, m.pluginHealthStats.GetRestartCount(plugin.pluginKey)+1, m.policy.Threshold)
 			if source, ok := m.sourcesStore.EnabledPlugins.Get(plugin.pluginKey); ok && source.Cleanup != nil {
 				m.log.Debugf("Releasing resources of source plugin %q...", plugin.pluginKey)
@@ -86,6 +90,10 @@ func (m *HealthMonitor) monitorExecutorPluginHealth(ctx context.Context) {
 		case <-ctx.Done():
 			return
 		case plugin := <-m.executorSupervisorChan:
+			if _, ok := m.executorsStore.EnabledPlugins.Get(plugin.pluginKey); !ok {
+				m.log.Infof("Executor plugin %q was disabled. Skipping restart...", plugin.pluginKey)
+				continue
+			}
 			m.log.Infof("Restarting executor plugin %q, attempt %d/%d...", plugin.pluginKey, m.pluginHealthStats.GetRestartCount(plugin.pluginKey)+1, m.policy.Threshold)
 
 			if executor, ok := m.executorsStore.EnabledPlugins.Get(plugin.pluginKey); ok && executor.Cleanup != nil {
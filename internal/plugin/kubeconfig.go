@@ -1,6 +1,8 @@
 package plugin
 
 import (
+	"errors"
+
 	"k8s.io/client-go/rest"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api/v1"
 	"sigs.k8s.io/yaml"
@@ -13,8 +15,19 @@ const (
 	kubeconfigDefaultNamespace = "default"
 )
 
+// ErrChatUserNotMapped is returned when the RBAC policy uses the ChatUser subject, but the chat user isn't mapped to a Kubernetes identity.
+var ErrChatUserNotMapped = errors.New("chat user is not mapped to any Kubernetes user")
+
 type KubeConfigInput struct {
 	Channel string
+	// ChatUser is the Kubernetes identity of the chat user who runs a given command. It is required by the ChatUser policy subject type.
+	ChatUser *K8sIdentity
+}
+
+// K8sIdentity holds the Kubernetes user and groups.
+type K8sIdentity struct {
+	User   string
+	Groups []string
 }
 
 func GenerateKubeConfig(restCfg *rest.Config, clusterName string, pluginCtx config.PluginContext, input KubeConfigInput) ([]byte, error) {
@@ -32,6 +45,10 @@ func GenerateKubeConfig(restCfg *rest.Config, clusterName string, pluginCtx conf
 		return nil, nil
 	}
 
+	if rbac.UsesChatUser() && input.ChatUser == nil {
+		return nil, ErrChatUserNotMapped
+	}
+
 	apiCfg := clientcmdapi.Config{
 		Kind:       "Config",
 		APIVersion: "v1",
@@ -85,6 +102,8 @@ func generateUserSubject(rbac config.UserPolicySubject, group config.GroupPolicy
 		user = rbac.Prefix + rbac.Static.Value
 	case config.ChannelNamePolicySubjectType:
 		user = rbac.Prefix + input.Channel
+	case config.ChatUserPolicySubjectType:
+		user = rbac.Prefix + input.ChatUser.User
 	default:
 		if group.Type != config.EmptyPolicySubjectType {
 			user = "botkube-internal-static-user"
@@ -101,6 +120,10 @@ func generateGroupSubject(rbac config.GroupPolicySubject, input KubeConfigInput)
 		}
 	case config.ChannelNamePolicySubjectType:
 		group = append(group, rbac.Prefix+input.Channel)
+	case config.ChatUserPolicySubjectType:
+		for _, value := range input.ChatUser.Groups {
+			group = append(group, rbac.Prefix+value)
+		}
 	}
 	return
 }
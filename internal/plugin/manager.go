@@ -16,6 +16,7 @@ import (
 
 	"github.com/hashicorp/go-plugin"
 	"github.com/sirupsen/logrus"
+	"golang.org/x/exp/slices"
 
 	"github.com/kubeshop/botkube/internal/httpx"
 	"github.com/kubeshop/botkube/pkg/api"
@@ -57,6 +58,8 @@ type Manager struct {
 	executorSupervisorChan chan pluginMetadata
 	schedulerChan          chan string
 
+	// reloadMu guards the enabled plugins, which can be changed once the configuration is reloaded.
+	reloadMu          sync.Mutex
 	executorsToEnable []string
 	executorsStore    *store[executor.Executor]
 
@@ -165,6 +168,134 @@ func (m *Manager) start(ctx context.Context, forceUpdate bool) error {
 	return nil
 }
 
+// Reload starts newly enabled plugins and stops the ones which are not used anymore. Other plugins keep running.
+// It returns ErrPluginVersionChanged if a version of a started plugin changes, as the binary cannot be replaced at runtime.
+func (m *Manager) Reload(ctx context.Context, executors, sources []string) error {
+	m.reloadMu.Lock()
+	defer m.reloadMu.Unlock()
+
+	if err := ensureSameVersions(m.executorsToEnable, executors); err != nil {
+		return err
+	}
+	if err := ensureSameVersions(m.sourcesToEnable, sources); err != nil {
+		return err
+	}
+
+	addedExecutors, removedExecutors := diffPluginKeys(m.executorsToEnable, executors)
+	addedSources, removedSources := diffPluginKeys(m.sourcesToEnable, sources)
+	if len(addedExecutors) == 0 && len(removedExecutors) == 0 && len(addedSources) == 0 && len(removedSources) == 0 {
+		return nil
+	}
+
+	if !m.isStarted.Load() {
+		m.executorsToEnable, m.sourcesToEnable = executors, sources
+		return m.Start(ctx)
+	}
+
+	m.log.WithFields(logrus.Fields{
+		"addedExecutors":   strings.Join(addedExecutors, ","),
+		"removedExecutors": strings.Join(removedExecutors, ","),
+		"addedSources":     strings.Join(addedSources, ","),
+		"removedSources":   strings.Join(removedSources, ","),
+	}).Info("Reloading plugins")
+
+	err := m.startPlugins(ctx, addedExecutors, addedSources, false)
+	if IsNotFoundError(err) {
+		m.log.Infof("%s. Retrying plugins reload with forced repo index update.", err)
+		err = m.startPlugins(ctx, addedExecutors, addedSources, true)
+	}
+	if err != nil {
+		return err
+	}
+
+	stopPlugins(m.executorsStore.EnabledPlugins, removedExecutors)
+	stopPlugins(m.sourcesStore.EnabledPlugins, removedSources)
+	m.executorsToEnable, m.sourcesToEnable = executors, sources
+	return nil
+}
+
+func (m *Manager) startPlugins(ctx context.Context, executors, sources []string, forceUpdate bool) error {
+	if err := m.loadRepositoriesMetadata(ctx, forceUpdate); err != nil {
+		return err
+	}
+
+	executorPlugins, err := m.loadPlugins(ctx, TypeExecutor, executors, m.executorsStore.Repository)
+	if err != nil {
+		return err
+	}
+	executorClients, err := createGRPCClients[executor.Executor](ctx, m.log, m.logConfig, executorPlugins, TypeExecutor, m.executorSupervisorChan, m.healthCheckInterval)
+	if err != nil {
+		return fmt.Errorf("while creating executor plugins: %w", err)
+	}
+
+	sourcesPlugins, err := m.loadPlugins(ctx, TypeSource, sources, m.sourcesStore.Repository)
+	if err != nil {
+		return err
+	}
+	sourcesClients, err := createGRPCClients[source.Source](ctx, m.log, m.logConfig, sourcesPlugins, TypeSource, m.sourceSupervisorChan, m.healthCheckInterval)
+	if err != nil {
+		return fmt.Errorf("while creating source plugins: %w", err)
+	}
+
+	for key, p := range executorClients.data {
+		m.executorsStore.EnabledPlugins.Insert(key, p)
+	}
+	for key, p := range sourcesClients.data {
+		m.sourcesStore.EnabledPlugins.Insert(key, p)
+	}
+	return nil
+}
+
+// stopPlugins removes plugins from the store before releasing them, so the health monitor doesn't restart them.
+func stopPlugins[T any](enabledPlugins *storePlugins[T], keys []string) {
+	for _, key := range keys {
+		p, found := enabledPlugins.Get(key)
+		enabledPlugins.Delete(key)
+		if found && p.Cleanup != nil {
+			p.Cleanup()
+		}
+	}
+}
+
+// diffPluginKeys returns plugin keys which are present only in the updated or only in the current list.
+func diffPluginKeys(current, updated []string) ([]string, []string) {
+	var added, removed []string
+	for _, key := range updated {
+		if !slices.Contains(current, key) {
+			added = append(added, key)
+		}
+	}
+	for _, key := range current {
+		if !slices.Contains(updated, key) {
+			removed = append(removed, key)
+		}
+	}
+	return added, removed
+}
+
+// ensureSameVersions returns an error if any plugin is present in both lists with different versions.
+func ensureSameVersions(current, updated []string) error {
+	versions := map[string]string{}
+	for _, key := range current {
+		repo, name, ver, err := config.DecomposePluginKey(key)
+		if err != nil {
+			return err
+		}
+		versions[repo+"/"+name] = ver
+	}
+
+	for _, key := range updated {
+		repo, name, ver, err := config.DecomposePluginKey(key)
+		if err != nil {
+			return err
+		}
+		if currentVer, found := versions[repo+"/"+name]; found && currentVer != ver {
+			return fmt.Errorf("%w: %q is started with version %q", ErrPluginVersionChanged, key, currentVer)
+		}
+	}
+	return nil
+}
+
 // GetExecutor returns the executor client for a given plugin.
 func (m *Manager) GetExecutor(name string) (executor.Executor, error) {
 	if !m.isStarted.Load() {
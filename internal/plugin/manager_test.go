@@ -105,3 +105,53 @@ func TestNewPluginOSRunCommand_HappyPath(t *testing.T) {
 	}
 	assert.True(t, found)
 }
+
+func TestDiffPluginKeys(t *testing.T) {
+	// given
+	current := []string{"botkube/kubectl@v1.0.0", "botkube/helm@v1.0.0"}
+	updated := []string{"botkube/kubectl@v1.0.0", "botkube/argocd@v1.0.0"}
+
+	// when
+	added, removed := diffPluginKeys(current, updated)
+
+	// then
+	assert.Equal(t, []string{"botkube/argocd@v1.0.0"}, added)
+	assert.Equal(t, []string{"botkube/helm@v1.0.0"}, removed)
+}
+
+func TestEnsureSameVersions(t *testing.T) {
+	tests := []struct {
+		name string
+
+		current []string
+		updated []string
+
+		expErrMsg string
+	}{
+		{
+			name:    "Same versions",
+			current: []string{"botkube/kubectl@v1.0.0", "botkube/helm@v1.0.0"},
+			updated: []string{"botkube/kubectl@v1.0.0", "botkube/argocd@v1.1.0"},
+		},
+		{
+			name:      "Changed version",
+			current:   []string{"botkube/kubectl@v1.0.0"},
+			updated:   []string{"botkube/kubectl@v1.1.0"},
+			expErrMsg: `version of a started plugin changed: "botkube/kubectl@v1.1.0" is started with version "v1.0.0"`,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			err := ensureSameVersions(tc.current, tc.updated)
+
+			// then
+			if tc.expErrMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, ErrPluginVersionChanged)
+			assert.EqualError(t, err, tc.expErrMsg)
+		})
+	}
+}
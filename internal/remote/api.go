@@ -86,6 +86,7 @@ type PatchDeploymentConfigInput struct {
 	Notification    *NotificationPatchDeploymentConfigInput  `json:"notification"`
 	SourceBinding   *SourceBindingPatchDeploymentConfigInput `json:"sourceBinding"`
 	Action          *ActionPatchDeploymentConfigInput        `json:"action"`
+	Silences        *SilencesPatchDeploymentConfigInput      `json:"silences"`
 }
 
 // NotificationPatchDeploymentConfigInput contains patch input specific to notifications
@@ -110,6 +111,22 @@ type ActionPatchDeploymentConfigInput struct {
 	Enabled *bool  `json:"enabled"`
 }
 
+// SilencesPatchDeploymentConfigInput contains the full list of notification silences, which replaces the existing one.
+type SilencesPatchDeploymentConfigInput struct {
+	Silences []SilenceInput `json:"silences"`
+}
+
+// SilenceInput represents a single notification silence.
+type SilenceInput struct {
+	ID        string `json:"id"`
+	Source    string `json:"source"`
+	Namespace string `json:"namespace"`
+	Reason    string `json:"reason"`
+	CreatedBy string `json:"createdBy"`
+	CreatedAt string `json:"createdAt"`
+	ExpiresAt string `json:"expiresAt"`
+}
+
 // DeploymentFailureInput represents the input data structure for reporting a deployment failure.
 type DeploymentFailureInput struct {
 	// ResourceVersion is the deployment version that we want to alter.
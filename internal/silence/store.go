@@ -0,0 +1,166 @@
+package silence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+// idLength is the length of generated silence IDs. They are short, so they can be easily typed in chat.
+const idLength = 8
+
+// ErrNotFound is returned when a given silence doesn't exist.
+var ErrNotFound = errors.New("silence not found")
+
+// Persistence persists silences, so they survive Botkube restarts.
+type Persistence interface {
+	PersistSilences(ctx context.Context, silences []config.Silence) error
+	GetSilences(ctx context.Context) ([]config.Silence, error)
+}
+
+// Store keeps notification silences in memory and persists them on every change.
+// It is safe for concurrent use, as it's shared between the `silence` command executor and the source dispatcher.
+type Store struct {
+	persistence Persistence
+	now         func() time.Time
+
+	mu       sync.RWMutex
+	silences []config.Silence
+
+	// changeMu serializes changes, so the silences are persisted in the same order as they were changed.
+	changeMu sync.Mutex
+}
+
+// NewStore returns a new Store instance.
+func NewStore(persistence Persistence) *Store {
+	return &Store{
+		persistence: persistence,
+		now:         time.Now,
+	}
+}
+
+// Load loads persisted silences. Expired ones are skipped.
+func (s *Store) Load(ctx context.Context) error {
+	silences, err := s.persistence.GetSilences(ctx)
+	if err != nil {
+		return fmt.Errorf("while getting persisted silences: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.silences = activeOnly(silences, s.now())
+	return nil
+}
+
+// Create persists a new silence and adds it to the active ones. The ID and creation time are generated.
+func (s *Store) Create(ctx context.Context, in config.Silence) (config.Silence, error) {
+	s.changeMu.Lock()
+	defer s.changeMu.Unlock()
+
+	now := s.now()
+	in.ID = uuid.New().String()[:idLength]
+	in.CreatedAt = now
+
+	if !in.IsActive(now) {
+		return config.Silence{}, fmt.Errorf("silence must expire in the future, got %s", in.ExpiresAt.Format(time.RFC3339))
+	}
+
+	s.mu.RLock()
+	updated := append(activeOnly(s.silences, now), in)
+	s.mu.RUnlock()
+
+	// the silence is applied only once it is persisted, so it's not lost after restart
+	if err := s.persist(ctx, updated); err != nil {
+		return config.Silence{}, err
+	}
+
+	s.mu.Lock()
+	s.silences = updated
+	s.mu.Unlock()
+	return in, nil
+}
+
+// Delete persists the removal of a given silence and then removes it from the active ones. Returns ErrNotFound if there is no active silence with a given ID.
+func (s *Store) Delete(ctx context.Context, id string) error {
+	s.changeMu.Lock()
+	defer s.changeMu.Unlock()
+
+	now := s.now()
+
+	s.mu.RLock()
+	var (
+		found bool
+		out   []config.Silence
+	)
+	for _, silence := range activeOnly(s.silences, now) {
+		if silence.ID == id {
+			found = true
+			continue
+		}
+		out = append(out, silence)
+	}
+	s.mu.RUnlock()
+	if !found {
+		return ErrNotFound
+	}
+
+	if err := s.persist(ctx, out); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	s.silences = out
+	s.mu.Unlock()
+	return nil
+}
+
+// List returns all active silences sorted by the expiration time.
+func (s *Store) List() []config.Silence {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	out := activeOnly(s.silences, s.now())
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].ExpiresAt.Before(out[j].ExpiresAt)
+	})
+	return out
+}
+
+// IsSilenced returns true if there is an active silence for a given source and namespace.
+func (s *Store) IsSilenced(sourceName, namespace string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	now := s.now()
+	for _, silence := range s.silences {
+		if silence.IsActive(now) && silence.Matches(sourceName, namespace) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Store) persist(ctx context.Context, silences []config.Silence) error {
+	if err := s.persistence.PersistSilences(ctx, silences); err != nil {
+		return fmt.Errorf("while persisting silences: %w", err)
+	}
+	return nil
+}
+
+func activeOnly(in []config.Silence, now time.Time) []config.Silence {
+	out := make([]config.Silence, 0, len(in))
+	for _, silence := range in {
+		if !silence.IsActive(now) {
+			continue
+		}
+		out = append(out, silence)
+	}
+	return out
+}
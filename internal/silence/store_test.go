@@ -0,0 +1,125 @@
+package silence
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+func TestStore(t *testing.T) {
+	// given
+	ctx := context.Background()
+	now := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+	persistence := &fakePersistence{
+		silences: []config.Silence{
+			{ID: "expired", Source: "k8s-events", ExpiresAt: now.Add(-time.Minute)},
+			{ID: "persisted", Namespace: "kube-system", ExpiresAt: now.Add(time.Hour)},
+		},
+	}
+
+	store := NewStore(persistence)
+	store.now = func() time.Time { return now }
+
+	// when
+	err := store.Load(ctx)
+	require.NoError(t, err)
+
+	created, err := store.Create(ctx, config.Silence{
+		Source:    "k8s-err-events",
+		Namespace: "payments",
+		Reason:    "db migration",
+		ExpiresAt: now.Add(2 * time.Hour),
+	})
+	require.NoError(t, err)
+
+	// then
+	assert.Len(t, created.ID, idLength)
+	assert.Equal(t, now, created.CreatedAt)
+	assert.Equal(t, []string{"persisted", created.ID}, silenceIDs(store.List()))
+	assert.Equal(t, []string{"persisted", created.ID}, silenceIDs(persistence.silences))
+
+	assert.True(t, store.IsSilenced("k8s-err-events", "payments"))
+	assert.True(t, store.IsSilenced("any-source", "kube-system"))
+	assert.False(t, store.IsSilenced("k8s-err-events", "default"))
+	assert.False(t, store.IsSilenced("k8s-events", "payments"))
+
+	// when
+	err = store.Delete(ctx, created.ID)
+
+	// then
+	require.NoError(t, err)
+	assert.False(t, store.IsSilenced("k8s-err-events", "payments"))
+	assert.Equal(t, []string{"persisted"}, silenceIDs(persistence.silences))
+
+	// when
+	err = store.Delete(ctx, created.ID)
+
+	// then
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestStoreExpiration(t *testing.T) {
+	// given
+	ctx := context.Background()
+	now := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+	store := NewStore(&fakePersistence{})
+	store.now = func() time.Time { return now }
+
+	_, err := store.Create(ctx, config.Silence{ExpiresAt: now.Add(time.Hour)})
+	require.NoError(t, err)
+
+	// when
+	silencedBefore := store.IsSilenced("k8s-events", "default")
+	now = now.Add(time.Hour)
+	silencedAfter := store.IsSilenced("k8s-events", "default")
+
+	// then
+	assert.True(t, silencedBefore)
+	assert.False(t, silencedAfter)
+	assert.Empty(t, store.List())
+}
+
+func TestStoreCreatePersistenceError(t *testing.T) {
+	// given
+	ctx := context.Background()
+	store := NewStore(&fakePersistence{persistErr: errors.New("conflict")})
+
+	// when
+	_, err := store.Create(ctx, config.Silence{ExpiresAt: time.Now().Add(time.Hour)})
+
+	// then
+	assert.EqualError(t, err, "while persisting silences: conflict")
+	assert.False(t, store.IsSilenced("k8s-events", "default"))
+	assert.Empty(t, store.List())
+}
+
+type fakePersistence struct {
+	silences   []config.Silence
+	persistErr error
+}
+
+func (f *fakePersistence) PersistSilences(_ context.Context, silences []config.Silence) error {
+	if f.persistErr != nil {
+		return f.persistErr
+	}
+	f.silences = silences
+	return nil
+}
+
+func (f *fakePersistence) GetSilences(context.Context) ([]config.Silence, error) {
+	return f.silences, nil
+}
+
+func silenceIDs(in []config.Silence) []string {
+	var out []string
+	for _, s := range in {
+		out = append(out, s.ID)
+	}
+	return out
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/sirupsen/logrus"
@@ -30,11 +31,14 @@ type Dispatcher struct {
 	actionProvider       ActionProvider
 	reporter             AnalyticsReporter
 	auditReporter        audit.AuditReporter
+	notifiersMu          sync.RWMutex
 	markdownNotifiers    []notifier.Bot
 	interactiveNotifiers []notifier.Bot
-	sinkNotifiers        []notifier.Sink
+	sinkNotifiers        map[string][]notifier.Sink
 	restCfg              *rest.Config
 	clusterName          string
+	silences             SilenceChecker
+	router               *Router
 }
 
 // ActionProvider defines a provider that is responsible for automated actions.
@@ -43,6 +47,11 @@ type ActionProvider interface {
 	ExecuteAction(ctx context.Context, action action.Action) interactive.CoreMessage
 }
 
+// SilenceChecker checks whether notifications for a given source and namespace are silenced.
+type SilenceChecker interface {
+	IsSilenced(sourceName, namespace string) bool
+}
+
 // AnalyticsReporter defines a reporter that collects analytics data.
 type AnalyticsReporter interface {
 	// ReportHandledEventSuccess reports a successfully handled event using a given integration type, communication platform, and plugin.
@@ -59,31 +68,39 @@ type AnalyticsReporter interface {
 }
 
 // NewDispatcher create a new Dispatcher instance.
-func NewDispatcher(log logrus.FieldLogger, clusterName string, notifiers map[string]bot.Bot, sinkNotifiers []notifier.Sink, manager *plugin.Manager, actionProvider ActionProvider, reporter AnalyticsReporter, auditReporter audit.AuditReporter, restCfg *rest.Config) *Dispatcher {
-	var (
-		interactiveNotifiers []notifier.Bot
-		markdownNotifiers    []notifier.Bot
-	)
+func NewDispatcher(log logrus.FieldLogger, clusterName string, notifiers map[string]bot.Bot, sinkNotifiers map[string][]notifier.Sink, manager *plugin.Manager, actionProvider ActionProvider, reporter AnalyticsReporter, auditReporter audit.AuditReporter, restCfg *rest.Config, silences SilenceChecker, router *Router) *Dispatcher {
+	d := &Dispatcher{
+		log:            log,
+		manager:        manager,
+		actionProvider: actionProvider,
+		reporter:       reporter,
+		auditReporter:  auditReporter,
+		restCfg:        restCfg,
+		clusterName:    clusterName,
+		silences:       silences,
+		router:         router,
+		sinkNotifiers:  map[string][]notifier.Sink{},
+	}
+	d.AddNotifiers(notifiers, sinkNotifiers)
+	return d
+}
+
+// AddNotifiers registers bots and sinks which are started after the configuration is reloaded.
+// Sinks are grouped by communication group names, so routing rules can select sinks from a given group.
+func (d *Dispatcher) AddNotifiers(notifiers map[string]bot.Bot, sinkNotifiers map[string][]notifier.Sink) {
+	d.notifiersMu.Lock()
+	defer d.notifiersMu.Unlock()
+
 	for _, n := range notifiers {
 		if n.IntegrationName().IsInteractive() {
-			interactiveNotifiers = append(interactiveNotifiers, n)
+			d.interactiveNotifiers = append(d.interactiveNotifiers, n)
 			continue
 		}
 
-		markdownNotifiers = append(markdownNotifiers, n)
+		d.markdownNotifiers = append(d.markdownNotifiers, n)
 	}
-
-	return &Dispatcher{
-		log:                  log,
-		manager:              manager,
-		actionProvider:       actionProvider,
-		reporter:             reporter,
-		auditReporter:        auditReporter,
-		interactiveNotifiers: interactiveNotifiers,
-		markdownNotifiers:    markdownNotifiers,
-		sinkNotifiers:        sinkNotifiers,
-		restCfg:              restCfg,
-		clusterName:          clusterName,
+	for commGroupName, sinks := range sinkNotifiers {
+		d.sinkNotifiers[commGroupName] = append(d.sinkNotifiers[commGroupName], sinks...)
 	}
 }
 
@@ -171,25 +188,105 @@ func (d *Dispatcher) DispatchExternalRequest(dispatch ExternalRequestDispatch) e
 }
 
 func (d *Dispatcher) getBotNotifiers(dispatch PluginDispatch) []notifier.Bot {
+	d.notifiersMu.RLock()
+	defer d.notifiersMu.RUnlock()
 	if dispatch.isInteractivitySupported {
 		return d.interactiveNotifiers
 	}
 	return d.markdownNotifiers
 }
 
+// getSinkNotifiers returns sinks selected by the routing rules.
+func (d *Dispatcher) getSinkNotifiers(receivers *notifier.Receivers) []notifier.Sink {
+	d.notifiersMu.RLock()
+	defer d.notifiersMu.RUnlock()
+
+	var out []notifier.Sink
+	for commGroupName, sinks := range d.sinkNotifiers {
+		for _, n := range sinks {
+			if !receivers.AllowsSink(commGroupName, n.IntegrationName()) {
+				d.log.Debugf("Skipping %q sink from %q communication group as it's not selected by routing rules", n.IntegrationName(), commGroupName)
+				continue
+			}
+			out = append(out, n)
+		}
+	}
+	return out
+}
+
 func (d *Dispatcher) dispatchMsg(ctx context.Context, event source.Event, dispatch PluginDispatch) {
 	var (
 		pluginName = dispatch.pluginName
 		sources    = []string{dispatch.sourceName}
 	)
 
+	// silences mute notifications only, so actions are still executed and the event is audited
+	namespace := eventNamespace(event)
+	silenced := d.silences.IsSilenced(dispatch.sourceName, namespace)
+	if silenced {
+		d.log.WithFields(logrus.Fields{
+			"sourceName": dispatch.sourceName,
+			"namespace":  namespace,
+		}).Debug("Notifications are silenced. Skipping sending event...")
+	}
+
+	receivers, err := d.router.Route(dispatch.sourceName, event)
+	if err != nil {
+		d.log.Errorf("while evaluating routing rules for source %q. Delivering event to all bound receivers: %s", dispatch.sourceName, err.Error())
+	}
+
+	if !silenced {
+		d.sendEvent(ctx, event, dispatch, receivers)
+	}
+
+	if err := d.reportAuditEvent(ctx, pluginName, event.RawObject, dispatch.sourceName, dispatch.sourceDisplayName); err != nil {
+		d.log.Errorf("while reporting audit event for source %q: %s", dispatch.sourceName, err.Error())
+	}
+
+	// execute actions
+	actions, err := d.actionProvider.RenderedActions(event.RawObject, sources)
+	if err != nil {
+		d.log.Errorf("while rendering automated actions: %s", err.Error())
+		return
+	}
+	for _, act := range actions {
+		log := d.log.WithFields(logrus.Fields{
+			"name":    act.DisplayName,
+			"command": act.Command,
+		})
+		log.Infof("Executing automated action...")
+		genericMsg := d.actionProvider.ExecuteAction(ctx, act)
+		log.WithField("message", fmt.Sprintf("%+v", genericMsg)).Debug("Automated action executed. Printing output message...")
+
+		if silenced {
+			continue
+		}
+		for _, n := range d.getBotNotifiers(dispatch) {
+			go func(n notifier.Bot) {
+				defer analytics.ReportPanicIfOccurs(d.log, d.reporter)
+				err := n.SendMessage(ctx, genericMsg, sources, receivers)
+				if err != nil {
+					d.log.Errorf("while sending action result message: %s", err.Error())
+				}
+			}(n)
+		}
+	}
+}
+
+// sendEvent sends a given event to bots and sinks selected by the routing rules.
+func (d *Dispatcher) sendEvent(ctx context.Context, event source.Event, dispatch PluginDispatch, receivers *notifier.Receivers) {
+	var (
+		pluginName = dispatch.pluginName
+		sources    = []string{dispatch.sourceName}
+	)
+
 	for _, n := range d.getBotNotifiers(dispatch) {
 		go func(n notifier.Bot) {
 			defer analytics.ReportPanicIfOccurs(d.log, d.reporter)
 			msg := interactive.CoreMessage{
 				Message: event.Message,
 			}
-			err := n.SendMessage(ctx, msg, sources)
+			err := n.SendMessage(ctx, msg, sources, receivers)
 			if err != nil {
 				reportErr := d.reportError(err, n, pluginName, event)
 				if reportErr != nil {
@@ -207,7 +304,7 @@ func (d *Dispatcher) dispatchMsg(ctx context.Context, event source.Event, dispat
 		}(n)
 	}
 
-	for _, n := range d.sinkNotifiers {
+	for _, n := range d.getSinkNotifiers(receivers) {
 		go func(n notifier.Sink) {
 			defer analytics.ReportPanicIfOccurs(d.log, d.reporter)
 			err := n.SendEvent(ctx, event.RawObject, sources)
@@ -227,36 +324,32 @@ func (d *Dispatcher) dispatchMsg(ctx context.Context, event source.Event, dispat
 			}
 		}(n)
 	}
+}
 
-	if err := d.reportAuditEvent(ctx, pluginName, event.RawObject, dispatch.sourceName, dispatch.sourceDisplayName); err != nil {
-		d.log.Errorf("while reporting audit event for source %q: %s", dispatch.sourceName, err.Error())
-	}
-
-	// execute actions
-	actions, err := d.actionProvider.RenderedActions(event.RawObject, sources)
-	if err != nil {
-		d.log.Errorf("while rendering automated actions: %s", err.Error())
-		return
+// eventNamespace returns the namespace of a given event. As sources don't share a common event schema,
+// it checks the raw object first and falls back to the "Namespace" field of the message sections.
+func eventNamespace(event source.Event) string {
+	if obj, ok := event.RawObject.(map[string]any); ok {
+		for _, key := range []string{"Namespace", "namespace"} {
+			if ns, ok := obj[key].(string); ok && ns != "" {
+				return ns
+			}
+		}
+		if metadata, ok := obj["metadata"].(map[string]any); ok {
+			if ns, ok := metadata["namespace"].(string); ok && ns != "" {
+				return ns
+			}
+		}
 	}
-	for _, act := range actions {
-		log := d.log.WithFields(logrus.Fields{
-			"name":    act.DisplayName,
-			"command": act.Command,
-		})
-		log.Infof("Executing automated action...")
-		genericMsg := d.actionProvider.ExecuteAction(ctx, act)
-		log.WithField("message", fmt.Sprintf("%+v", genericMsg)).Debug("Automated action executed. Printing output message...")
 
-		for _, n := range d.getBotNotifiers(dispatch) {
-			go func(n notifier.Bot) {
-				defer analytics.ReportPanicIfOccurs(d.log, d.reporter)
-				err := n.SendMessage(ctx, genericMsg, sources)
-				if err != nil {
-					d.log.Errorf("while sending action result message: %s", err.Error())
-				}
-			}(n)
+	for _, section := range event.Message.Sections {
+		for _, field := range section.TextFields {
+			if field.Key == "Namespace" {
+				return field.Value
+			}
 		}
 	}
+	return ""
 }
 
 func (d *Dispatcher) reportAuditEvent(ctx context.Context, pluginName string, event any, sourceName, sourceDisplayName string) error {
@@ -29,8 +29,13 @@ func (w IncomingWebhookData) FullURLForSource(sourceName string) string {
 	return fmt.Sprintf("%s/%s/%s", w.inClusterBaseURL, incomingWebhookPathPrefix, sourceName)
 }
 
+// StartedSourcesGetter returns source plugins started for a given source.
+type StartedSourcesGetter interface {
+	GetStartedSources(sourceName string) (StartedSources, bool)
+}
+
 // NewIncomingWebhookServer creates a new HTTP server for incoming webhooks.
-func NewIncomingWebhookServer(log logrus.FieldLogger, cfg *config.Config, dispatcher *Dispatcher, startedSources map[string]StartedSources) *httpx.Server {
+func NewIncomingWebhookServer(log logrus.FieldLogger, cfg *config.Config, dispatcher *Dispatcher, startedSources StartedSourcesGetter) *httpx.Server {
 	addr := fmt.Sprintf(":%d", cfg.Plugins.IncomingWebhook.Port)
 	router := incomingWebhookRouter(log, cfg, dispatcher, startedSources)
 
@@ -38,7 +43,7 @@ func NewIncomingWebhookServer(log logrus.FieldLogger, cfg *config.Config, dispat
 	return httpx.NewServer(log, addr, router)
 }
 
-func incomingWebhookRouter(log logrus.FieldLogger, cfg *config.Config, dispatcher *Dispatcher, startedSources map[string]StartedSources) *mux.Router {
+func incomingWebhookRouter(log logrus.FieldLogger, cfg *config.Config, dispatcher *Dispatcher, startedSources StartedSourcesGetter) *mux.Router {
 	router := mux.NewRouter()
 	pathPrefix := fmt.Sprintf("/%s/", incomingWebhookPathPrefix)
 	router.PathPrefix(pathPrefix).Methods(http.MethodPost).Handler(
@@ -56,7 +61,7 @@ func incomingWebhookRouter(log logrus.FieldLogger, cfg *config.Config, dispatche
 				})
 				logger.Debugf("Handling incoming webhook request...")
 
-				sourcePlugins, ok := startedSources[sourceName]
+				sourcePlugins, ok := startedSources.GetStartedSources(sourceName)
 				if !ok || len(sourcePlugins) == 0 {
 					writeJSONError(log, writer, fmt.Sprintf("source %q not found", sourceName), http.StatusNotFound)
 					return
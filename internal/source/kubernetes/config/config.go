@@ -1,12 +1,19 @@
 package config
 
 import (
+	"errors"
 	"fmt"
 	"regexp"
 	"strings"
+	"text/template"
 	"time"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+
 	"github.com/kubeshop/botkube/internal/ptr"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/expression"
 	"github.com/kubeshop/botkube/pkg/api/source"
 	"github.com/kubeshop/botkube/pkg/config"
 	"github.com/kubeshop/botkube/pkg/pluginx"
@@ -24,7 +31,13 @@ type Config struct {
 	Namespaces           *RegexConstraints  `yaml:"namespaces"`
 	Annotations          *map[string]string `yaml:"annotations"`
 	Labels               *map[string]string `yaml:"labels"`
+	LabelSelector        *Selector          `yaml:"labelSelector"`
+	AnnotationSelector   *Selector          `yaml:"annotationSelector"`
 	Filters              *Filters           `yaml:"filters"`
+	Deduplication        *Deduplication     `yaml:"deduplication"`
+	// MetricsPort is a port on which the plugin exposes its Prometheus metrics. If empty, metrics are not exposed.
+	// The plugin runs as a separate process, so it can't share the Botkube agent metrics port.
+	MetricsPort string `yaml:"metricsPort"`
 }
 
 type (
@@ -185,13 +198,132 @@ func (eventType EventType) String() string {
 
 // Resource contains resources to watch
 type Resource struct {
-	Type          string            `yaml:"type"`
-	Name          RegexConstraints  `yaml:"name"`
-	Namespaces    RegexConstraints  `yaml:"namespaces"`
-	Annotations   map[string]string `yaml:"annotations"`
-	Labels        map[string]string `yaml:"labels"`
-	Event         KubernetesEvent   `yaml:"event"`
-	UpdateSetting UpdateSetting     `yaml:"updateSetting"`
+	Type               string            `yaml:"type"`
+	Name               RegexConstraints  `yaml:"name"`
+	Namespaces         RegexConstraints  `yaml:"namespaces"`
+	Annotations        map[string]string `yaml:"annotations"`
+	Labels             map[string]string `yaml:"labels"`
+	LabelSelector      *Selector         `yaml:"labelSelector"`
+	AnnotationSelector *Selector         `yaml:"annotationSelector"`
+	Event              KubernetesEvent   `yaml:"event"`
+	UpdateSetting      UpdateSetting     `yaml:"updateSetting"`
+	Deduplication      *Deduplication    `yaml:"deduplication"`
+	Filter             *ResourceFilter   `yaml:"filter"`
+	Enrichment         *Enrichment       `yaml:"enrichment"`
+}
+
+// Enrichment contains configuration for adding details about related objects to notifications.
+type Enrichment struct {
+	// Owner adds the top-level controller owning the object, e.g. Deployment for a Pod.
+	Owner bool `yaml:"owner"`
+
+	// Node adds the name of the Node on which the Pod runs.
+	Node bool `yaml:"node"`
+
+	// Logs contains configuration for adding the last Pod container log lines.
+	Logs LogsEnrichment `yaml:"logs"`
+
+	// Events contains configuration for adding recent Events related to the object.
+	Events EventsEnrichment `yaml:"events"`
+}
+
+// LogsEnrichment contains configuration for adding Pod container logs.
+type LogsEnrichment struct {
+	Enabled bool `yaml:"enabled"`
+	// TailLines is a number of the last log lines to add for each container.
+	// If not specified, DefaultEnrichmentLogTailLines is used.
+	TailLines int64 `yaml:"tailLines"`
+}
+
+// EventsEnrichment contains configuration for adding related Events.
+type EventsEnrichment struct {
+	Enabled bool `yaml:"enabled"`
+	// Limit is a maximum number of the most recent Events to add.
+	// If not specified, DefaultEnrichmentEventsLimit is used.
+	Limit int `yaml:"limit"`
+}
+
+// IsEnabled returns true if any of the enrichment options is enabled.
+func (e *Enrichment) IsEnabled() bool {
+	return e != nil && (e.Owner || e.Node || e.Logs.Enabled || e.Events.Enabled)
+}
+
+// Selector contains Kubernetes label selector requirements.
+// Both Expression and MatchExpressions can be specified, in such case all requirements must be satisfied.
+type Selector struct {
+	// Expression is a selector in the Kubernetes format, e.g. `environment in (production, qa),tier!=frontend,!canary`.
+	Expression string `yaml:"expression"`
+
+	// MatchExpressions is a list of selector requirements.
+	MatchExpressions []SelectorRequirement `yaml:"matchExpressions"`
+}
+
+// SelectorRequirement is a selector requirement that contains a key, an operator and values.
+type SelectorRequirement struct {
+	Key string `yaml:"key"`
+	// Operator is one of `In`, `NotIn`, `Exists` and `DoesNotExist`.
+	Operator string `yaml:"operator"`
+	// Values must be non-empty for the `In` and `NotIn` operators, and empty for the `Exists` and `DoesNotExist` ones.
+	Values []string `yaml:"values"`
+}
+
+// IsDefined returns true if any requirement is specified.
+func (s *Selector) IsDefined() bool {
+	return s != nil && (s.Expression != "" || len(s.MatchExpressions) > 0)
+}
+
+// BuildSelector returns a selector which matches all exact key-value pairs and satisfies the selector requirements.
+// Exact key-value pairs are not validated, so they can be used also for annotations.
+func BuildSelector(exact map[string]string, selector *Selector) (labels.Selector, error) {
+	out := labels.SelectorFromValidatedSet(exact)
+	if !selector.IsDefined() {
+		return out, nil
+	}
+
+	if selector.Expression != "" {
+		parsed, err := labels.Parse(selector.Expression)
+		if err != nil {
+			return nil, fmt.Errorf("while parsing selector expression: %w", err)
+		}
+		reqs, _ := parsed.Requirements()
+		out = out.Add(reqs...)
+	}
+
+	for _, expr := range selector.MatchExpressions {
+		op, err := selectorOperator(expr.Operator)
+		if err != nil {
+			return nil, err
+		}
+		req, err := labels.NewRequirement(expr.Key, op, expr.Values)
+		if err != nil {
+			return nil, fmt.Errorf("while parsing match expression for key %q: %w", expr.Key, err)
+		}
+		out = out.Add(*req)
+	}
+
+	return out, nil
+}
+
+func selectorOperator(in string) (selection.Operator, error) {
+	switch in {
+	case string(metav1.LabelSelectorOpIn):
+		return selection.In, nil
+	case string(metav1.LabelSelectorOpNotIn):
+		return selection.NotIn, nil
+	case string(metav1.LabelSelectorOpExists):
+		return selection.Exists, nil
+	case string(metav1.LabelSelectorOpDoesNotExist):
+		return selection.DoesNotExist, nil
+	default:
+		return "", fmt.Errorf("%q is not a valid selector operator", in)
+	}
+}
+
+// ResourceFilter contains advanced filtering options for a given resource.
+type ResourceFilter struct {
+	// Expression is a CEL expression evaluated against the `object`, `oldObject` and `event` variables.
+	// The event is sent only if the expression evaluates to true.
+	Expression string `yaml:"expression"`
 }
 
 // UpdateSetting struct defines updateEvent fields specification
@@ -200,6 +332,38 @@ type UpdateSetting struct {
 	IncludeDiff bool     `yaml:"includeDiff"`
 }
 
+// Deduplication contains configuration for collapsing repeated events and suppressing flapping resources.
+type Deduplication struct {
+	// Enabled enables collapsing repeated events.
+	Enabled bool `yaml:"enabled"`
+
+	// Window is a time window in which repeated events with the same kind, namespace, name and reason are collapsed into a single notification.
+	// If not specified, DefaultDeduplicationWindow is used.
+	Window time.Duration `yaml:"window"`
+
+	// Flapping contains configuration for flapping resources detection.
+	Flapping FlapDetection `yaml:"flapping"`
+}
+
+// FlapDetection contains configuration for suppressing resources which change their state too often.
+type FlapDetection struct {
+	// Enabled enables flapping detection.
+	Enabled bool `yaml:"enabled"`
+
+	// Threshold is a maximum number of state changes in a given window. A state change is a transition to a different
+	// event type, reason or set of changed fields. When exceeded, the resource is considered as flapping.
+	Threshold int `yaml:"threshold"`
+
+	// Window is a time window in which state changes are counted.
+	// If not specified, DefaultFlapDetectionWindow is used.
+	Window time.Duration `yaml:"window"`
+}
+
+// IsEnabled returns true if any of the deduplication features is enabled.
+func (d *Deduplication) IsEnabled() bool {
+	return d != nil && (d.Enabled || d.Flapping.Enabled)
+}
+
 // Filters contains configuration for built-in filters.
 type Filters struct {
 	// ObjectAnnotationChecker enables support for `botkube.io/disable` resource annotation.
@@ -207,6 +371,50 @@ type Filters struct {
 
 	// NodeEventsChecker filters out Node-related events that are not important.
 	NodeEventsChecker bool `yaml:"nodeEventsChecker"`
+
+	// Custom contains user-defined filters.
+	Custom []CustomFilter `yaml:"custom"`
+}
+
+// CustomFilter contains configuration for a user-defined filter.
+type CustomFilter struct {
+	// Name is a unique filter name. It is used as the `filter` label for the dropped events metric.
+	Name string `yaml:"name"`
+
+	// Order specifies the order in which filters are run. Filters with lower order are run first.
+	// Built-in filters have order 0. Filters with the same order are run in alphabetical order.
+	Order int `yaml:"order"`
+
+	// Enabled enables the filter.
+	Enabled bool `yaml:"enabled"`
+
+	// Rules is a list of rules evaluated in order.
+	Rules []FilterRule `yaml:"rules"`
+}
+
+// FilterAction defines what to do with an event matching a given filter rule.
+type FilterAction string
+
+const (
+	// DropFilterAction drops the event.
+	DropFilterAction FilterAction = "drop"
+	// EnrichFilterAction modifies the event level and adds a message to the event.
+	EnrichFilterAction FilterAction = "enrich"
+)
+
+// FilterRule is a single rule of a custom filter.
+type FilterRule struct {
+	// Expression is a CEL expression evaluated against the `object` and `event` variables. The rule is applied only if it evaluates to true.
+	Expression string `yaml:"expression"`
+
+	// Action is either `drop` or `enrich`.
+	Action FilterAction `yaml:"action"`
+
+	// Level overrides the event level for the `enrich` action.
+	Level Level `yaml:"level"`
+
+	// Message is a Go template rendered with the event and added to the event messages for the `enrich` action.
+	Message string `yaml:"message"`
 }
 
 // MergeConfigs merges all input configuration.
@@ -240,9 +448,86 @@ func MergeConfigs(configs []*source.Config) (Config, error) {
 		return Config{}, err
 	}
 
+	if _, err := BuildSelector(nil, out.LabelSelector); err != nil {
+		return Config{}, fmt.Errorf("while building label selector: %w", err)
+	}
+	if _, err := BuildSelector(nil, out.AnnotationSelector); err != nil {
+		return Config{}, fmt.Errorf("while building annotation selector: %w", err)
+	}
+
+	if err := validateCustomFilters(out.Filters); err != nil {
+		return Config{}, fmt.Errorf("while validating custom filters: %w", err)
+	}
+
+	for _, r := range out.Resources {
+		if _, err := BuildSelector(nil, r.LabelSelector); err != nil {
+			return Config{}, fmt.Errorf("while building label selector for resource %q: %w", r.Type, err)
+		}
+		if _, err := BuildSelector(nil, r.AnnotationSelector); err != nil {
+			return Config{}, fmt.Errorf("while building annotation selector for resource %q: %w", r.Type, err)
+		}
+
+		if r.Filter == nil || r.Filter.Expression == "" {
+			continue
+		}
+		if _, err := expression.Compile(r.Filter.Expression); err != nil {
+			return Config{}, fmt.Errorf("while compiling filter expression for resource %q: %w", r.Type, err)
+		}
+	}
+
 	return out, nil
 }
 
+func validateCustomFilters(filters *Filters) error {
+	if filters == nil {
+		return nil
+	}
+
+	names := map[string]struct{}{}
+	for _, filter := range filters.Custom {
+		if filter.Name == "" {
+			return errors.New("filter name cannot be empty")
+		}
+		if _, found := names[filter.Name]; found {
+			return fmt.Errorf("filter name %q is not unique", filter.Name)
+		}
+		names[filter.Name] = struct{}{}
+
+		for idx, rule := range filter.Rules {
+			if err := rule.Validate(); err != nil {
+				return fmt.Errorf("while validating rule %d of filter %q: %w", idx, filter.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// Validate returns an error if the rule is misconfigured.
+func (r FilterRule) Validate() error {
+	if _, err := expression.Compile(r.Expression); err != nil {
+		return fmt.Errorf("while compiling expression: %w", err)
+	}
+
+	switch r.Action {
+	case DropFilterAction:
+	case EnrichFilterAction:
+		if r.Level == "" && r.Message == "" {
+			return errors.New("level or message must be specified for the enrich action")
+		}
+		switch r.Level {
+		case "", Info, Success, Error:
+		default:
+			return fmt.Errorf("unknown level %q", r.Level)
+		}
+		if _, err := template.New("message").Parse(r.Message); err != nil {
+			return fmt.Errorf("while parsing message template: %w", err)
+		}
+	default:
+		return fmt.Errorf("unknown action %q, allowed values are %q and %q", r.Action, DropFilterAction, EnrichFilterAction)
+	}
+	return nil
+}
+
 // Level type to store event levels
 type Level string
 
@@ -255,6 +540,18 @@ const (
 )
 
 const (
+	// DefaultDeduplicationWindow is a default time window for collapsing repeated events.
+	DefaultDeduplicationWindow = 10 * time.Minute
+	// DefaultFlapDetectionWindow is a default time window for counting resource state changes.
+	DefaultFlapDetectionWindow = 10 * time.Minute
+	// DefaultFlapDetectionThreshold is a default number of state changes after which the resource is considered as flapping.
+	DefaultFlapDetectionThreshold = 5
+
+	// DefaultEnrichmentLogTailLines is a default number of the last container log lines added to notifications.
+	DefaultEnrichmentLogTailLines = 10
+	// DefaultEnrichmentEventsLimit is a default number of the most recent related Events added to notifications.
+	DefaultEnrichmentEventsLimit = 5
+
 	// AllNamespaceIndicator represents a keyword for allowing all Kubernetes Namespaces.
 	AllNamespaceIndicator = ".*"
 )
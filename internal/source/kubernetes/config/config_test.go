@@ -0,0 +1,119 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+func TestBuildSelector(t *testing.T) {
+	// given
+	exact := map[string]string{"team": "payments"}
+	selector := &Selector{
+		Expression: "env in (prod, qa)",
+		MatchExpressions: []SelectorRequirement{
+			{Key: "canary", Operator: "DoesNotExist"},
+			{Key: "tier", Operator: "NotIn", Values: []string{"frontend"}},
+		},
+	}
+
+	// when
+	got, err := BuildSelector(exact, selector)
+
+	// then
+	require.NoError(t, err)
+	assert.True(t, got.Matches(labels.Set{"team": "payments", "env": "prod", "tier": "backend"}))
+	assert.False(t, got.Matches(labels.Set{"team": "payments", "env": "prod", "canary": "true"}))
+	assert.False(t, got.Matches(labels.Set{"team": "payments", "env": "prod", "tier": "frontend"}))
+	assert.False(t, got.Matches(labels.Set{"env": "prod"}))
+}
+
+func TestBuildSelectorErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		selector Selector
+		expErr   string
+	}{
+		{
+			name:     "Invalid expression",
+			selector: Selector{Expression: "env in prod"},
+			expErr:   "while parsing selector expression",
+		},
+		{
+			name: "Unknown operator",
+			selector: Selector{MatchExpressions: []SelectorRequirement{
+				{Key: "env", Operator: "Equals", Values: []string{"prod"}},
+			}},
+			expErr: `"Equals" is not a valid selector operator`,
+		},
+		{
+			name: "Missing values",
+			selector: Selector{MatchExpressions: []SelectorRequirement{
+				{Key: "env", Operator: "In"},
+			}},
+			expErr: `while parsing match expression for key "env"`,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			_, err := BuildSelector(nil, &tc.selector)
+
+			// then
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.expErr)
+		})
+	}
+}
+
+func TestValidateCustomFilters(t *testing.T) {
+	tests := []struct {
+		name   string
+		custom []CustomFilter
+		expErr string
+	}{
+		{
+			name:   "Missing name",
+			custom: []CustomFilter{{}},
+			expErr: "filter name cannot be empty",
+		},
+		{
+			name:   "Duplicated name",
+			custom: []CustomFilter{{Name: "foo"}, {Name: "foo"}},
+			expErr: `filter name "foo" is not unique`,
+		},
+		{
+			name: "Unknown action",
+			custom: []CustomFilter{{Name: "foo", Rules: []FilterRule{
+				{Expression: "true", Action: "ignore"},
+			}}},
+			expErr: `while validating rule 0 of filter "foo": unknown action "ignore", allowed values are "drop" and "enrich"`,
+		},
+		{
+			name: "Invalid expression",
+			custom: []CustomFilter{{Name: "foo", Rules: []FilterRule{
+				{Expression: "event.kind ==", Action: DropFilterAction},
+			}}},
+			expErr: `while validating rule 0 of filter "foo": while compiling expression`,
+		},
+		{
+			name: "Invalid message template",
+			custom: []CustomFilter{{Name: "foo", Rules: []FilterRule{
+				{Expression: "true", Action: EnrichFilterAction, Message: "{{ .Name "},
+			}}},
+			expErr: `while validating rule 0 of filter "foo": while parsing message template`,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			err := validateCustomFilters(&Filters{Custom: tc.custom})
+
+			// then
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.expErr)
+		})
+	}
+}
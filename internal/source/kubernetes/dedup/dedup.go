@@ -0,0 +1,231 @@
+package dedup
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+)
+
+const pruneInterval = time.Minute
+
+// SummaryFn sends the summary of repeated events suppressed in the deduplication window.
+type SummaryFn func(e event.Event)
+
+// Tracker collapses repeated events and suppresses notifications for flapping resources.
+// It is safe for concurrent use, as informer handlers are executed in separate goroutines.
+type Tracker struct {
+	mu        sync.Mutex
+	now       func() time.Time
+	afterFunc func(d time.Duration, f func()) *time.Timer
+	lastPrune time.Time
+
+	repeats map[string]*occurrence
+	flaps   map[string]*stateChanges
+}
+
+type occurrence struct {
+	count     int
+	lastSeen  time.Time
+	window    time.Duration
+	expiresAt time.Time
+
+	// last is the last suppressed event, sent as a summary once the window expires.
+	last      event.Event
+	summaryFn SummaryFn
+	timer     *time.Timer
+}
+
+type stateChanges struct {
+	// timestamps of the state transitions observed in the window.
+	timestamps []time.Time
+	lastState  string
+	lastSeen   time.Time
+	window     time.Duration
+	flapping   bool
+}
+
+// NewTracker returns a new Tracker instance.
+func NewTracker() *Tracker {
+	return &Tracker{
+		now:       time.Now,
+		afterFunc: time.AfterFunc,
+		repeats:   make(map[string]*occurrence),
+		flaps:     make(map[string]*stateChanges),
+	}
+}
+
+// Process checks whether a given event should be sent based on the deduplication settings.
+// Events repeated in a configured window are suppressed. Once the window expires, the last suppressed event is passed
+// to summaryFn with the number of occurrences and the timestamp of the last one. If the next event arrives before that,
+// it carries the summary instead.
+func (t *Tracker) Process(cfg *config.Deduplication, e *event.Event, summaryFn SummaryFn) bool {
+	if !cfg.IsEnabled() {
+		return true
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := t.now()
+	t.pruneExpired(now)
+
+	if cfg.Flapping.Enabled && !t.processFlapping(cfg.Flapping, e, now) {
+		return false
+	}
+
+	if !cfg.Enabled {
+		return true
+	}
+
+	return t.processRepeats(windowOrDefault(cfg.Window, config.DefaultDeduplicationWindow), e, now, summaryFn)
+}
+
+func (t *Tracker) processRepeats(window time.Duration, e *event.Event, now time.Time, summaryFn SummaryFn) bool {
+	key := repeatKey(e)
+	occ, found := t.repeats[key]
+	if found && now.Before(occ.expiresAt) {
+		occ.count++
+		occ.lastSeen = now
+		occ.last = *e
+		occ.summaryFn = summaryFn
+		if occ.timer == nil && summaryFn != nil {
+			occ.timer = t.afterFunc(occ.expiresAt.Sub(now), func() { t.flushSummary(key, occ) })
+		}
+		return false
+	}
+
+	if found && occ.count > 0 {
+		if occ.timer != nil {
+			occ.timer.Stop()
+		}
+		lastSeen := occ.lastSeen
+		e.Occurrences = occ.count + 1
+		e.LastSeen = &lastSeen
+	}
+
+	t.repeats[key] = &occurrence{
+		window:    window,
+		expiresAt: now.Add(window),
+	}
+	return true
+}
+
+// flushSummary sends the summary of suppressed repeats, unless it was already reported with the next event.
+func (t *Tracker) flushSummary(key string, occ *occurrence) {
+	t.mu.Lock()
+	if t.repeats[key] != occ || occ.count == 0 {
+		t.mu.Unlock()
+		return
+	}
+	delete(t.repeats, key)
+	summary := occ.last
+	lastSeen := occ.lastSeen
+	summary.Occurrences = occ.count + 1
+	summary.LastSeen = &lastSeen
+	summaryFn := occ.summaryFn
+	t.mu.Unlock()
+
+	summaryFn(summary)
+}
+
+func (t *Tracker) processFlapping(cfg config.FlapDetection, e *event.Event, now time.Time) bool {
+	window := windowOrDefault(cfg.Window, config.DefaultFlapDetectionWindow)
+	threshold := cfg.Threshold
+	if threshold <= 0 {
+		threshold = config.DefaultFlapDetectionThreshold
+	}
+
+	key := objectKey(e)
+	state := objectState(e)
+	changes, found := t.flaps[key]
+	if !found {
+		changes = &stateChanges{lastState: state}
+		t.flaps[key] = changes
+	}
+	changes.window = window
+	changes.lastSeen = now
+
+	// only transitions count, so unrelated updates of a busy object don't mark it as flapping
+	changes.timestamps = dropOlderThan(changes.timestamps, now.Add(-window))
+	if state != changes.lastState {
+		changes.timestamps = append(changes.timestamps, now)
+		changes.lastState = state
+	}
+	if len(changes.timestamps) <= threshold {
+		changes.flapping = false
+		return true
+	}
+
+	if changes.flapping {
+		return false
+	}
+
+	changes.flapping = true
+	e.Warnings = append(e.Warnings, fmt.Sprintf("Resource changed its state %d times in %s. Further notifications are suppressed until it stabilizes.", len(changes.timestamps), window))
+	return true
+}
+
+// pruneExpired removes entries which are no longer relevant, so the memory usage doesn't grow with every observed object.
+// Suppressed repeats are kept for one more window, unless their summary was already sent.
+func (t *Tracker) pruneExpired(now time.Time) {
+	if now.Sub(t.lastPrune) < pruneInterval {
+		return
+	}
+	t.lastPrune = now
+
+	for key, occ := range t.repeats {
+		retention := occ.expiresAt
+		if occ.count > 0 {
+			retention = retention.Add(occ.window)
+		}
+		if now.After(retention) {
+			delete(t.repeats, key)
+		}
+	}
+
+	for key, changes := range t.flaps {
+		if now.Sub(changes.lastSeen) > changes.window {
+			delete(t.flaps, key)
+		}
+	}
+}
+
+func dropOlderThan(in []time.Time, threshold time.Time) []time.Time {
+	idx := 0
+	for idx < len(in) && in[idx].Before(threshold) {
+		idx++
+	}
+	return in[idx:]
+}
+
+func windowOrDefault(window, defaultWindow time.Duration) time.Duration {
+	if window <= 0 {
+		return defaultWindow
+	}
+	return window
+}
+
+// repeatKey returns a key for collapsing repeated events.
+// Events for resources other than core Kubernetes Events don't have a reason, so the event type is used instead.
+func repeatKey(e *event.Event) string {
+	reason := e.Reason
+	if reason == "" {
+		reason = e.Type.String()
+	}
+	return strings.Join([]string{objectKey(e), reason}, "/")
+}
+
+// objectState returns the state of an object described by a given event.
+// Update events carry the changed fields in messages, so a resource toggling a field back and forth changes its state
+// with every update, while updates without tracked field changes, such as status heartbeats, don't.
+func objectState(e *event.Event) string {
+	return strings.Join(append([]string{e.Type.String(), e.Reason}, e.Messages...), "/")
+}
+
+func objectKey(e *event.Event) string {
+	return strings.Join([]string{e.Kind, e.Namespace, e.Name}, "/")
+}
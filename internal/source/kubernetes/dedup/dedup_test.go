@@ -0,0 +1,227 @@
+package dedup
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+)
+
+func TestTrackerProcess_CollapsesRepeatedEvents(t *testing.T) {
+	// given
+	now := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+	tracker := NewTracker()
+	tracker.now = func() time.Time { return now }
+
+	cfg := &config.Deduplication{
+		Enabled: true,
+		Window:  5 * time.Minute,
+	}
+
+	// when
+	first := fixBackOffEvent()
+	sentFirst := tracker.Process(cfg, &first, nil)
+
+	var sentRepeats []bool
+	for i := 0; i < 3; i++ {
+		now = now.Add(time.Minute)
+		repeat := fixBackOffEvent()
+		sentRepeats = append(sentRepeats, tracker.Process(cfg, &repeat, nil))
+	}
+	lastSuppressed := now
+
+	now = now.Add(5 * time.Minute)
+	afterWindow := fixBackOffEvent()
+	sentAfterWindow := tracker.Process(cfg, &afterWindow, nil)
+
+	// then
+	assert.True(t, sentFirst)
+	assert.Zero(t, first.Occurrences)
+	assert.Equal(t, []bool{false, false, false}, sentRepeats)
+
+	assert.True(t, sentAfterWindow)
+	assert.Equal(t, 4, afterWindow.Occurrences)
+	assert.Equal(t, &lastSuppressed, afterWindow.LastSeen)
+}
+
+func TestTrackerProcess_SendsSummaryWhenWindowExpires(t *testing.T) {
+	// given
+	now := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+	tracker := NewTracker()
+	tracker.now = func() time.Time { return now }
+
+	var (
+		flushAfter time.Duration
+		flush      func()
+	)
+	tracker.afterFunc = func(d time.Duration, f func()) *time.Timer {
+		flushAfter, flush = d, f
+		return time.NewTimer(time.Hour)
+	}
+
+	cfg := &config.Deduplication{
+		Enabled: true,
+		Window:  5 * time.Minute,
+	}
+
+	var summaries []event.Event
+	summaryFn := func(e event.Event) {
+		summaries = append(summaries, e)
+	}
+
+	first := fixBackOffEvent()
+	require.True(t, tracker.Process(cfg, &first, summaryFn))
+	for i := 0; i < 2; i++ {
+		now = now.Add(time.Minute)
+		repeat := fixBackOffEvent()
+		require.False(t, tracker.Process(cfg, &repeat, summaryFn))
+	}
+	lastSuppressed := now
+
+	// when
+	require.NotNil(t, flush)
+	flush()
+
+	// then
+	assert.Equal(t, 4*time.Minute, flushAfter)
+	require.Len(t, summaries, 1)
+	assert.Equal(t, 3, summaries[0].Occurrences)
+	assert.Equal(t, &lastSuppressed, summaries[0].LastSeen)
+
+	// the summary was already sent, so the next event doesn't repeat it
+	now = now.Add(5 * time.Minute)
+	afterWindow := fixBackOffEvent()
+	assert.True(t, tracker.Process(cfg, &afterWindow, summaryFn))
+	assert.Zero(t, afterWindow.Occurrences)
+}
+
+func TestTrackerProcess_DistinguishesEventsByKey(t *testing.T) {
+	// given
+	tracker := NewTracker()
+	cfg := &config.Deduplication{Enabled: true}
+
+	first := fixBackOffEvent()
+	otherReason := fixBackOffEvent()
+	otherReason.Reason = "Unhealthy"
+	otherPod := fixBackOffEvent()
+	otherPod.Name = "other"
+
+	// when
+	sent := []bool{
+		tracker.Process(cfg, &first, nil),
+		tracker.Process(cfg, &otherReason, nil),
+		tracker.Process(cfg, &otherPod, nil),
+	}
+
+	// then
+	assert.Equal(t, []bool{true, true, true}, sent)
+}
+
+func TestTrackerProcess_SuppressesFlappingResource(t *testing.T) {
+	// given
+	now := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+	tracker := NewTracker()
+	tracker.now = func() time.Time { return now }
+
+	cfg := &config.Deduplication{
+		Flapping: config.FlapDetection{
+			Enabled:   true,
+			Threshold: 2,
+			Window:    time.Minute,
+		},
+	}
+
+	// when
+	var sent []bool
+	var warnings []string
+	for i := 0; i < 6; i++ {
+		now = now.Add(10 * time.Second)
+		e := fixReadinessUpdateEvent(i%2 == 0)
+		sent = append(sent, tracker.Process(cfg, &e, nil))
+		warnings = append(warnings, e.Warnings...)
+	}
+
+	now = now.Add(2 * time.Minute)
+	stable := fixReadinessUpdateEvent(true)
+	sentStable := tracker.Process(cfg, &stable, nil)
+
+	// then
+	assert.Equal(t, []bool{true, true, true, true, false, false}, sent)
+	assert.Len(t, warnings, 1)
+	assert.True(t, sentStable)
+	assert.Empty(t, stable.Warnings)
+}
+
+func TestTrackerProcess_IgnoresUpdatesWithoutStateTransition(t *testing.T) {
+	// given
+	now := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+	tracker := NewTracker()
+	tracker.now = func() time.Time { return now }
+
+	cfg := &config.Deduplication{
+		Flapping: config.FlapDetection{
+			Enabled:   true,
+			Threshold: 2,
+			Window:    time.Minute,
+		},
+	}
+
+	// when
+	var sent []bool
+	var warnings []string
+	for i := 0; i < 5; i++ {
+		now = now.Add(10 * time.Second)
+		e := fixUpdateEvent()
+		sent = append(sent, tracker.Process(cfg, &e, nil))
+		warnings = append(warnings, e.Warnings...)
+	}
+
+	// then
+	assert.Equal(t, []bool{true, true, true, true, true}, sent)
+	assert.Empty(t, warnings)
+}
+
+func TestTrackerProcess_Disabled(t *testing.T) {
+	// given
+	tracker := NewTracker()
+
+	// when
+	var sent []bool
+	for i := 0; i < 3; i++ {
+		e := fixBackOffEvent()
+		sent = append(sent, tracker.Process(nil, &e, nil))
+	}
+
+	// then
+	assert.Equal(t, []bool{true, true, true}, sent)
+}
+
+func fixBackOffEvent() event.Event {
+	return event.Event{
+		Kind:      "Pod",
+		Name:      "nginx",
+		Namespace: "default",
+		Reason:    "BackOff",
+		Type:      config.ErrorEvent,
+	}
+}
+
+func fixUpdateEvent() event.Event {
+	return event.Event{
+		Kind:      "Pod",
+		Name:      "nginx",
+		Namespace: "default",
+		Type:      config.UpdateEvent,
+	}
+}
+
+func fixReadinessUpdateEvent(ready bool) event.Event {
+	e := fixUpdateEvent()
+	e.Messages = []string{fmt.Sprintf("status.containerStatuses[0].ready: %t", ready)}
+	return e
+}
@@ -0,0 +1,209 @@
+package enrichment
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	coreV1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/k8sutil"
+)
+
+const (
+	podKind = "Pod"
+	// maxOwnerDepth limits how many levels of owner references are followed, e.g. Pod -> ReplicaSet -> Deployment.
+	maxOwnerDepth = 2
+)
+
+// Enricher adds details about objects related to a given event object.
+type Enricher struct {
+	log    logrus.FieldLogger
+	k8sCli kubernetes.Interface
+}
+
+// NewEnricher returns a new Enricher instance.
+func NewEnricher(log logrus.FieldLogger, k8sCli kubernetes.Interface) *Enricher {
+	return &Enricher{
+		log:    log,
+		k8sCli: k8sCli,
+	}
+}
+
+// Enrich sets the event enrichment based on its settings. Failures are logged, so the event is sent even if some details cannot be fetched.
+func (e *Enricher) Enrich(ctx context.Context, evt *event.Event) {
+	cfg := evt.EnrichmentSettings
+	if !cfg.IsEnabled() {
+		return
+	}
+
+	log := e.log.WithFields(logrus.Fields{
+		"kind":      evt.Kind,
+		"name":      evt.Name,
+		"namespace": evt.Namespace,
+	})
+
+	var pod *coreV1.Pod
+	if evt.Kind == podKind && (cfg.Owner || cfg.Node || cfg.Logs.Enabled) {
+		var err error
+		pod, err = e.k8sCli.CoreV1().Pods(evt.Namespace).Get(ctx, evt.Name, metaV1.GetOptions{})
+		if err != nil {
+			log.Debugf("Cannot get Pod for enrichment: %s", err.Error())
+		}
+	}
+
+	out := &event.Enrichment{}
+	if cfg.Owner {
+		out.Owner = e.owner(ctx, evt, pod, log)
+	}
+	if cfg.Node && pod != nil {
+		out.Node = pod.Spec.NodeName
+	}
+	if cfg.Logs.Enabled && pod != nil {
+		out.Logs = e.logs(ctx, pod, cfg.Logs, log)
+	}
+	if cfg.Events.Enabled {
+		out.RelatedEvents = e.relatedEvents(ctx, evt, cfg.Events, log)
+	}
+
+	evt.Enrichment = out
+}
+
+func (e *Enricher) owner(ctx context.Context, evt *event.Event, pod *coreV1.Pod, log logrus.FieldLogger) string {
+	ownerRefs := evt.ObjectMeta.OwnerReferences
+	if pod != nil {
+		ownerRefs = pod.OwnerReferences
+	} else if k8sutil.GetObjectTypeMetaData(evt.Object).Kind == "Event" {
+		// for mapped events, the object meta doesn't contain owner references of the involved object
+		return ""
+	}
+
+	var owner *metaV1.OwnerReference
+	for depth := 0; depth < maxOwnerDepth; depth++ {
+		ref := metaV1.GetControllerOfNoCopy(&metaV1.ObjectMeta{OwnerReferences: ownerRefs})
+		if ref == nil {
+			break
+		}
+		owner = ref
+
+		next, err := e.ownerReferencesOf(ctx, evt.Namespace, *ref)
+		if err != nil {
+			log.Debugf("Cannot get owner references of %s %q: %s", ref.Kind, ref.Name, err.Error())
+			break
+		}
+		ownerRefs = next
+	}
+
+	if owner == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s/%s", owner.Kind, owner.Name)
+}
+
+// ownerReferencesOf returns owner references for the intermediate controllers, which are usually owned by another controller.
+func (e *Enricher) ownerReferencesOf(ctx context.Context, namespace string, ref metaV1.OwnerReference) ([]metaV1.OwnerReference, error) {
+	switch ref.Kind {
+	case "ReplicaSet":
+		rs, err := e.k8sCli.AppsV1().ReplicaSets(namespace).Get(ctx, ref.Name, metaV1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return rs.OwnerReferences, nil
+	case "Job":
+		job, err := e.k8sCli.BatchV1().Jobs(namespace).Get(ctx, ref.Name, metaV1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return job.OwnerReferences, nil
+	default:
+		return nil, nil
+	}
+}
+
+func (e *Enricher) logs(ctx context.Context, pod *coreV1.Pod, cfg config.LogsEnrichment, log logrus.FieldLogger) []event.ContainerLogs {
+	tailLines := cfg.TailLines
+	if tailLines <= 0 {
+		tailLines = config.DefaultEnrichmentLogTailLines
+	}
+
+	var out []event.ContainerLogs
+	for _, container := range pod.Spec.Containers {
+		raw, err := e.k8sCli.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &coreV1.PodLogOptions{
+			Container: container.Name,
+			TailLines: &tailLines,
+		}).DoRaw(ctx)
+		if err != nil {
+			log.Debugf("Cannot get logs for container %q: %s", container.Name, err.Error())
+			continue
+		}
+
+		lines := strings.Split(strings.TrimRight(string(raw), "\n"), "\n")
+		if len(lines) == 1 && lines[0] == "" {
+			continue
+		}
+		out = append(out, event.ContainerLogs{
+			Container: container.Name,
+			Lines:     lines,
+		})
+	}
+	return out
+}
+
+func (e *Enricher) relatedEvents(ctx context.Context, evt *event.Event, cfg config.EventsEnrichment, log logrus.FieldLogger) []event.RelatedEvent {
+	limit := cfg.Limit
+	if limit <= 0 {
+		limit = config.DefaultEnrichmentEventsLimit
+	}
+
+	list, err := e.k8sCli.CoreV1().Events(evt.Namespace).List(ctx, metaV1.ListOptions{
+		FieldSelector: fields.Set{
+			"involvedObject.kind": evt.Kind,
+			"involvedObject.name": evt.Name,
+		}.String(),
+	})
+	if err != nil {
+		log.Debugf("Cannot list related Events: %s", err.Error())
+		return nil
+	}
+
+	var out []event.RelatedEvent
+	for _, item := range list.Items {
+		if item.InvolvedObject.Kind != evt.Kind || item.InvolvedObject.Name != evt.Name {
+			continue
+		}
+		out = append(out, event.RelatedEvent{
+			Type:     item.Type,
+			Reason:   item.Reason,
+			Message:  item.Message,
+			Count:    item.Count,
+			LastSeen: lastSeen(item),
+		})
+	}
+
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].LastSeen.After(out[j].LastSeen)
+	})
+	if len(out) > limit {
+		out = out[:limit]
+	}
+	return out
+}
+
+func lastSeen(in coreV1.Event) time.Time {
+	switch {
+	case !in.LastTimestamp.IsZero():
+		return in.LastTimestamp.Time
+	case !in.EventTime.IsZero():
+		return in.EventTime.Time
+	default:
+		return in.CreationTimestamp.Time
+	}
+}
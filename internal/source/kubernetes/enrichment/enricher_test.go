@@ -0,0 +1,106 @@
+package enrichment
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	appsV1 "k8s.io/api/apps/v1"
+	coreV1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/internal/ptr"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+)
+
+func TestEnricherEnrich(t *testing.T) {
+	// given
+	now := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+	k8sCli := fake.NewSimpleClientset(
+		&appsV1.ReplicaSet{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:            "api-5d4f",
+				Namespace:       "payments",
+				OwnerReferences: []metaV1.OwnerReference{fixControllerRef("Deployment", "api")},
+			},
+		},
+		&coreV1.Pod{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:            "api-5d4f-x2p",
+				Namespace:       "payments",
+				OwnerReferences: []metaV1.OwnerReference{fixControllerRef("ReplicaSet", "api-5d4f")},
+			},
+			Spec: coreV1.PodSpec{
+				NodeName:   "node-1",
+				Containers: []coreV1.Container{{Name: "api"}},
+			},
+		},
+		fixRelatedEvent("older", "Scheduled", now.Add(-time.Hour)),
+		fixRelatedEvent("newer", "BackOff", now),
+		&coreV1.Event{
+			ObjectMeta:     metaV1.ObjectMeta{Name: "other", Namespace: "payments"},
+			InvolvedObject: coreV1.ObjectReference{Kind: "Pod", Name: "other"},
+		},
+	)
+
+	evt := event.Event{
+		Kind:      "Pod",
+		Name:      "api-5d4f-x2p",
+		Namespace: "payments",
+		EnrichmentSettings: &config.Enrichment{
+			Owner:  true,
+			Node:   true,
+			Logs:   config.LogsEnrichment{Enabled: true},
+			Events: config.EventsEnrichment{Enabled: true, Limit: 1},
+		},
+	}
+
+	enricher := NewEnricher(loggerx.NewNoop(), k8sCli)
+
+	// when
+	enricher.Enrich(context.Background(), &evt)
+
+	// then
+	assert.Equal(t, &event.Enrichment{
+		Owner: "Deployment/api",
+		Node:  "node-1",
+		Logs: []event.ContainerLogs{
+			{Container: "api", Lines: []string{"fake logs"}},
+		},
+		RelatedEvents: []event.RelatedEvent{
+			{Type: "Warning", Reason: "BackOff", Message: "newer", Count: 1, LastSeen: now},
+		},
+	}, evt.Enrichment)
+}
+
+func TestEnricherEnrich_Disabled(t *testing.T) {
+	// given
+	evt := event.Event{Kind: "Pod", Name: "api", Namespace: "payments"}
+	enricher := NewEnricher(loggerx.NewNoop(), fake.NewSimpleClientset())
+
+	// when
+	enricher.Enrich(context.Background(), &evt)
+
+	// then
+	assert.Nil(t, evt.Enrichment)
+}
+
+func fixControllerRef(kind, name string) metaV1.OwnerReference {
+	return metaV1.OwnerReference{Kind: kind, Name: name, Controller: ptr.FromType(true)}
+}
+
+func fixRelatedEvent(name, reason string, lastSeen time.Time) *coreV1.Event {
+	return &coreV1.Event{
+		ObjectMeta:     metaV1.ObjectMeta{Name: name, Namespace: "payments"},
+		InvolvedObject: coreV1.ObjectReference{Kind: "Pod", Name: "api-5d4f-x2p"},
+		Type:           "Warning",
+		Reason:         reason,
+		Message:        name,
+		Count:          1,
+		LastTimestamp:  metaV1.NewTime(lastSeen),
+	}
+}
@@ -33,11 +33,46 @@ type Event struct {
 	Recommendations []string
 	Warnings        []string
 
+	// Occurrences and LastSeen are set when repeated events were collapsed into this one.
+	Occurrences int        `json:",omitempty"`
+	LastSeen    *time.Time `json:",omitempty"`
+
+	// Enrichment contains details about related objects. It is set only if enrichment is enabled for a given resource.
+	Enrichment *Enrichment `json:",omitempty"`
+
 	// The following fields are ignored when marshalling the event by purpose.
 	// We send the whole Event struct via sink.Elasticsearch integration.
 	// When using ELS dynamic mapping, we should avoid complex, dynamic objects, which could result into type conflicts.
 	ObjectMeta metaV1.ObjectMeta `json:"-"`
 	Object     interface{}       `json:"-"`
+	// Labels are passed to the routing rules with source.Event.Labels.
+	Labels map[string]string `json:"-"`
+
+	// EnrichmentSettings are copied from the resource configuration which the event was matched with.
+	EnrichmentSettings *config.Enrichment `json:"-"`
+}
+
+// Enrichment holds details about objects related to the event object.
+type Enrichment struct {
+	Owner         string          `json:",omitempty"`
+	Node          string          `json:",omitempty"`
+	Logs          []ContainerLogs `json:",omitempty"`
+	RelatedEvents []RelatedEvent  `json:",omitempty"`
+}
+
+// ContainerLogs holds the last log lines of a given container.
+type ContainerLogs struct {
+	Container string
+	Lines     []string
+}
+
+// RelatedEvent holds details about a Kubernetes Event related to the event object.
+type RelatedEvent struct {
+	Type     string
+	Reason   string
+	Message  string
+	Count    int32
+	LastSeen time.Time
 }
 
 // Action describes an automated action for a given event.
@@ -53,6 +88,24 @@ func (e *Event) HasRecommendationsOrWarnings() bool {
 	return len(e.Recommendations) > 0 || len(e.Warnings) > 0
 }
 
+// ExpressionVars returns event details exposed to filter expressions.
+func (e *Event) ExpressionVars() map[string]any {
+	return map[string]any{
+		"type":        e.Type.String(),
+		"level":       string(e.Level),
+		"reason":      e.Reason,
+		"messages":    e.Messages,
+		"kind":        e.Kind,
+		"apiVersion":  e.APIVersion,
+		"resource":    e.Resource,
+		"name":        e.Name,
+		"namespace":   e.Namespace,
+		"count":       int64(e.Count),
+		"labels":      e.ObjectMeta.Labels,
+		"annotations": e.ObjectMeta.Annotations,
+	}
+}
+
 // LevelMap is a map of event type to Level
 var LevelMap = map[config.EventType]config.Level{
 	config.NormalEvent:  config.Info,
@@ -75,6 +128,7 @@ func New(objectMeta metaV1.ObjectMeta, object interface{}, eventType config.Even
 		Object:     object,
 		Name:       objectMeta.Name,
 		Namespace:  objectMeta.Namespace,
+		Labels:     objectMeta.Labels,
 		Level:      LevelMap[eventType],
 		Type:       eventType,
 		Resource:   resource,
@@ -0,0 +1,87 @@
+package expression
+
+import (
+	"fmt"
+
+	"github.com/google/cel-go/cel"
+)
+
+const (
+	// ObjectVar is the name of the variable which holds the observed Kubernetes object.
+	ObjectVar = "object"
+	// OldObjectVar is the name of the variable which holds the previous version of the observed Kubernetes object.
+	// It is set only for update events, otherwise it's an empty map.
+	OldObjectVar = "oldObject"
+	// EventVar is the name of the variable which holds the Botkube event details.
+	EventVar = "event"
+)
+
+// Input holds the data that a filter expression is evaluated against.
+type Input struct {
+	Object    map[string]any
+	OldObject map[string]any
+	Event     map[string]any
+}
+
+// Program is a compiled CEL filter expression.
+type Program struct {
+	expr string
+	prg  cel.Program
+}
+
+// Compile parses and checks a given CEL expression. The expression must evaluate to a boolean value.
+func Compile(expr string) (*Program, error) {
+	env, err := cel.NewEnv(
+		cel.Variable(ObjectVar, cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable(OldObjectVar, cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable(EventVar, cel.MapType(cel.StringType, cel.DynType)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("while creating CEL environment: %w", err)
+	}
+
+	ast, iss := env.Compile(expr)
+	if iss.Err() != nil {
+		return nil, iss.Err()
+	}
+	if ast.OutputType() != cel.BoolType && ast.OutputType() != cel.DynType {
+		return nil, fmt.Errorf("expression must evaluate to bool, got %s", ast.OutputType())
+	}
+
+	prg, err := env.Program(ast)
+	if err != nil {
+		return nil, fmt.Errorf("while creating CEL program: %w", err)
+	}
+
+	return &Program{expr: expr, prg: prg}, nil
+}
+
+// Evaluate returns the result of the expression for a given input.
+func (p *Program) Evaluate(in Input) (bool, error) {
+	out, _, err := p.prg.Eval(map[string]any{
+		ObjectVar:    emptyIfNil(in.Object),
+		OldObjectVar: emptyIfNil(in.OldObject),
+		EventVar:     emptyIfNil(in.Event),
+	})
+	if err != nil {
+		return false, fmt.Errorf("while evaluating expression %q: %w", p.expr, err)
+	}
+
+	result, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("expression %q evaluated to %T instead of bool", p.expr, out.Value())
+	}
+	return result, nil
+}
+
+// String returns the source expression.
+func (p *Program) String() string {
+	return p.expr
+}
+
+func emptyIfNil(in map[string]any) map[string]any {
+	if in == nil {
+		return map[string]any{}
+	}
+	return in
+}
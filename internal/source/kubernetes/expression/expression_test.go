@@ -0,0 +1,117 @@
+package expression
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProgramEvaluate(t *testing.T) {
+	// given
+	in := Input{
+		Object: map[string]any{
+			"spec": map[string]any{
+				"replicas": int64(3),
+			},
+			"status": map[string]any{
+				"availableReplicas": int64(1),
+			},
+		},
+		OldObject: map[string]any{
+			"status": map[string]any{
+				"availableReplicas": int64(3),
+			},
+		},
+		Event: map[string]any{
+			"type":      "update",
+			"namespace": "payments",
+		},
+	}
+
+	tests := []struct {
+		name      string
+		expr      string
+		expResult bool
+	}{
+		{
+			name:      "Object fields",
+			expr:      "object.status.availableReplicas < object.spec.replicas",
+			expResult: true,
+		},
+		{
+			name:      "Old object fields",
+			expr:      "oldObject.status.availableReplicas > object.status.availableReplicas",
+			expResult: true,
+		},
+		{
+			name:      "Event fields",
+			expr:      `event.type == "update" && event.namespace.startsWith("kube-")`,
+			expResult: false,
+		},
+		{
+			name:      "Missing field check",
+			expr:      `has(object.metadata) && object.metadata.name == "foo"`,
+			expResult: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			prg, err := Compile(tc.expr)
+			require.NoError(t, err)
+
+			got, err := prg.Evaluate(in)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.expResult, got)
+		})
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		expr   string
+		expErr string
+	}{
+		{
+			name:   "Syntax error",
+			expr:   "object.spec.replicas >",
+			expErr: "Syntax error",
+		},
+		{
+			name:   "Unknown variable",
+			expr:   "pod.spec.replicas > 1",
+			expErr: "undeclared reference to 'pod'",
+		},
+		{
+			name:   "Non-bool result",
+			expr:   `"foo"`,
+			expErr: "expression must evaluate to bool, got string",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			_, err := Compile(tc.expr)
+
+			// then
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.expErr)
+		})
+	}
+}
+
+func TestProgramEvaluate_MissingField(t *testing.T) {
+	// given
+	prg, err := Compile("object.status.phase == 'Failed'")
+	require.NoError(t, err)
+
+	// when
+	_, err = prg.Evaluate(Input{})
+
+	// then
+	assert.ErrorContains(t, err, "no such key: status")
+}
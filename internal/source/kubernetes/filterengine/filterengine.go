@@ -3,13 +3,21 @@ package filterengine
 import (
 	"context"
 	"fmt"
+	"sort"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/sirupsen/logrus"
 
 	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
 	"github.com/kubeshop/botkube/pkg/maputil"
 )
 
+var droppedEventsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "botkube_kubernetes_filter_dropped_events_total",
+	Help: "Total number of Kubernetes events dropped by a given filter.",
+}, []string{"filter"})
+
 // DefaultFilterEngine is a default implementation of the Filter Engine.
 type DefaultFilterEngine struct {
 	log logrus.FieldLogger
@@ -28,6 +36,8 @@ type FilterEngine interface {
 // RegisteredFilter contains details about registered filter.
 type RegisteredFilter struct {
 	Enabled bool
+	// Order specifies the order in which filters are run. Filters with the same order are run in alphabetical order.
+	Order int
 	Filter
 }
 
@@ -46,7 +56,7 @@ func New(log logrus.FieldLogger) *DefaultFilterEngine {
 	}
 }
 
-// Run runs the registered filters always iterating over a slice of filters with sorted keys.
+// Run runs the registered filters always iterating over a slice of filters sorted by order and name.
 func (f *DefaultFilterEngine) Run(ctx context.Context, event event.Event) event.Event {
 	f.log.Debug("Running registered filters")
 	filters := f.RegisteredFilters()
@@ -57,11 +67,15 @@ func (f *DefaultFilterEngine) Run(ctx context.Context, event event.Event) event.
 			continue
 		}
 
+		skippedBefore := event.Skip
 		err := filter.Run(ctx, &event)
 		if err != nil {
 			f.log.Errorf("while running filter %q: %w", filter.Name(), err)
 		}
 		f.log.Debugf("ran filter name: %q, event was skipped: %t", filter.Name(), event.Skip)
+		if !skippedBefore && event.Skip {
+			droppedEventsTotal.WithLabelValues(filter.Name()).Inc()
+		}
 	}
 	return event
 }
@@ -74,13 +88,16 @@ func (f *DefaultFilterEngine) Register(filters ...RegisteredFilter) {
 	}
 }
 
-// RegisteredFilters returns sorted slice of registered filters.
+// RegisteredFilters returns slice of registered filters sorted by order and name.
 func (f *DefaultFilterEngine) RegisteredFilters() []RegisteredFilter {
 	var registeredFilters []RegisteredFilter
 	for _, key := range maputil.SortKeys(f.filters) {
 		registeredFilters = append(registeredFilters, f.filters[key])
 	}
 
+	sort.SliceStable(registeredFilters, func(i, j int) bool {
+		return registeredFilters[i].Order < registeredFilters[j].Order
+	})
 	return registeredFilters
 }
 
@@ -0,0 +1,56 @@
+package filterengine
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+)
+
+func TestDefaultFilterEngineRun(t *testing.T) {
+	// given
+	var executed []string
+	engine := New(loggerx.NewNoop())
+	engine.Register(
+		RegisteredFilter{Enabled: true, Order: 10, Filter: &fakeFilter{name: "a-drop", skip: true, executed: &executed}},
+		RegisteredFilter{Enabled: true, Filter: &fakeFilter{name: "z-builtin", executed: &executed}},
+		RegisteredFilter{Enabled: false, Order: -1, Filter: &fakeFilter{name: "disabled", skip: true, executed: &executed}},
+		RegisteredFilter{Enabled: true, Order: 20, Filter: &fakeFilter{name: "b-drop", skip: true, executed: &executed}},
+	)
+	droppedBefore := testutil.ToFloat64(droppedEventsTotal.WithLabelValues("a-drop"))
+
+	// when
+	out := engine.Run(context.Background(), event.Event{})
+
+	// then
+	assert.True(t, out.Skip)
+	assert.Equal(t, []string{"z-builtin", "a-drop", "b-drop"}, executed)
+	assert.Equal(t, droppedBefore+1, testutil.ToFloat64(droppedEventsTotal.WithLabelValues("a-drop")))
+	assert.Zero(t, testutil.ToFloat64(droppedEventsTotal.WithLabelValues("b-drop")))
+}
+
+type fakeFilter struct {
+	name     string
+	skip     bool
+	executed *[]string
+}
+
+func (f *fakeFilter) Run(_ context.Context, e *event.Event) error {
+	*f.executed = append(*f.executed, f.name)
+	if f.skip {
+		e.Skip = true
+	}
+	return nil
+}
+
+func (f *fakeFilter) Name() string {
+	return f.name
+}
+
+func (f *fakeFilter) Describe() string {
+	return "Fake filter."
+}
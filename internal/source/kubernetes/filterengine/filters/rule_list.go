@@ -0,0 +1,121 @@
+package filters
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"text/template"
+
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/expression"
+)
+
+// RuleList is a user-defined filter which drops or enriches events matching the configured CEL rules.
+type RuleList struct {
+	log   logrus.FieldLogger
+	name  string
+	rules []compiledRule
+}
+
+type compiledRule struct {
+	program *expression.Program
+	action  config.FilterAction
+	level   config.Level
+	message *template.Template
+}
+
+// NewRuleList creates a new RuleList instance.
+func NewRuleList(log logrus.FieldLogger, cfg config.CustomFilter) (*RuleList, error) {
+	var rules []compiledRule
+	for idx, rule := range cfg.Rules {
+		prg, err := expression.Compile(rule.Expression)
+		if err != nil {
+			return nil, fmt.Errorf("while compiling expression for rule %d: %w", idx, err)
+		}
+
+		var msgTpl *template.Template
+		if rule.Message != "" {
+			msgTpl, err = template.New(fmt.Sprintf("%s-%d", cfg.Name, idx)).Parse(rule.Message)
+			if err != nil {
+				return nil, fmt.Errorf("while parsing message template for rule %d: %w", idx, err)
+			}
+		}
+
+		rules = append(rules, compiledRule{
+			program: prg,
+			action:  rule.Action,
+			level:   rule.Level,
+			message: msgTpl,
+		})
+	}
+
+	return &RuleList{
+		log:   log,
+		name:  cfg.Name,
+		rules: rules,
+	}, nil
+}
+
+// Run evaluates rules in order. The first matching rule with the drop action marks the event as skipped.
+func (f *RuleList) Run(_ context.Context, event *event.Event) error {
+	in := expression.Input{
+		Event: event.ExpressionVars(),
+	}
+	if obj, ok := event.Object.(*unstructured.Unstructured); ok && obj != nil {
+		in.Object = obj.Object
+	}
+
+	for idx, rule := range f.rules {
+		match, err := rule.program.Evaluate(in)
+		if err != nil {
+			return fmt.Errorf("while evaluating rule %d: %w", idx, err)
+		}
+		if !match {
+			continue
+		}
+
+		switch rule.action {
+		case config.DropFilterAction:
+			f.log.Debugf("Dropping event as it matches rule %d", idx)
+			event.Skip = true
+			return nil
+		case config.EnrichFilterAction:
+			if err := f.enrich(event, rule); err != nil {
+				return fmt.Errorf("while enriching event with rule %d: %w", idx, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Name returns the filter's name
+func (f *RuleList) Name() string {
+	return f.name
+}
+
+// Describe describes the filter
+func (f *RuleList) Describe() string {
+	return "Drops or enriches events matching user-defined rules."
+}
+
+func (f *RuleList) enrich(event *event.Event, rule compiledRule) error {
+	if rule.level != "" {
+		event.Level = rule.level
+	}
+
+	if rule.message == nil {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	if err := rule.message.Execute(&buf, event); err != nil {
+		return fmt.Errorf("while rendering message: %w", err)
+	}
+	event.Messages = append(event.Messages, buf.String())
+	return nil
+}
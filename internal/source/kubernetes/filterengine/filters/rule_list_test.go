@@ -0,0 +1,76 @@
+package filters
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+)
+
+func TestRuleListRun(t *testing.T) {
+	// given
+	filter, err := NewRuleList(loggerx.NewNoop(), config.CustomFilter{
+		Name: "payments",
+		Rules: []config.FilterRule{
+			{
+				Expression: `event.namespace == "kube-system"`,
+				Action:     config.DropFilterAction,
+			},
+			{
+				Expression: `event.kind == "Pod" && object.status.phase == "Failed"`,
+				Action:     config.EnrichFilterAction,
+				Level:      config.Error,
+				Message:    "Pod {{ .Name }} failed, see the payments runbook.",
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		givenEvent  event.Event
+		expSkip     bool
+		expLevel    config.Level
+		expMessages []string
+	}{
+		{
+			name:       "Drop",
+			givenEvent: event.Event{Kind: "Pod", Namespace: "kube-system", Level: config.Info},
+			expSkip:    true,
+			expLevel:   config.Info,
+		},
+		{
+			name: "Enrich",
+			givenEvent: event.Event{Kind: "Pod", Name: "api", Namespace: "payments", Level: config.Info, Object: &unstructured.Unstructured{
+				Object: map[string]any{"status": map[string]any{"phase": "Failed"}},
+			}},
+			expLevel:    config.Error,
+			expMessages: []string{"Pod api failed, see the payments runbook."},
+		},
+		{
+			name:       "No match",
+			givenEvent: event.Event{Kind: "Service", Namespace: "payments", Level: config.Info},
+			expLevel:   config.Info,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			evt := tc.givenEvent
+
+			// when
+			err := filter.Run(context.Background(), &evt)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.expSkip, evt.Skip)
+			assert.Equal(t, tc.expLevel, evt.Level)
+			assert.Equal(t, tc.expMessages, evt.Messages)
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package filterengine
 
 import (
+	"fmt"
+
 	"github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/client-go/dynamic"
@@ -14,8 +16,8 @@ const (
 	componentLogFieldKey = "component"
 )
 
-// WithAllFilters returns new DefaultFilterEngine instance with all filters registered.
-func WithAllFilters(logger logrus.FieldLogger, dynamicCli dynamic.Interface, mapper meta.RESTMapper, cfg *config.Filters) *DefaultFilterEngine {
+// WithAllFilters returns new DefaultFilterEngine instance with all built-in and custom filters registered.
+func WithAllFilters(logger logrus.FieldLogger, dynamicCli dynamic.Interface, mapper meta.RESTMapper, cfg *config.Filters) (*DefaultFilterEngine, error) {
 	filterEngine := New(logger.WithField(componentLogFieldKey, "Filter Engine"))
 	filterEngine.Register([]RegisteredFilter{
 		{
@@ -28,5 +30,20 @@ func WithAllFilters(logger logrus.FieldLogger, dynamicCli dynamic.Interface, map
 		},
 	}...)
 
-	return filterEngine
+	for _, custom := range cfg.Custom {
+		if _, found := filterEngine.filters[custom.Name]; found {
+			return nil, fmt.Errorf("filter %q is already registered", custom.Name)
+		}
+		filter, err := filters.NewRuleList(logger.WithField(filterLogFieldKey, custom.Name), custom)
+		if err != nil {
+			return nil, fmt.Errorf("while creating custom filter %q: %w", custom.Name, err)
+		}
+		filterEngine.Register(RegisteredFilter{
+			Filter:  filter,
+			Enabled: custom.Enabled,
+			Order:   custom.Order,
+		})
+	}
+
+	return filterEngine, nil
 }
@@ -0,0 +1,46 @@
+package kubernetes
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+)
+
+// informerFactories holds dynamic shared informer factories per label selector,
+// so the informers list and watch only the objects that can be routed.
+type informerFactories struct {
+	dynamicCli   dynamic.Interface
+	resyncPeriod time.Duration
+	factories    map[string]dynamicinformer.DynamicSharedInformerFactory
+}
+
+func newInformerFactories(dynamicCli dynamic.Interface, resyncPeriod time.Duration) *informerFactories {
+	return &informerFactories{
+		dynamicCli:   dynamicCli,
+		resyncPeriod: resyncPeriod,
+		factories:    make(map[string]dynamicinformer.DynamicSharedInformerFactory),
+	}
+}
+
+// ForLabelSelector returns a factory for informers watching objects matching a given label selector.
+// Empty selector means that all objects are watched.
+func (f *informerFactories) ForLabelSelector(labelSelector string) dynamicinformer.DynamicSharedInformerFactory {
+	if factory, ok := f.factories[labelSelector]; ok {
+		return factory
+	}
+
+	factory := dynamicinformer.NewFilteredDynamicSharedInformerFactory(f.dynamicCli, f.resyncPeriod, metav1.NamespaceAll, func(opts *metav1.ListOptions) {
+		opts.LabelSelector = labelSelector
+	})
+	f.factories[labelSelector] = factory
+	return factory
+}
+
+// Start starts all informers requested so far.
+func (f *informerFactories) Start(stopCh <-chan struct{}) {
+	for _, factory := range f.factories {
+		factory.Start(stopCh)
+	}
+}
@@ -0,0 +1,37 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/sirupsen/logrus"
+
+	"github.com/kubeshop/botkube/internal/httpx"
+)
+
+// metricsServer serves metrics for the whole plugin process, as Stream is called separately for every set of source bindings.
+type metricsServer struct {
+	once sync.Once
+}
+
+// StartIfShould starts the metrics server once. It runs until the plugin process exits, so it's not stopped
+// when the context of a given Stream call is canceled, e.g. after the configuration reload.
+func (m *metricsServer) StartIfShould(log logrus.FieldLogger, port string) {
+	if port == "" {
+		return
+	}
+
+	m.once.Do(func() {
+		router := mux.NewRouter()
+		router.Handle("/metrics", promhttp.Handler())
+		srv := httpx.NewServer(log.WithField(componentLogFieldKey, "Metrics server"), fmt.Sprintf(":%s", port), router)
+		go func() {
+			if err := srv.Serve(context.Background()); err != nil {
+				log.WithError(err).Error("Metrics server failed")
+			}
+		}()
+	})
+}
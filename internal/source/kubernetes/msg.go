@@ -3,7 +3,9 @@ package kubernetes
 import (
 	"bytes"
 	"fmt"
+	"strings"
 	"text/template"
+	"time"
 
 	sprig "github.com/go-task/slim-sprig"
 	"github.com/sirupsen/logrus"
@@ -48,9 +50,12 @@ func (m *MessageBuilder) FromEvent(event event.Event, actions []config.ExtraButt
 
 	if !m.isInteractivitySupported {
 		msg.Type = api.NonInteractiveSingleSection
+		m.mergeEnrichment(&msg.Sections[0], event.Enrichment)
 		return msg, nil
 	}
 
+	msg.Sections = append(msg.Sections, m.enrichmentSections(event.Enrichment)...)
+
 	cmdSection, err := m.getCommandSelectIfShould(event)
 	if err != nil {
 		return api.Message{}, err
@@ -145,6 +150,7 @@ func (m *MessageBuilder) baseNotificationSection(event event.Event) api.Section
 	section.TextFields = m.appendTextFieldIfNotEmpty(section.TextFields, "Reason", event.Reason)
 	section.TextFields = m.appendTextFieldIfNotEmpty(section.TextFields, "Action", event.Action)
 	section.TextFields = m.appendTextFieldIfNotEmpty(section.TextFields, "Cluster", event.Cluster)
+	section.TextFields = m.appendTextFieldIfNotEmpty(section.TextFields, "Occurrences", occurrencesText(event))
 
 	// Messages, Recommendations and Warnings formatted as bullet point lists.
 	section.BulletLists = m.appendBulletListIfNotEmpty(section.BulletLists, "Messages", event.Messages)
@@ -154,6 +160,73 @@ func (m *MessageBuilder) baseNotificationSection(event event.Event) api.Section
 	return section
 }
 
+// enrichmentSections returns sections with details about related objects, such as owner, node, recent Events and container logs.
+func (m *MessageBuilder) enrichmentSections(enrichment *event.Enrichment) []api.Section {
+	if enrichment == nil {
+		return nil
+	}
+
+	var out []api.Section
+	if related, ok := m.relatedObjectsSection(enrichment); ok {
+		out = append(out, related)
+	}
+	for _, logs := range enrichment.Logs {
+		out = append(out, api.Section{
+			Base: api.Base{
+				Header: containerLogsTitle(logs),
+				Body: api.Body{
+					CodeBlock: strings.Join(logs.Lines, "\n"),
+				},
+			},
+		})
+	}
+	return out
+}
+
+// mergeEnrichment adds details about related objects to a given section. It's used for platforms which render a single section only.
+func (m *MessageBuilder) mergeEnrichment(section *api.Section, enrichment *event.Enrichment) {
+	if enrichment == nil {
+		return
+	}
+
+	if related, ok := m.relatedObjectsSection(enrichment); ok {
+		section.TextFields = append(section.TextFields, related.TextFields...)
+		section.BulletLists = append(section.BulletLists, related.BulletLists...)
+	}
+	for _, logs := range enrichment.Logs {
+		section.BulletLists = m.appendBulletListIfNotEmpty(section.BulletLists, containerLogsTitle(logs), logs.Lines)
+	}
+}
+
+func (m *MessageBuilder) relatedObjectsSection(enrichment *event.Enrichment) (api.Section, bool) {
+	section := api.Section{
+		Base: api.Base{
+			Header: "Related objects",
+		},
+	}
+	section.TextFields = m.appendTextFieldIfNotEmpty(section.TextFields, "Owner", enrichment.Owner)
+	section.TextFields = m.appendTextFieldIfNotEmpty(section.TextFields, "Node", enrichment.Node)
+
+	var relatedEvents []string
+	for _, item := range enrichment.RelatedEvents {
+		relatedEvents = append(relatedEvents, fmt.Sprintf("%s %s (x%d, last seen %s): %s", item.Type, item.Reason, item.Count, item.LastSeen.Format(time.RFC1123), item.Message))
+	}
+	section.BulletLists = m.appendBulletListIfNotEmpty(section.BulletLists, "Recent events", relatedEvents)
+
+	return section, len(section.TextFields) > 0 || len(section.BulletLists) > 0
+}
+
+func containerLogsTitle(logs event.ContainerLogs) string {
+	return fmt.Sprintf("Logs of container %q", logs.Container)
+}
+
+func occurrencesText(event event.Event) string {
+	if event.Occurrences <= 1 || event.LastSeen == nil {
+		return ""
+	}
+	return fmt.Sprintf("%d (last seen %s)", event.Occurrences, event.LastSeen.Format(time.RFC1123))
+}
+
 func (m *MessageBuilder) appendTextFieldIfNotEmpty(fields api.TextFields, title, value string) []api.TextField {
 	if value == "" {
 		return fields
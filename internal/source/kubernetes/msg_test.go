@@ -0,0 +1,82 @@
+package kubernetes
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/commander"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+	"github.com/kubeshop/botkube/pkg/api"
+)
+
+func TestMessageBuilderFromEvent_Enrichment(t *testing.T) {
+	// given
+	evt := event.Event{
+		Kind:      "Pod",
+		Name:      "api",
+		Namespace: "payments",
+		Title:     "v1/pods error",
+		Level:     config.Error,
+		Enrichment: &event.Enrichment{
+			Owner: "Deployment/api",
+			Node:  "node-1",
+			Logs: []event.ContainerLogs{
+				{Container: "api", Lines: []string{"starting", "panic: boom"}},
+			},
+			RelatedEvents: []event.RelatedEvent{
+				{Type: "Warning", Reason: "BackOff", Message: "Back-off restarting failed container", Count: 3, LastSeen: time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)},
+			},
+		},
+	}
+	expRelatedFields := api.TextFields{
+		{Key: "Owner", Value: "Deployment/api"},
+		{Key: "Node", Value: "node-1"},
+	}
+	expRecentEvents := api.BulletList{
+		Title: "Recent events",
+		Items: []string{"Warning BackOff (x3, last seen Sun, 01 Jan 2023 10:00:00 UTC): Back-off restarting failed container"},
+	}
+
+	t.Run("Interactive", func(t *testing.T) {
+		builder := NewMessageBuilder(true, loggerx.NewNoop(), &fakeCommandsGetter{})
+
+		// when
+		msg, err := builder.FromEvent(evt, nil)
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, msg.Sections, 3)
+		assert.Equal(t, "Related objects", msg.Sections[1].Header)
+		assert.Equal(t, expRelatedFields, msg.Sections[1].TextFields)
+		assert.Equal(t, api.BulletLists{expRecentEvents}, msg.Sections[1].BulletLists)
+		assert.Equal(t, `Logs of container "api"`, msg.Sections[2].Header)
+		assert.Equal(t, "starting\npanic: boom", msg.Sections[2].Body.CodeBlock)
+	})
+
+	t.Run("Non-interactive", func(t *testing.T) {
+		builder := NewMessageBuilder(false, loggerx.NewNoop(), &fakeCommandsGetter{})
+
+		// when
+		msg, err := builder.FromEvent(evt, nil)
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, msg.Sections, 1)
+		assert.Subset(t, msg.Sections[0].TextFields, expRelatedFields)
+		assert.Equal(t, api.BulletLists{
+			expRecentEvents,
+			{Title: `Logs of container "api"`, Items: []string{"starting", "panic: boom"}},
+		}, msg.Sections[0].BulletLists)
+	})
+}
+
+type fakeCommandsGetter struct{}
+
+func (*fakeCommandsGetter) GetCommandsForEvent(event.Event) ([]commander.Command, error) {
+	return nil, nil
+}
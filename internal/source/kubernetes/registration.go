@@ -2,6 +2,7 @@ package kubernetes
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"reflect"
 	"strings"
@@ -10,12 +11,15 @@ import (
 	coreV1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/tools/cache"
 
 	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/dedup"
 	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/expression"
 	"github.com/kubeshop/botkube/internal/source/kubernetes/k8sutil"
 	"github.com/kubeshop/botkube/pkg/multierror"
 )
@@ -28,9 +32,19 @@ type registration struct {
 	events          []config.EventType
 	mappedResources []string
 	mappedEvent     config.EventType
+	deduplicator    *dedup.Tracker
+
+	// labelSelector is set when the informer watches only objects matching the selector.
+	labelSelector string
 }
 
 func (r registration) handleEvent(ctx context.Context, s Source, resource string, eventType config.EventType, routes []route, fn eventHandler) {
+	selector, err := labels.Parse(r.labelSelector)
+	if err != nil {
+		r.log.Errorf("while parsing label selector %q: %s", r.labelSelector, err.Error())
+		selector = nil
+	}
+
 	handleFunc := func(oldObj, newObj interface{}) {
 		logger := r.log.WithFields(logrus.Fields{
 			"eventHandler": eventType,
@@ -38,13 +52,24 @@ func (r registration) handleEvent(ctx context.Context, s Source, resource string
 			"object":       newObj,
 		})
 
+		if eventType == config.DeleteEvent && r.labelSelector != "" && stoppedMatching(selector, newObj) {
+			// informer reports objects which stop matching the label selector as deleted
+			logger.Debugf("Skipping delete event as the object doesn't match the %q label selector anymore", r.labelSelector)
+			return
+		}
+		if eventType == config.CreateEvent && r.labelSelector != "" && selectorLabelsChangedAfterCreation(selector, newObj) {
+			// informer reports existing objects which start matching the label selector as added
+			logger.Debugf("Skipping create event as the object was labeled after creation to match the %q label selector", r.labelSelector)
+			return
+		}
+
 		event, err := r.eventForObj(ctx, newObj, eventType, resource)
 		if err != nil {
 			logger.Errorf("while creating new event: %s", err.Error())
 			return
 		}
 
-		ok, diffs, err := r.qualifyEvent(event, newObj, oldObj, routes)
+		matched, ok, diffs, err := r.qualifyEvent(event, newObj, oldObj, routes)
 		if err != nil {
 			logger.Errorf("while getting sources for event: %s", err.Error())
 			// continue anyway, there could be still some sources to handle
@@ -52,6 +77,11 @@ func (r registration) handleEvent(ctx context.Context, s Source, resource string
 		if !ok {
 			return
 		}
+		event.EnrichmentSettings = matched.Enrichment
+		if !r.deduplicator.Process(matched.Deduplication, &event, r.summaryFn(ctx, s, fn)) {
+			logger.Debug("Skipping event as it is a repeated one or the resource is flapping")
+			return
+		}
 		fn(ctx, s, event, diffs)
 	}
 
@@ -106,12 +136,17 @@ func (r registration) handleMapped(ctx context.Context, s Source, eventType conf
 			}
 
 			routes := eventRoutes(routeTable, gvrString, eventType)
-			ok, err := r.matchEvent(routes, event)
+			matched, err := r.matchEvent(routes, event, obj, nil)
 			if err != nil {
 				r.log.Errorf("cannot calculate event for observed mapped resource event: %q in Add event handler: %s", eventType, err.Error())
 				// continue anyway, there could be still some sources to handle
 			}
-			if !ok {
+			if matched == nil {
+				return
+			}
+			event.EnrichmentSettings = matched.Enrichment
+			if !r.deduplicator.Process(matched.Deduplication, &event, r.summaryFn(ctx, s, fn)) {
+				r.log.Debugf("Skipping %q event for %s/%s as it is a repeated one or the resource is flapping", event.Reason, event.Namespace, event.Name)
 				return
 			}
 			fn(ctx, s, event, nil)
@@ -119,6 +154,68 @@ func (r registration) handleMapped(ctx context.Context, s Source, eventType conf
 	})
 }
 
+// summaryFn returns a function which sends the summary of suppressed repeats, unless the source was already stopped.
+func (r registration) summaryFn(ctx context.Context, s Source, fn eventHandler) dedup.SummaryFn {
+	return func(e event.Event) {
+		if ctx.Err() != nil {
+			return
+		}
+		fn(ctx, s, e, nil)
+	}
+}
+
+// stoppedMatching returns true if a given deleted object doesn't match the label selector.
+// When an object stops matching the selector, the informer reports its latest state as deleted.
+// Objects which were actually deleted keep their labels, also when the informer missed the deletion and passed the tombstone.
+func stoppedMatching(selector labels.Selector, obj interface{}) bool {
+	if selector == nil {
+		return false
+	}
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	unstructuredObj, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return false
+	}
+	return !selector.Matches(labels.Set(unstructuredObj.GetLabels()))
+}
+
+// selectorLabelsChangedAfterCreation returns true if any label used by the selector was set after a given object was created.
+// It's based on the managed fields, so it returns false if they are not tracked.
+func selectorLabelsChangedAfterCreation(selector labels.Selector, obj interface{}) bool {
+	if selector == nil {
+		return false
+	}
+	unstructuredObj, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return false
+	}
+	requirements, _ := selector.Requirements()
+
+	created := unstructuredObj.GetCreationTimestamp()
+	for _, entry := range unstructuredObj.GetManagedFields() {
+		if entry.Time == nil || !entry.Time.After(created.Time) || entry.FieldsV1 == nil {
+			continue
+		}
+
+		var fields struct {
+			Metadata struct {
+				Labels map[string]any `json:"f:labels"`
+			} `json:"f:metadata"`
+		}
+		if err := json.Unmarshal(entry.FieldsV1.Raw, &fields); err != nil {
+			continue
+		}
+		for _, req := range requirements {
+			if _, found := fields.Metadata.Labels["f:"+req.Key()]; found {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (r registration) canHandleEvent(target string) bool {
 	for _, e := range r.events {
 		if strings.EqualFold(target, e.String()) {
@@ -137,18 +234,20 @@ func (r registration) includesSrcResource(resource string) bool {
 	return false
 }
 
-func (r registration) matchEvent(routes []route, event event.Event) (bool, error) {
+// matchEvent returns the first route matching a given event. If there is no match, nil is returned.
+func (r registration) matchEvent(routes []route, event event.Event, newObj, oldObj interface{}) (*route, error) {
 	errs := multierror.New()
-	for _, rt := range routes {
+	for idx := range routes {
+		rt := routes[idx]
 		// event reason
 		if rt.Event != nil && rt.Event.Reason.AreConstraintsDefined() {
 			match, err := rt.Event.Reason.IsAllowed(event.Reason)
 			if err != nil {
-				return false, err
+				return nil, err
 			}
 			if !match {
 				r.log.Debugf("Ignoring as reason %q doesn't match constraints %+v", event.Reason, rt.Event.Reason)
-				return false, nil
+				return nil, nil
 			}
 		}
 
@@ -165,7 +264,7 @@ func (r registration) matchEvent(routes []route, event event.Event) (bool, error
 			for _, msg := range eventMsgs {
 				match, err := rt.Event.Message.IsAllowed(msg)
 				if err != nil {
-					return false, err
+					return nil, err
 				}
 				if match {
 					anyMsgMatches = true
@@ -174,7 +273,7 @@ func (r registration) matchEvent(routes []route, event event.Event) (bool, error
 			}
 			if !anyMsgMatches {
 				r.log.Debugf("Ignoring as any event message from %q doesn't match constraints %+v", strings.Join(event.Messages, ";"), rt.Event.Message)
-				return false, nil
+				return nil, nil
 			}
 		}
 
@@ -182,11 +281,11 @@ func (r registration) matchEvent(routes []route, event event.Event) (bool, error
 		if rt.ResourceName.AreConstraintsDefined() {
 			allowed, err := rt.ResourceName.IsAllowed(event.Name)
 			if err != nil {
-				return false, err
+				return nil, err
 			}
 			if !allowed {
 				r.log.Debugf("Ignoring as resource name %q doesn't match constraints %+v", event.Name, rt.ResourceName)
-				return false, nil
+				return nil, nil
 			}
 		}
 
@@ -194,50 +293,64 @@ func (r registration) matchEvent(routes []route, event event.Event) (bool, error
 		if rt.Namespaces != nil && rt.Namespaces.AreConstraintsDefined() {
 			match, err := rt.Namespaces.IsAllowed(event.Namespace)
 			if err != nil {
-				return false, err
+				return nil, err
 			}
 			if !match {
 				r.log.Debugf("Ignoring as namespace %q doesn't match constraints %+v", event.Namespace, rt.Namespaces)
-				return false, nil
+				return nil, nil
 			}
 		}
 
 		// annotations
-		if !kvsSatisfiedForMap(rt.Annotations, event.ObjectMeta.Annotations) {
+		if !selectorMatches(rt.AnnotationSelector, event.ObjectMeta.Annotations) {
 			continue
 		}
 
 		// labels
-		if !kvsSatisfiedForMap(rt.Labels, event.ObjectMeta.Labels) {
+		if !selectorMatches(rt.LabelSelector, event.ObjectMeta.Labels) {
 			continue
 		}
-		return true, nil
+
+		// filter expression
+		if rt.Filter != nil {
+			match, err := rt.Filter.Evaluate(filterInput(event, newObj, oldObj))
+			if err != nil {
+				errs = multierror.Append(errs, err)
+				continue
+			}
+			if !match {
+				r.log.Debugf("Ignoring as filter expression %q evaluated to false", rt.Filter.String())
+				continue
+			}
+		}
+		return &rt, nil
 	}
 
-	return false, errs.ErrorOrNil()
+	return nil, errs.ErrorOrNil()
 }
 
-func kvsSatisfiedForMap(expectedKV *map[string]string, obj map[string]string) bool {
-	if expectedKV == nil || len(*expectedKV) == 0 {
+// selectorMatches returns true if a given key-value set satisfies the selector. Nil selector matches everything.
+func selectorMatches(selector labels.Selector, set map[string]string) bool {
+	if selector == nil {
 		return true
 	}
+	return selector.Matches(labels.Set(set))
+}
 
-	if len(obj) == 0 {
-		return false
+func filterInput(event event.Event, newObj, oldObj interface{}) expression.Input {
+	return expression.Input{
+		Object:    unstructuredContent(newObj),
+		OldObject: unstructuredContent(oldObj),
+		Event:     event.ExpressionVars(),
 	}
+}
 
-	for k, v := range *expectedKV {
-		got, ok := obj[k]
-		if !ok {
-			return false
-		}
-
-		if got != v {
-			return false
-		}
+func unstructuredContent(obj interface{}) map[string]any {
+	unstructuredObj, ok := obj.(*unstructured.Unstructured)
+	if !ok || unstructuredObj == nil {
+		return nil
 	}
-
-	return true
+	return unstructuredObj.Object
 }
 
 func (r registration) eventForObj(ctx context.Context, obj interface{}, eventType config.EventType, resource string) (event.Event, error) {
@@ -258,20 +371,21 @@ func (r registration) qualifyEvent(
 	event event.Event,
 	newObj, oldObj interface{},
 	routes []route,
-) (bool, []string, error) {
-	ok, err := r.matchEvent(routes, event)
+) (*route, bool, []string, error) {
+	matched, err := r.matchEvent(routes, event, newObj, oldObj)
 	if err != nil {
-		return false, nil, fmt.Errorf("while matching event: %w", err)
+		return nil, false, nil, fmt.Errorf("while matching event: %w", err)
 	}
-	if !ok {
-		return false, nil, nil
+	if matched == nil {
+		return nil, false, nil, nil
 	}
 
 	if event.Type == config.UpdateEvent {
-		return r.qualifyEventForUpdate(newObj, oldObj, routes)
+		ok, diffs, err := r.qualifyEventForUpdate(newObj, oldObj, routes)
+		return matched, ok, diffs, err
 	}
 
-	return true, nil, nil
+	return matched, true, nil, nil
 }
 
 func (r registration) qualifyEventForUpdate(
@@ -0,0 +1,263 @@
+package kubernetes
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+)
+
+func TestRegistrationMatchEvent_FilterExpression(t *testing.T) {
+	// given
+	const resource = "apps/v1/deployments"
+	cfg := config.Config{
+		Event: &config.KubernetesEvent{
+			Types: []config.EventType{config.UpdateEvent},
+		},
+		Resources: []config.Resource{
+			{
+				Type: resource,
+				Filter: &config.ResourceFilter{
+					Expression: `event.type == "update" && object.status.availableReplicas < oldObject.status.availableReplicas`,
+				},
+			},
+		},
+	}
+	routes := NewRouter(nil, nil, loggerx.NewNoop()).BuildTable(&cfg).getSourceRoutes(resource, config.UpdateEvent)
+	require.Len(t, routes, 1)
+
+	reg := registration{log: loggerx.NewNoop()}
+	evt := event.Event{Type: config.UpdateEvent, Name: "api", Namespace: "default"}
+
+	tests := []struct {
+		name           string
+		givenAvailable int64
+		expMatch       bool
+	}{
+		{
+			name:           "Available replicas decreased",
+			givenAvailable: 1,
+			expMatch:       true,
+		},
+		{
+			name:           "Available replicas unchanged",
+			givenAvailable: 3,
+			expMatch:       false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			matched, err := reg.matchEvent(routes, evt, fixDeployment(tc.givenAvailable), fixDeployment(3))
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.expMatch, matched != nil)
+		})
+	}
+}
+
+func fixDeployment(availableReplicas int64) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"status": map[string]any{
+				"availableReplicas": availableReplicas,
+			},
+		},
+	}
+}
+
+func TestRegistrationMatchEvent_Selectors(t *testing.T) {
+	// given
+	const resource = "v1/pods"
+	cfg := config.Config{
+		Event: &config.KubernetesEvent{
+			Types: []config.EventType{config.CreateEvent},
+		},
+		Labels: &map[string]string{
+			"team": "payments",
+		},
+		LabelSelector: &config.Selector{
+			Expression: "env in (prod, qa),!canary",
+		},
+		Resources: []config.Resource{
+			{
+				Type: resource,
+				AnnotationSelector: &config.Selector{
+					MatchExpressions: []config.SelectorRequirement{
+						{Key: "botkube.io/channel", Operator: "Exists"},
+					},
+				},
+			},
+		},
+	}
+	routes := NewRouter(nil, nil, loggerx.NewNoop()).BuildTable(&cfg).getSourceRoutes(resource, config.CreateEvent)
+	require.Len(t, routes, 1)
+
+	reg := registration{log: loggerx.NewNoop()}
+
+	tests := []struct {
+		name             string
+		givenLabels      map[string]string
+		givenAnnotations map[string]string
+		expMatch         bool
+	}{
+		{
+			name:             "All requirements satisfied",
+			givenLabels:      map[string]string{"team": "payments", "env": "qa"},
+			givenAnnotations: map[string]string{"botkube.io/channel": "alerts"},
+			expMatch:         true,
+		},
+		{
+			name:             "Label value not in set",
+			givenLabels:      map[string]string{"team": "payments", "env": "dev"},
+			givenAnnotations: map[string]string{"botkube.io/channel": "alerts"},
+			expMatch:         false,
+		},
+		{
+			name:             "Excluded label exists",
+			givenLabels:      map[string]string{"team": "payments", "env": "prod", "canary": "true"},
+			givenAnnotations: map[string]string{"botkube.io/channel": "alerts"},
+			expMatch:         false,
+		},
+		{
+			name:             "Exact label missing",
+			givenLabels:      map[string]string{"env": "prod"},
+			givenAnnotations: map[string]string{"botkube.io/channel": "alerts"},
+			expMatch:         false,
+		},
+		{
+			name:        "Annotation missing",
+			givenLabels: map[string]string{"team": "payments", "env": "prod"},
+			expMatch:    false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			evt := event.Event{Type: config.CreateEvent}
+			evt.ObjectMeta.Labels = tc.givenLabels
+			evt.ObjectMeta.Annotations = tc.givenAnnotations
+
+			// when
+			matched, err := reg.matchEvent(routes, evt, nil, nil)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.expMatch, matched != nil)
+		})
+	}
+}
+
+func TestSelectorLabelsChangedAfterCreation(t *testing.T) {
+	// given
+	created := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+	selector, err := labels.Parse("team=a")
+	require.NoError(t, err)
+
+	labelsFields := `{"f:metadata":{"f:labels":{".":{},"f:team":{}}}}`
+	specFields := `{"f:spec":{"f:replicas":{}}}`
+
+	type fieldsEntry struct {
+		time   time.Time
+		fields string
+	}
+	tests := []struct {
+		name        string
+		givenFields []fieldsEntry
+		expChanged  bool
+	}{
+		{
+			name:        "Newly created object",
+			givenFields: []fieldsEntry{{time: created, fields: labelsFields}},
+			expChanged:  false,
+		},
+		{
+			name:        "Object labeled after creation",
+			givenFields: []fieldsEntry{{time: created, fields: specFields}, {time: created.Add(time.Hour), fields: labelsFields}},
+			expChanged:  true,
+		},
+		{
+			name:        "Object updated after creation without label changes",
+			givenFields: []fieldsEntry{{time: created, fields: labelsFields}, {time: created.Add(time.Hour), fields: specFields}},
+			expChanged:  false,
+		},
+		{
+			name:       "Object without managed fields",
+			expChanged: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			obj := &unstructured.Unstructured{}
+			obj.SetCreationTimestamp(metav1.NewTime(created))
+			var fields []metav1.ManagedFieldsEntry
+			for _, entry := range tc.givenFields {
+				fieldsTime := metav1.NewTime(entry.time)
+				fields = append(fields, metav1.ManagedFieldsEntry{
+					Manager:  "kubectl",
+					Time:     &fieldsTime,
+					FieldsV1: &metav1.FieldsV1{Raw: []byte(entry.fields)},
+				})
+			}
+			obj.SetManagedFields(fields)
+
+			// when
+			changed := selectorLabelsChangedAfterCreation(selector, obj)
+
+			// then
+			assert.Equal(t, tc.expChanged, changed)
+		})
+	}
+}
+
+func TestStoppedMatching(t *testing.T) {
+	// given
+	selector, err := labels.Parse("team=a")
+	require.NoError(t, err)
+
+	fixObj := func(lbls map[string]string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetLabels(lbls)
+		return obj
+	}
+
+	tests := []struct {
+		name       string
+		givenObj   interface{}
+		expStopped bool
+	}{
+		{
+			name:       "Deleted object",
+			givenObj:   fixObj(map[string]string{"team": "a"}),
+			expStopped: false,
+		},
+		{
+			name:       "Object with changed labels",
+			givenObj:   fixObj(map[string]string{"team": "b"}),
+			expStopped: true,
+		},
+		{
+			name:       "Tombstone of deleted object",
+			givenObj:   cache.DeletedFinalStateUnknown{Key: "default/foo", Obj: fixObj(map[string]string{"team": "a"})},
+			expStopped: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			stopped := stoppedMatching(selector, tc.givenObj)
+
+			// then
+			assert.Equal(t, tc.expStopped, stopped)
+		})
+	}
+}
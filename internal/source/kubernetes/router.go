@@ -5,27 +5,35 @@ import (
 
 	"github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/tools/cache"
 
 	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/dedup"
 	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/expression"
 	"github.com/kubeshop/botkube/internal/source/kubernetes/recommendation"
 )
 
 const eventsResource = "v1/events"
 
 type mergedEvents map[string]map[config.EventType]struct{}
-type registrationHandler func(resource string) (cache.SharedIndexInformer, error)
+type registrationHandler func(resource, labelSelector string) (cache.SharedIndexInformer, error)
 type eventHandler func(ctx context.Context, source Source, event event.Event, updateDiffs []string)
 
 type route struct {
-	ResourceName  config.RegexConstraints
-	Labels        *map[string]string
-	Annotations   *map[string]string
-	Namespaces    *config.RegexConstraints
-	UpdateSetting *config.UpdateSetting
-	Event         *config.KubernetesEvent
+	ResourceName       config.RegexConstraints
+	Labels             *map[string]string
+	Annotations        *map[string]string
+	LabelSelector      labels.Selector `yaml:"-"`
+	AnnotationSelector labels.Selector `yaml:"-"`
+	Namespaces         *config.RegexConstraints
+	UpdateSetting      *config.UpdateSetting
+	Event              *config.KubernetesEvent
+	Deduplication      *config.Deduplication
+	Filter             *expression.Program `yaml:"-"`
+	Enrichment         *config.Enrichment
 }
 
 func (r route) hasActionableUpdateSetting() bool {
@@ -45,6 +53,7 @@ type Router struct {
 	dynamicCli    dynamic.Interface
 	table         map[string][]entry
 	registrations map[string]registration
+	deduplicator  *dedup.Tracker
 }
 
 // NewRouter creates a new router to use for routing event types to registered informers.
@@ -55,6 +64,7 @@ func NewRouter(mapper meta.RESTMapper, dynamicCli dynamic.Interface, log logrus.
 		dynamicCli:    dynamicCli,
 		table:         make(map[string][]entry),
 		registrations: make(map[string]registration),
+		deduplicator:  dedup.NewTracker(),
 	}
 }
 
@@ -77,16 +87,19 @@ func (r *Router) BuildTable(cfg *config.Config) *Router {
 func (r *Router) RegisterInformers(targetEvents []config.EventType, handler registrationHandler) error {
 	resources := r.resourcesForEvents(targetEvents)
 	for _, resource := range resources {
-		informer, err := handler(resource)
+		labelSelector := r.resourceLabelSelector(resource)
+		informer, err := handler(resource, labelSelector)
 		if err != nil {
 			return err
 		}
 		r.registrations[resource] = registration{
-			informer:   informer,
-			events:     r.resourceEvents(resource),
-			log:        r.log,
-			mapper:     r.mapper,
-			dynamicCli: r.dynamicCli,
+			informer:      informer,
+			events:        r.resourceEvents(resource),
+			labelSelector: labelSelector,
+			log:           r.log,
+			mapper:        r.mapper,
+			dynamicCli:    r.dynamicCli,
+			deduplicator:  r.deduplicator,
 		}
 	}
 	return nil
@@ -105,7 +118,8 @@ func (r *Router) MapWithEventsInformer(srcEvent config.EventType, dstEvent confi
 		return nil
 	}
 
-	informer, err := handler(eventsResource)
+	// mapped events are reported for different resources, so the selectors cannot be pushed down
+	informer, err := handler(eventsResource, "")
 	if err != nil {
 		return err
 	}
@@ -117,6 +131,7 @@ func (r *Router) MapWithEventsInformer(srcEvent config.EventType, dstEvent confi
 		log:             r.log,
 		mapper:          r.mapper,
 		dynamicCli:      r.dynamicCli,
+		deduplicator:    r.deduplicator,
 	}
 	return nil
 }
@@ -169,18 +184,43 @@ func mergeResourceEvents(cfg *config.Config) mergedEvents {
 
 func (r *Router) mergeEventRoutes(resource string, cfg *config.Config) map[config.EventType][]route {
 	out := make(map[config.EventType][]route)
+	log := r.log
 	for idx := range cfg.Resources {
 		r := cfg.Resources[idx] // make sure that we work on a copy
+		if resource != r.Type {
+			continue
+		}
+		// expressions and selectors are already validated when merging configs, so the errors shouldn't happen
+		filter, err := resourceFilter(r.Filter)
+		if err != nil {
+			log.Errorf("Skipping routes for resource %q: %s", r.Type, err.Error())
+			continue
+		}
+		resAnnotations := resourceStringMap(cfg.Annotations, r.Annotations)
+		annotationSelector, err := resourceSelector(resAnnotations, cfg.AnnotationSelector, r.AnnotationSelector)
+		if err != nil {
+			log.Errorf("Skipping routes for resource %q: while building annotation selector: %s", r.Type, err.Error())
+			continue
+		}
+		resLabels := resourceStringMap(cfg.Labels, r.Labels)
+		labelSelector, err := resourceSelector(resLabels, cfg.LabelSelector, r.LabelSelector)
+		if err != nil {
+			log.Errorf("Skipping routes for resource %q: while building label selector: %s", r.Type, err.Error())
+			continue
+		}
+
 		for _, e := range flattenEventTypes(cfg.Event.Types, r.Event.Types) {
-			if resource != r.Type {
-				continue
-			}
 			route := route{
-				Namespaces:   resourceNamespaces(cfg.Namespaces, &r.Namespaces),
-				Annotations:  resourceStringMap(cfg.Annotations, r.Annotations),
-				Labels:       resourceStringMap(cfg.Labels, r.Labels),
-				ResourceName: r.Name,
-				Event:        resourceEvent(*cfg.Event, r.Event),
+				Namespaces:         resourceNamespaces(cfg.Namespaces, &r.Namespaces),
+				Annotations:        resAnnotations,
+				Labels:             resLabels,
+				AnnotationSelector: annotationSelector,
+				LabelSelector:      labelSelector,
+				ResourceName:       r.Name,
+				Event:              resourceEvent(*cfg.Event, r.Event),
+				Deduplication:      resourceDeduplication(cfg.Deduplication, r.Deduplication),
+				Filter:             filter,
+				Enrichment:         r.Enrichment,
 			}
 			if e == config.UpdateEvent {
 				route.UpdateSetting = &config.UpdateSetting{
@@ -212,7 +252,8 @@ func (r *Router) setEventRouteForRecommendationsIfShould(routeMap *map[config.Ev
 	}
 
 	recommRoute := route{
-		Namespaces: cfg.Namespaces,
+		Namespaces:    cfg.Namespaces,
+		Deduplication: cfg.Deduplication,
 		Event: &config.KubernetesEvent{
 			Reason:  config.RegexConstraints{},
 			Message: config.RegexConstraints{},
@@ -242,6 +283,35 @@ func eventRoutes(routeTable map[string][]entry, targetResource string, targetEve
 	return out
 }
 
+// resourceLabelSelector returns a label selector which can be used to list and watch a given resource.
+// It's returned only if all routes for the resource share the same label selector, otherwise all objects must be watched.
+func (r *Router) resourceLabelSelector(resource string) string {
+	var (
+		out    string
+		routes int
+	)
+	for _, routedEvent := range r.table[resource] {
+		for _, rt := range routedEvent.Routes {
+			if rt.LabelSelector == nil || rt.LabelSelector.Empty() {
+				return ""
+			}
+			selector := rt.LabelSelector.String()
+			if routes > 0 && selector != out {
+				return ""
+			}
+			out = selector
+			routes++
+		}
+	}
+
+	// exact key-value pairs are not validated, so make sure that the selector is accepted by the API server
+	if _, err := labels.Parse(out); err != nil {
+		r.log.Debugf("Not using label selector %q for listing %s: %s", out, resource, err.Error())
+		return ""
+	}
+	return out
+}
+
 func (r *Router) resourceEvents(resource string) []config.EventType {
 	var out []config.EventType
 	for _, routedEvent := range r.table[resource] {
@@ -315,3 +385,33 @@ func resourceEvent(sourceEvent, resourceEvent config.KubernetesEvent) *config.Ku
 
 	return &sourceEvent
 }
+
+// resourceDeduplication returns the source deduplication settings
+// unless the resource ones are configured.
+func resourceDeduplication(sourceDedup, resourceDedup *config.Deduplication) *config.Deduplication {
+	if resourceDedup != nil {
+		return resourceDedup
+	}
+	return sourceDedup
+}
+
+// resourceSelector returns a selector for the exact key-value pairs combined with
+// the source selector requirements, unless the resource ones are configured.
+func resourceSelector(exact *map[string]string, sourceSelector, resourceSelector *config.Selector) (labels.Selector, error) {
+	var kvs map[string]string
+	if exact != nil {
+		kvs = *exact
+	}
+	selector := sourceSelector
+	if resourceSelector.IsDefined() {
+		selector = resourceSelector
+	}
+	return config.BuildSelector(kvs, selector)
+}
+
+func resourceFilter(filter *config.ResourceFilter) (*expression.Program, error) {
+	if filter == nil || filter.Expression == "" {
+		return nil, nil
+	}
+	return expression.Compile(filter.Expression)
+}
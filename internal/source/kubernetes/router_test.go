@@ -92,3 +92,58 @@ func TestRouterListMergingNestedFields(t *testing.T) {
 		golden.Assert(t, string(out), filepath.Join(t.Name(), filename))
 	}
 }
+
+func TestRouterResourceLabelSelector(t *testing.T) {
+	tests := []struct {
+		name        string
+		givenCfg    config.Config
+		expSelector string
+	}{
+		{
+			name: "Same selector for all routes",
+			givenCfg: config.Config{
+				Event:  &config.KubernetesEvent{Types: []config.EventType{config.CreateEvent, config.DeleteEvent}},
+				Labels: &map[string]string{"team": "payments"},
+				LabelSelector: &config.Selector{
+					Expression: "env in (prod)",
+				},
+				Resources: []config.Resource{{Type: "v1/pods"}},
+			},
+			expSelector: "env in (prod),team=payments",
+		},
+		{
+			name: "Different selectors",
+			givenCfg: config.Config{
+				Event: &config.KubernetesEvent{Types: []config.EventType{config.CreateEvent}},
+				LabelSelector: &config.Selector{
+					Expression: "env in (prod)",
+				},
+				Resources: []config.Resource{
+					{Type: "v1/pods"},
+					{Type: "v1/pods", LabelSelector: &config.Selector{Expression: "env=qa"}},
+				},
+			},
+			expSelector: "",
+		},
+		{
+			name: "Route without selector",
+			givenCfg: config.Config{
+				Event:     &config.KubernetesEvent{Types: []config.EventType{config.CreateEvent}},
+				Resources: []config.Resource{{Type: "v1/pods"}},
+			},
+			expSelector: "",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			router := NewRouter(nil, nil, loggerx.NewNoop()).BuildTable(&tc.givenCfg)
+
+			// when
+			got := router.resourceLabelSelector("v1/pods")
+
+			// then
+			assert.Equal(t, tc.expSelector, got)
+		})
+	}
+}
@@ -0,0 +1,218 @@
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+	coreV1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	"github.com/kubeshop/botkube/internal/command"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/commander"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/enrichment"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/filterengine"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/k8sutil"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/recommendation"
+	"github.com/kubeshop/botkube/pkg/api/source"
+)
+
+const warningEventType = "Warning"
+
+// SimulationInput holds a recorded Kubernetes object which is processed without connecting to a cluster.
+type SimulationInput struct {
+	// Object is a recorded Kubernetes object. A core/v1 Event is reported as the error event of the involved object,
+	// unless a different EventType is set explicitly.
+	Object *unstructured.Unstructured
+	// OldObject is the previous object state, used to calculate the diff for update events.
+	OldObject *unstructured.Unstructured
+	// EventType is the type of the simulated event. If empty, it's detected based on the object kind.
+	EventType config.EventType
+
+	ClusterName              string
+	IsInteractivitySupported bool
+}
+
+// SimulationResult holds the outcome of a simulated event.
+type SimulationResult struct {
+	// Event is set only if the event passed the routes, filters and recommendations, so it would be sent.
+	Event *source.Event
+	// Resource is the resource the event was routed for, e.g. "v1/pods".
+	Resource  string
+	EventType config.EventType
+}
+
+// Simulate processes a recorded object in the same way as the source does for objects observed by informers:
+// it matches the routes, runs filters and recommendations, and renders the message.
+// Objects are not fetched from a cluster, so the enrichment is skipped, and the filters and recommendations only see
+// the recorded objects. Reasons why the event is skipped are logged on the debug level.
+func Simulate(ctx context.Context, log logrus.FieldLogger, cfg config.Config, in SimulationInput) (SimulationResult, error) {
+	if in.Object == nil {
+		return SimulationResult{}, errors.New("object is required")
+	}
+
+	eventType := in.EventType
+	if eventType == "" {
+		eventType = config.CreateEvent
+		if isCoreEvent(in.Object) {
+			eventType = config.ErrorEvent
+		}
+	}
+
+	mapper := meta.NewDefaultRESTMapper(nil)
+	addToMapper(mapper, in.Object.GroupVersionKind(), in.Object.GetNamespace() != "")
+
+	var coreEvent *coreV1.Event
+	if eventType == config.ErrorEvent && isCoreEvent(in.Object) {
+		coreEvent = &coreV1.Event{}
+		if err := k8sutil.TransformIntoTypedObject(in.Object, coreEvent); err != nil {
+			return SimulationResult{}, fmt.Errorf("while converting object into Event: %w", err)
+		}
+		addToMapper(mapper, coreEvent.InvolvedObject.GroupVersionKind(), coreEvent.InvolvedObject.Namespace != "")
+	}
+	dynamicCli := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), in.Object)
+
+	router := NewRouter(mapper, dynamicCli, log).BuildTable(&cfg)
+	reg := registration{
+		log:        log,
+		mapper:     mapper,
+		dynamicCli: dynamicCli,
+	}
+
+	var (
+		resource string
+		routes   []route
+	)
+	if coreEvent != nil {
+		if coreEvent.Type != warningEventType {
+			log.Debugf("Skipping %q Event as only %q Events are reported as errors", coreEvent.Type, warningEventType)
+			return SimulationResult{EventType: eventType}, nil
+		}
+		gvr, err := k8sutil.GetResourceFromKind(mapper, coreEvent.InvolvedObject.GroupVersionKind())
+		if err != nil {
+			return SimulationResult{}, err
+		}
+		resource = gvrToString(gvr)
+		routes = eventRoutes(router.table, resource, eventType)
+	} else {
+		mapping, err := mapper.RESTMapping(in.Object.GroupVersionKind().GroupKind(), in.Object.GroupVersionKind().Version)
+		if err != nil {
+			return SimulationResult{}, fmt.Errorf("while getting resource for object: %w", err)
+		}
+		resource = gvrToString(mapping.Resource)
+		routes = router.getSourceRoutes(resource, eventType)
+	}
+
+	out := SimulationResult{Resource: resource, EventType: eventType}
+	if len(routes) == 0 {
+		log.Debugf("Skipping event as there are no routes for %q %s events", resource, eventType)
+		return out, nil
+	}
+
+	e, err := reg.eventForObj(ctx, in.Object, eventType, resource)
+	if err != nil {
+		return SimulationResult{}, err
+	}
+
+	var (
+		matched *route
+		ok      bool
+		diffs   []string
+	)
+	if coreEvent != nil {
+		matched, err = reg.matchEvent(routes, e, in.Object, nil)
+		ok = matched != nil
+	} else {
+		var oldObj any
+		if in.OldObject != nil {
+			oldObj = in.OldObject
+		}
+		matched, ok, diffs, err = reg.qualifyEvent(e, in.Object, oldObj, routes)
+	}
+	if err != nil {
+		return SimulationResult{}, fmt.Errorf("while matching routes: %w", err)
+	}
+	if !ok {
+		log.Debug("Skipping event as it doesn't match any route")
+		return out, nil
+	}
+	// the enrichment needs the current cluster state
+	e.EnrichmentSettings = nil
+	if matched.Enrichment.IsEnabled() {
+		log.Debug("Skipping enrichment as it requires access to the cluster")
+	}
+
+	filterEngine, err := filterengine.WithAllFilters(log, dynamicCli, mapper, cfg.Filters)
+	if err != nil {
+		return SimulationResult{}, fmt.Errorf("while creating filter engine: %w", err)
+	}
+
+	s := Source{
+		config:                   cfg,
+		logger:                   log,
+		eventCh:                  make(chan source.Event, 1),
+		clusterName:              in.ClusterName,
+		isInteractivitySupported: in.IsInteractivitySupported,
+		filterEngine:             filterEngine,
+		enricher:                 enrichment.NewEnricher(log, nil),
+		recommFactory:            recommendation.NewFactory(log, dynamicCli),
+		messageBuilder: NewMessageBuilder(in.IsInteractivitySupported, log, commander.NewCommander(log, &offlineCmdGuard{
+			CommandGuard: command.NewCommandGuard(log, nil),
+			gvr:          gvrForResource(resource),
+			namespaced:   e.Namespace != "",
+		}, cfg.Commands)),
+	}
+	handleEvent(ctx, s, e, diffs)
+
+	select {
+	case msg := <-s.eventCh:
+		out.Event = &msg
+	default:
+	}
+	return out, nil
+}
+
+func isCoreEvent(obj *unstructured.Unstructured) bool {
+	gvk := obj.GroupVersionKind()
+	return gvk.Group == "" && gvk.Kind == "Event"
+}
+
+func addToMapper(mapper *meta.DefaultRESTMapper, gvk schema.GroupVersionKind, namespaced bool) {
+	scope := meta.RESTScopeRoot
+	if namespaced {
+		scope = meta.RESTScopeNamespace
+	}
+	mapper.Add(gvk, scope)
+}
+
+func gvrForResource(resource string) schema.GroupVersionResource {
+	gvr, _ := strToGVR(resource)
+	return gvr
+}
+
+// offlineCmdGuard is a command guard which assumes that the cluster serves a single resource the event is related to.
+type offlineCmdGuard struct {
+	*command.CommandGuard
+	gvr        schema.GroupVersionResource
+	namespaced bool
+}
+
+// GetServerResourceMap returns the resource the event is related to with the verbs supported by all built-in resources.
+func (g *offlineCmdGuard) GetServerResourceMap() (map[string]metav1.APIResource, error) {
+	return map[string]metav1.APIResource{
+		g.gvr.Resource: {
+			Name:       g.gvr.Resource,
+			Group:      g.gvr.Group,
+			Version:    g.gvr.Version,
+			Namespaced: g.namespaced,
+			Verbs:      metav1.Verbs{"get", "list", "watch", "create", "update", "patch", "delete"},
+		},
+	}, nil
+}
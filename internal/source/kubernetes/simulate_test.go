@@ -0,0 +1,124 @@
+package kubernetes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/pkg/api/source"
+)
+
+func TestSimulate(t *testing.T) {
+	// given
+	cfg, err := config.MergeConfigs([]*source.Config{
+		{
+			RawYAML: []byte(heredoc.Doc(`
+				event:
+				  types: [error]
+				namespaces:
+				  include: [default]
+				resources:
+				  - type: v1/pods
+			`)),
+		},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		givenObject  *unstructured.Unstructured
+		expSent      bool
+		expResource  string
+		expEventType config.EventType
+	}{
+		{
+			name:         "Warning Event for a Pod",
+			givenObject:  fixCoreEvent("Warning", "default"),
+			expSent:      true,
+			expResource:  "v1/pods",
+			expEventType: config.ErrorEvent,
+		},
+		{
+			name:         "Normal Event for a Pod",
+			givenObject:  fixCoreEvent("Normal", "default"),
+			expSent:      false,
+			expEventType: config.ErrorEvent,
+		},
+		{
+			name:         "Warning Event from excluded namespace",
+			givenObject:  fixCoreEvent("Warning", "kube-system"),
+			expSent:      false,
+			expResource:  "v1/pods",
+			expEventType: config.ErrorEvent,
+		},
+		{
+			name:         "Created Pod without create route",
+			givenObject:  fixPod("default"),
+			expSent:      false,
+			expResource:  "v1/pods",
+			expEventType: config.CreateEvent,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			out, err := Simulate(context.Background(), loggerx.NewNoop(), cfg, SimulationInput{
+				Object:      tc.givenObject,
+				ClusterName: "dev",
+			})
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.expResource, out.Resource)
+			assert.Equal(t, tc.expEventType, out.EventType)
+			if !tc.expSent {
+				assert.Nil(t, out.Event)
+				return
+			}
+			require.NotNil(t, out.Event)
+			require.NotEmpty(t, out.Event.Message.Sections)
+			assert.Equal(t, "❗ v1/pods error", out.Event.Message.Sections[0].Base.Header)
+		})
+	}
+}
+
+func fixCoreEvent(eventType, namespace string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "Event",
+			"metadata": map[string]any{
+				"name":      "nginx.123",
+				"namespace": namespace,
+			},
+			"type":    eventType,
+			"reason":  "BackOff",
+			"message": "Back-off restarting failed container",
+			"involvedObject": map[string]any{
+				"apiVersion": "v1",
+				"kind":       "Pod",
+				"name":       "nginx",
+				"namespace":  namespace,
+			},
+		},
+	}
+}
+
+func fixPod(namespace string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "Pod",
+			"metadata": map[string]any{
+				"name":      "nginx",
+				"namespace": namespace,
+			},
+		},
+	}
+}
@@ -11,13 +11,13 @@ import (
 	"github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime/schema"
-	"k8s.io/client-go/dynamic/dynamicinformer"
 	"k8s.io/client-go/tools/cache"
 
 	"github.com/kubeshop/botkube/internal/command"
 	"github.com/kubeshop/botkube/internal/loggerx"
 	"github.com/kubeshop/botkube/internal/source/kubernetes/commander"
 	"github.com/kubeshop/botkube/internal/source/kubernetes/config"
+	"github.com/kubeshop/botkube/internal/source/kubernetes/enrichment"
 	"github.com/kubeshop/botkube/internal/source/kubernetes/event"
 	"github.com/kubeshop/botkube/internal/source/kubernetes/filterengine"
 	"github.com/kubeshop/botkube/internal/source/kubernetes/recommendation"
@@ -59,10 +59,12 @@ type Source struct {
 	recommFactory            RecommendationFactory
 	commandGuard             *command.CommandGuard
 	filterEngine             filterengine.FilterEngine
+	enricher                 *enrichment.Enricher
 	clusterName              string
 	kubeConfig               []byte
 	messageBuilder           *MessageBuilder
 	isInteractivitySupported bool
+	metricsServer            *metricsServer
 
 	source.HandleExternalRequestUnimplemented
 }
@@ -71,11 +73,12 @@ type Source struct {
 func NewSource(version string) *Source {
 	return &Source{
 		pluginVersion: version,
+		metricsServer: &metricsServer{},
 	}
 }
 
 // Stream streams Kubernetes events
-func (*Source) Stream(ctx context.Context, input source.StreamInput) (source.StreamOutput, error) {
+func (s *Source) Stream(ctx context.Context, input source.StreamInput) (source.StreamOutput, error) {
 	if err := pluginx.ValidateKubeConfigProvided(PluginName, input.Context.KubeConfig); err != nil {
 		return source.StreamOutput{}, err
 	}
@@ -84,7 +87,7 @@ func (*Source) Stream(ctx context.Context, input source.StreamInput) (source.Str
 	if err != nil {
 		return source.StreamOutput{}, fmt.Errorf("while merging input configs: %w", err)
 	}
-	s := Source{
+	src := Source{
 		startTime: time.Now(),
 		eventCh:   make(chan source.Event),
 		config:    cfg,
@@ -96,9 +99,10 @@ func (*Source) Stream(ctx context.Context, input source.StreamInput) (source.Str
 		isInteractivitySupported: input.Context.IsInteractivitySupported,
 	}
 
-	go consumeEvents(ctx, s)
+	s.metricsServer.StartIfShould(src.logger, cfg.MetricsPort)
+	go consumeEvents(ctx, src)
 	return source.StreamOutput{
-		Event: s.eventCh,
+		Event: src.eventCh,
 	}, nil
 }
 
@@ -117,26 +121,28 @@ func consumeEvents(ctx context.Context, s Source) {
 	client, err := NewClient(s.kubeConfig)
 	exitOnError(err, s.logger)
 
-	dynamicKubeInformerFactory := dynamicinformer.NewDynamicSharedInformerFactory(client.dynamicCli, s.config.InformerResyncPeriod)
+	dynamicKubeInformerFactories := newInformerFactories(client.dynamicCli, s.config.InformerResyncPeriod)
 	router := NewRouter(client.mapper, client.dynamicCli, s.logger)
 	router.BuildTable(&s.config)
 	s.recommFactory = recommendation.NewFactory(s.logger.WithField("component", "Recommendations"), client.dynamicCli)
 	s.commandGuard = command.NewCommandGuard(s.logger.WithField(componentLogFieldKey, "Command Guard"), client.discoveryCli)
 	cmdr := commander.NewCommander(s.logger.WithField(componentLogFieldKey, "Commander"), s.commandGuard, s.config.Commands)
 	s.messageBuilder = NewMessageBuilder(s.isInteractivitySupported, s.logger.WithField(componentLogFieldKey, "Message Builder"), cmdr)
-	s.filterEngine = filterengine.WithAllFilters(s.logger, client.dynamicCli, client.mapper, s.config.Filters)
+	s.filterEngine, err = filterengine.WithAllFilters(s.logger, client.dynamicCli, client.mapper, s.config.Filters)
+	exitOnError(err, s.logger)
+	s.enricher = enrichment.NewEnricher(s.logger.WithField(componentLogFieldKey, "Enricher"), client.k8sCli)
 
 	err = router.RegisterInformers([]config.EventType{
 		config.CreateEvent,
 		config.UpdateEvent,
 		config.DeleteEvent,
-	}, func(resource string) (cache.SharedIndexInformer, error) {
+	}, func(resource, labelSelector string) (cache.SharedIndexInformer, error) {
 		gvr, err := parseResourceArg(resource, client.mapper)
 		if err != nil {
 			s.logger.Infof("Unable to parse resource: %s to register with informer\n", resource)
 			return nil, err
 		}
-		return dynamicKubeInformerFactory.ForResource(gvr).Informer(), nil
+		return dynamicKubeInformerFactories.ForLabelSelector(labelSelector).ForResource(gvr).Informer(), nil
 	})
 	if err != nil {
 		exitOnError(err, s.logger.WithFields(logrus.Fields{
@@ -152,13 +158,13 @@ func consumeEvents(ctx context.Context, s Source) {
 	err = router.MapWithEventsInformer(
 		config.ErrorEvent,
 		config.WarningEvent,
-		func(resource string) (cache.SharedIndexInformer, error) {
+		func(resource, labelSelector string) (cache.SharedIndexInformer, error) {
 			gvr, err := parseResourceArg(resource, client.mapper)
 			if err != nil {
 				s.logger.Infof("Unable to parse resource: %s to register with informer\n", resource)
 				return nil, err
 			}
-			return dynamicKubeInformerFactory.ForResource(gvr).Informer(), nil
+			return dynamicKubeInformerFactories.ForLabelSelector(labelSelector).ForResource(gvr).Informer(), nil
 		})
 	if err != nil {
 		exitOnError(err, s.logger.WithFields(logrus.Fields{
@@ -190,7 +196,7 @@ func consumeEvents(ctx context.Context, s Source) {
 	)
 
 	stopCh := ctx.Done()
-	dynamicKubeInformerFactory.Start(stopCh)
+	dynamicKubeInformerFactories.Start(stopCh)
 }
 
 func handleEvent(ctx context.Context, s Source, e event.Event, updateDiffs []string) {
@@ -220,6 +226,8 @@ func handleEvent(ctx context.Context, s Source, e event.Event, updateDiffs []str
 		return
 	}
 
+	s.enricher.Enrich(ctx, &e)
+
 	recRunner, recCfg := s.recommFactory.New(s.config)
 	err := recRunner.Do(ctx, &e)
 	if err != nil {
@@ -241,6 +249,7 @@ func handleEvent(ctx context.Context, s Source, e event.Event, updateDiffs []str
 	message := source.Event{
 		Message:         msg,
 		RawObject:       e,
+		Labels:          e.Labels,
 		AnalyticsLabels: event.AnonymizedEventDetailsFrom(e),
 	}
 	s.eventCh <- message
@@ -0,0 +1,158 @@
+package source
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"golang.org/x/exp/slices"
+
+	"github.com/kubeshop/botkube/pkg/api/source"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/notifier"
+)
+
+// Router selects the channels and sinks for source events based on the routing rules.
+type Router struct {
+	mu    sync.RWMutex
+	rules []config.RoutingRule
+}
+
+// NewRouter returns a new Router instance.
+func NewRouter(cfg config.Routing) *Router {
+	return &Router{rules: cfg.Rules}
+}
+
+// SetRouting replaces the routing rules, so the configuration changes are applied without restart.
+func (r *Router) SetRouting(cfg config.Routing) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.rules = cfg.Rules
+}
+
+// Route evaluates routing rules in order and returns receivers collected from the matching rules.
+// It returns nil if no rule matches, so the event is delivered to all bound channels and sinks.
+func (r *Router) Route(sourceName string, event source.Event) (*notifier.Receivers, error) {
+	if r == nil {
+		return nil, nil
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if len(r.rules) == 0 {
+		return nil, nil
+	}
+
+	details := routingDetailsForEvent(sourceName, event)
+
+	var out *notifier.Receivers
+	for _, rule := range r.rules {
+		matched, err := details.matches(rule.Match)
+		if err != nil {
+			return nil, fmt.Errorf("while matching routing rule %q: %w", rule.Name, err)
+		}
+		if !matched {
+			continue
+		}
+
+		if out == nil {
+			out = &notifier.Receivers{}
+		}
+		out.Channels = append(out.Channels, rule.Receivers.Channels...)
+		out.Sinks = append(out.Sinks, rule.Receivers.Sinks...)
+
+		if !rule.Continue {
+			break
+		}
+	}
+
+	return out, nil
+}
+
+// routingDetails holds event properties which the routing rules are matched against.
+type routingDetails struct {
+	source    string
+	level     string
+	namespace string
+	kind      string
+	labels    map[string]string
+}
+
+// routingDetailsForEvent extracts routing details from a given event. As sources don't share a common event schema,
+// it checks the well-known fields of the raw object. Labels set on the event take precedence over the raw object ones.
+func routingDetailsForEvent(sourceName string, event source.Event) routingDetails {
+	out := routingDetails{
+		source:    sourceName,
+		namespace: eventNamespace(event),
+		labels:    event.Labels,
+	}
+
+	obj, ok := event.RawObject.(map[string]any)
+	if !ok {
+		return out
+	}
+
+	out.level = stringField(obj, "Level", "level")
+	out.kind = stringField(obj, "Kind", "kind")
+
+	rawLabels, ok := obj["Labels"].(map[string]any)
+	if !ok {
+		if metadata, ok := obj["metadata"].(map[string]any); ok {
+			rawLabels, _ = metadata["labels"].(map[string]any)
+		}
+	}
+	if len(rawLabels) > 0 && out.labels == nil {
+		out.labels = make(map[string]string, len(rawLabels))
+		for key, val := range rawLabels {
+			out.labels[key], _ = val.(string)
+		}
+	}
+
+	return out
+}
+
+func (d routingDetails) matches(match config.RoutingMatch) (bool, error) {
+	if len(match.Sources) > 0 && !slices.Contains(match.Sources, d.source) {
+		return false, nil
+	}
+
+	if len(match.Levels) > 0 && !slices.ContainsFunc(match.Levels, func(lvl config.Level) bool {
+		return strings.EqualFold(string(lvl), d.level)
+	}) {
+		return false, nil
+	}
+
+	if len(match.Kinds) > 0 && !slices.ContainsFunc(match.Kinds, func(kind string) bool {
+		return strings.EqualFold(kind, d.kind)
+	}) {
+		return false, nil
+	}
+
+	for key, val := range match.Labels {
+		got, found := d.labels[key]
+		if !found || got != val {
+			return false, nil
+		}
+	}
+
+	if match.Namespaces.AreConstraintsDefined() {
+		allowed, err := match.Namespaces.IsAllowed(d.namespace)
+		if err != nil {
+			return false, err
+		}
+		if !allowed {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func stringField(obj map[string]any, keys ...string) string {
+	for _, key := range keys {
+		if val, ok := obj[key].(string); ok && val != "" {
+			return val
+		}
+	}
+	return ""
+}
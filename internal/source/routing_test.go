@@ -0,0 +1,194 @@
+package source
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/pkg/api/source"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/notifier"
+)
+
+func TestRouterRoute(t *testing.T) {
+	// given
+	router := NewRouter(config.Routing{
+		Rules: []config.RoutingRule{
+			{
+				Name: "critical",
+				Match: config.RoutingMatch{
+					Levels: []config.Level{config.Error},
+				},
+				Receivers: config.RoutingReceivers{
+					Channels: []string{"on-call"},
+					Sinks:    []string{"elasticsearch"},
+				},
+				Continue: true,
+			},
+			{
+				Name: "team-a",
+				Match: config.RoutingMatch{
+					Sources: []string{"k8s-all-events"},
+					Namespaces: config.RegexConstraints{
+						Include: []string{"team-a-.*"},
+					},
+				},
+				Receivers: config.RoutingReceivers{
+					Channels: []string{"team-a"},
+				},
+			},
+			{
+				Name: "team-b-pods",
+				Match: config.RoutingMatch{
+					Kinds: []string{"Pod"},
+					Labels: map[string]string{
+						"team": "b",
+					},
+				},
+				Receivers: config.RoutingReceivers{
+					Channels: []string{"team-b"},
+				},
+			},
+		},
+	})
+
+	tests := []struct {
+		name         string
+		sourceName   string
+		givenRawObj  any
+		givenLabels  map[string]string
+		expReceivers *notifier.Receivers
+	}{
+		{
+			name:       "Error event continues to next rules",
+			sourceName: "k8s-all-events",
+			givenRawObj: map[string]any{
+				"Level":     "error",
+				"Kind":      "Deployment",
+				"Namespace": "team-a-prod",
+			},
+			expReceivers: &notifier.Receivers{
+				Channels: []string{"on-call", "team-a"},
+				Sinks:    []string{"elasticsearch"},
+			},
+		},
+		{
+			name:       "First matching rule without continue stops evaluation",
+			sourceName: "k8s-all-events",
+			givenRawObj: map[string]any{
+				"Level":     "info",
+				"Kind":      "Pod",
+				"Namespace": "team-a-dev",
+				"Labels": map[string]any{
+					"team": "b",
+				},
+			},
+			expReceivers: &notifier.Receivers{
+				Channels: []string{"team-a"},
+			},
+		},
+		{
+			name:       "Labels from object metadata",
+			sourceName: "k8s-pods",
+			givenRawObj: map[string]any{
+				"kind": "Pod",
+				"metadata": map[string]any{
+					"namespace": "default",
+					"labels": map[string]any{
+						"team": "b",
+					},
+				},
+			},
+			expReceivers: &notifier.Receivers{
+				Channels: []string{"team-b"},
+			},
+		},
+		{
+			name:       "Labels set on event",
+			sourceName: "k8s-pods",
+			givenRawObj: map[string]any{
+				"Kind":      "Pod",
+				"Namespace": "default",
+			},
+			givenLabels: map[string]string{
+				"team": "b",
+			},
+			expReceivers: &notifier.Receivers{
+				Channels: []string{"team-b"},
+			},
+		},
+		{
+			name:       "No matching rule",
+			sourceName: "k8s-all-events",
+			givenRawObj: map[string]any{
+				"Level":     "info",
+				"Kind":      "Pod",
+				"Namespace": "default",
+			},
+			expReceivers: nil,
+		},
+		{
+			name:         "Unknown event schema",
+			sourceName:   "prometheus",
+			givenRawObj:  "alert",
+			expReceivers: nil,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			got, err := router.Route(tc.sourceName, source.Event{RawObject: tc.givenRawObj, Labels: tc.givenLabels})
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.expReceivers, got)
+		})
+	}
+}
+
+func TestRouterSetRouting(t *testing.T) {
+	// given
+	router := NewRouter(config.Routing{})
+	evt := source.Event{RawObject: map[string]any{"Namespace": "payments"}}
+
+	// when
+	before, err := router.Route("k8s-events", evt)
+	require.NoError(t, err)
+
+	router.SetRouting(config.Routing{
+		Rules: []config.RoutingRule{
+			{
+				Name:      "payments",
+				Match:     config.RoutingMatch{Sources: []string{"k8s-events"}},
+				Receivers: config.RoutingReceivers{Channels: []string{"team-payments"}},
+			},
+		},
+	})
+	after, err := router.Route("k8s-events", evt)
+	require.NoError(t, err)
+
+	// then
+	assert.Nil(t, before)
+	assert.Equal(t, &notifier.Receivers{Channels: []string{"team-payments"}}, after)
+}
+
+func TestReceiversAllowance(t *testing.T) {
+	// given
+	var notRouted *notifier.Receivers
+	routed := &notifier.Receivers{
+		Channels: []string{"team-a"},
+		Sinks:    []string{"webhook", "team-a/elasticsearch"},
+	}
+
+	// then
+	assert.True(t, notRouted.AllowsChannel("team-b"))
+	assert.True(t, notRouted.AllowsSink("default-group", config.ElasticsearchCommPlatformIntegration))
+
+	assert.True(t, routed.AllowsChannel("team-a"))
+	assert.False(t, routed.AllowsChannel("team-b"))
+	assert.True(t, routed.AllowsSink("default-group", config.WebhookCommPlatformIntegration))
+	assert.True(t, routed.AllowsSink("team-b", config.WebhookCommPlatformIntegration))
+	assert.True(t, routed.AllowsSink("team-a", config.ElasticsearchCommPlatformIntegration))
+	assert.False(t, routed.AllowsSink("team-b", config.ElasticsearchCommPlatformIntegration))
+}
@@ -3,6 +3,7 @@ package source
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"sync"
 
 	"github.com/sirupsen/logrus"
@@ -52,14 +53,17 @@ type StartedSource struct {
 
 // Scheduler analyzes the provided configuration and based on that schedules plugin sources.
 type Scheduler struct {
-	log            logrus.FieldLogger
-	cfg            *config.Config
-	dispatcher     pluginDispatcher
-	dispatchConfig map[string]map[string]PluginDispatch
-	schedulerChan  chan string
-
-	openedStreams        *openedStreams
+	log           logrus.FieldLogger
+	dispatcher    pluginDispatcher
+	schedulerChan chan string
+	openedStreams *openedStreams
+
+	// mu guards the fields below, which are replaced once the configuration is reloaded.
+	mu                   sync.RWMutex
+	cfg                  *config.Config
+	dispatchConfig       map[string]map[string]PluginDispatch
 	startedSourcePlugins map[string]StartedSources
+	streamCancels        map[string]context.CancelFunc
 }
 
 type openedStreams struct {
@@ -87,6 +91,12 @@ func (p *openedStreams) deleteAllStartedStreamsForPlugin(plugin string) {
 	delete(p.data, plugin)
 }
 
+func (p *openedStreams) deleteStartedStreamWithConfiguration(plugin, configuration string) {
+	p.Lock()
+	defer p.Unlock()
+	delete(p.data[plugin], configuration)
+}
+
 func (p *openedStreams) isStartedStreamWithConfiguration(plugin, configuration string) bool {
 	p.RLock()
 	defer p.RUnlock()
@@ -103,6 +113,7 @@ func NewScheduler(ctx context.Context, log logrus.FieldLogger, cfg *config.Confi
 		startedSourcePlugins: map[string]StartedSources{},
 		openedStreams:        &openedStreams{data: map[string]map[string]struct{}{}},
 		dispatchConfig:       make(map[string]map[string]PluginDispatch),
+		streamCancels:        make(map[string]context.CancelFunc),
 		schedulerChan:        schedulerChan,
 	}
 	go s.monitorHealth(ctx)
@@ -111,6 +122,9 @@ func NewScheduler(ctx context.Context, log logrus.FieldLogger, cfg *config.Confi
 
 // Start starts all sources and dispatch received events.
 func (d *Scheduler) Start(ctx context.Context) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
 	if err := d.generateConfigs(ctx); err != nil {
 		return fmt.Errorf("while generating configs for sources: %w", err)
 	}
@@ -132,13 +146,78 @@ func (d *Scheduler) monitorHealth(ctx context.Context) {
 			// 	   - k8s-all-events_interactive/true
 			//	   - k8s-all-events_interactive/false
 			d.openedStreams.deleteAllStartedStreamsForPlugin(pluginName)
+			d.mu.Lock()
 			if err := d.schedule(pluginName); err != nil {
 				d.log.Errorf("while scheduling %q: %s", pluginName, err)
 			}
+			d.mu.Unlock()
 		}
 	}
 }
 
+// Reload schedules sources for a new configuration. Streams with unchanged configuration keep running,
+// streams which are not used anymore or have a different configuration are stopped, and new ones are started.
+func (d *Scheduler) Reload(ctx context.Context, cfg *config.Config) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	currentCfg, currentDispatchConfig, currentStartedSources := d.cfg, d.dispatchConfig, d.startedSourcePlugins
+	d.cfg = cfg
+	d.dispatchConfig = make(map[string]map[string]PluginDispatch)
+	d.startedSourcePlugins = map[string]StartedSources{}
+	if err := d.generateConfigs(ctx); err != nil {
+		d.cfg, d.dispatchConfig, d.startedSourcePlugins = currentCfg, currentDispatchConfig, currentStartedSources
+		return fmt.Errorf("while generating configs for sources: %w", err)
+	}
+
+	for configKey, sourceConfig := range currentDispatchConfig {
+		for pluginName, current := range sourceConfig {
+			updated, found := d.dispatchConfig[configKey][pluginName]
+			if found && isSameDispatch(current, updated) {
+				continue
+			}
+
+			d.log.Infof("Stopping stream for plugin %q as its configuration changed.", pluginName)
+			d.stopStream(pluginName, configKey)
+		}
+	}
+
+	if err := d.schedule(emptyPluginFilter); err != nil {
+		return fmt.Errorf("while scheduling source dispatch: %w", err)
+	}
+	return nil
+}
+
+// isSameDispatch returns true if both dispatches stream the same events.
+func isSameDispatch(a, b PluginDispatch) bool {
+	return a.sourceDisplayName == b.sourceDisplayName &&
+		a.cfg.Settings.ClusterName == b.cfg.Settings.ClusterName &&
+		string(a.pluginConfig.RawYAML) == string(b.pluginConfig.RawYAML) &&
+		reflect.DeepEqual(a.pluginContext, b.pluginContext) &&
+		a.incomingWebhook == b.incomingWebhook
+}
+
+func (d *Scheduler) stopStream(pluginName, configKey string) {
+	key := streamKey(pluginName, configKey)
+	if cancel, found := d.streamCancels[key]; found {
+		cancel()
+		delete(d.streamCancels, key)
+	}
+	d.openedStreams.deleteStartedStreamWithConfiguration(pluginName, configKey)
+}
+
+func streamKey(pluginName, configKey string) string {
+	return fmt.Sprintf("%s/%s", configKey, pluginName)
+}
+
+// GetStartedSources returns source plugins started for a given source.
+func (d *Scheduler) GetStartedSources(sourceName string) (StartedSources, bool) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	sources, found := d.startedSourcePlugins[sourceName]
+	return sources, found
+}
+
 func (d *Scheduler) schedule(pluginFilter string) error {
 	for configKey, sourceConfig := range d.dispatchConfig {
 		for pluginName, config := range sourceConfig {
@@ -153,6 +232,14 @@ func (d *Scheduler) schedule(pluginFilter string) error {
 			}
 
 			d.log.Infof("Starting a new stream for plugin %q", pluginName)
+			// each stream can be stopped separately once the configuration is reloaded
+			key := streamKey(pluginName, configKey)
+			if cancel, found := d.streamCancels[key]; found {
+				cancel()
+			}
+			streamCtx, cancel := context.WithCancel(config.ctx)
+			d.streamCancels[key] = cancel
+			config.ctx = streamCtx
 			if err := d.dispatcher.Dispatch(config); err != nil {
 				return fmt.Errorf("while starting plugin source %s: %w", pluginName, err)
 			}
@@ -304,7 +391,3 @@ func (d *Scheduler) generatePluginConfig(ctx context.Context, isInteractivitySup
 	}
 	return nil
 }
-
-func (d *Scheduler) StartedSourcePlugins() map[string]StartedSources {
-	return d.startedSourcePlugins
-}
@@ -109,3 +109,57 @@ type fakeDispatcherFunc func(ctx context.Context, isInteractivitySupported bool,
 func (f fakeDispatcherFunc) Dispatch(dispatch PluginDispatch) error {
 	return f(dispatch.ctx, dispatch.isInteractivitySupported, dispatch.pluginName, dispatch.pluginConfig, []string{dispatch.sourceName})
 }
+
+func TestSchedulerReload(t *testing.T) {
+	// given
+	fixCfg := func(url string) *config.Config {
+		cfg, _, err := config.LoadWithDefaults(config.YAMLFiles{[]byte(fmt.Sprintf(`
+sources:
+  'keptn':
+    botkube/keptn@v1.0.0:
+      enabled: true
+      config:
+        url: '%s'
+communications:
+  default-group:
+    discord:
+      enabled: true
+      channels:
+        all:
+          id: all
+          bindings:
+            sources:
+              - 'keptn'
+`, url))})
+		require.NoError(t, err)
+		return cfg
+	}
+
+	var streamCtxs []context.Context
+	dispatcher := fakeDispatcherFunc(func(ctx context.Context, _ bool, _ string, _ *source.Config, _ []string) error {
+		streamCtxs = append(streamCtxs, ctx)
+		return nil
+	})
+
+	scheduler := NewScheduler(context.Background(), loggerx.NewNoop(), fixCfg("keptn.local"), dispatcher, make(chan string))
+	err := scheduler.Start(context.Background())
+	require.NoError(t, err)
+	require.Len(t, streamCtxs, 1)
+
+	// when the configuration is the same
+	err = scheduler.Reload(context.Background(), fixCfg("keptn.local"))
+
+	// then the stream keeps running
+	require.NoError(t, err)
+	require.Len(t, streamCtxs, 1)
+	assert.NoError(t, streamCtxs[0].Err())
+
+	// when the source configuration changed
+	err = scheduler.Reload(context.Background(), fixCfg("keptn-eu.local"))
+
+	// then the stream is restarted
+	require.NoError(t, err)
+	require.Len(t, streamCtxs, 2)
+	assert.ErrorIs(t, streamCtxs[0].Err(), context.Canceled)
+	assert.NoError(t, streamCtxs[1].Err())
+}
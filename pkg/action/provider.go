@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"html/template"
 	"strings"
+	"sync"
 
 	sprig "github.com/go-task/slim-sprig"
 	"github.com/sirupsen/logrus"
@@ -40,8 +41,10 @@ type ExecutorFactory interface {
 // Provider provides automations for events.
 type Provider struct {
 	log             logrus.FieldLogger
-	cfg             config.Actions
 	executorFactory ExecutorFactory
+
+	mu  sync.RWMutex
+	cfg config.Actions
 }
 
 // NewProvider returns new instance of Provider.
@@ -49,8 +52,18 @@ func NewProvider(log logrus.FieldLogger, cfg config.Actions, executorFactory Exe
 	return &Provider{log: log, cfg: cfg, executorFactory: executorFactory}
 }
 
+// SetActions replaces the actions, so the configuration changes are applied without restart.
+func (p *Provider) SetActions(cfg config.Actions) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.cfg = cfg
+}
+
 // RenderedActions finds and processes actions for given data.
 func (p *Provider) RenderedActions(e any, sourceBindings []string) ([]Action, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
 	var actions []Action
 	errs := multierror.New()
 	for _, action := range p.cfg {
@@ -20,9 +20,10 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Executor_Execute_FullMethodName  = "/executor.Executor/Execute"
-	Executor_Metadata_FullMethodName = "/executor.Executor/Metadata"
-	Executor_Help_FullMethodName     = "/executor.Executor/Help"
+	Executor_Execute_FullMethodName       = "/executor.Executor/Execute"
+	Executor_ExecuteStream_FullMethodName = "/executor.Executor/ExecuteStream"
+	Executor_Metadata_FullMethodName      = "/executor.Executor/Metadata"
+	Executor_Help_FullMethodName          = "/executor.Executor/Help"
 )
 
 // ExecutorClient is the client API for Executor service.
@@ -30,6 +31,7 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ExecutorClient interface {
 	Execute(ctx context.Context, in *ExecuteRequest, opts ...grpc.CallOption) (*ExecuteResponse, error)
+	ExecuteStream(ctx context.Context, in *ExecuteRequest, opts ...grpc.CallOption) (Executor_ExecuteStreamClient, error)
 	Metadata(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*MetadataResponse, error)
 	Help(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*HelpResponse, error)
 }
@@ -51,6 +53,38 @@ func (c *executorClient) Execute(ctx context.Context, in *ExecuteRequest, opts .
 	return out, nil
 }
 
+func (c *executorClient) ExecuteStream(ctx context.Context, in *ExecuteRequest, opts ...grpc.CallOption) (Executor_ExecuteStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Executor_ServiceDesc.Streams[0], Executor_ExecuteStream_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &executorExecuteStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Executor_ExecuteStreamClient interface {
+	Recv() (*ExecuteStreamResponse, error)
+	grpc.ClientStream
+}
+
+type executorExecuteStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *executorExecuteStreamClient) Recv() (*ExecuteStreamResponse, error) {
+	m := new(ExecuteStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *executorClient) Metadata(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*MetadataResponse, error) {
 	out := new(MetadataResponse)
 	err := c.cc.Invoke(ctx, Executor_Metadata_FullMethodName, in, out, opts...)
@@ -74,6 +108,7 @@ func (c *executorClient) Help(ctx context.Context, in *emptypb.Empty, opts ...gr
 // for forward compatibility
 type ExecutorServer interface {
 	Execute(context.Context, *ExecuteRequest) (*ExecuteResponse, error)
+	ExecuteStream(*ExecuteRequest, Executor_ExecuteStreamServer) error
 	Metadata(context.Context, *emptypb.Empty) (*MetadataResponse, error)
 	Help(context.Context, *emptypb.Empty) (*HelpResponse, error)
 	mustEmbedUnimplementedExecutorServer()
@@ -86,6 +121,9 @@ type UnimplementedExecutorServer struct {
 func (UnimplementedExecutorServer) Execute(context.Context, *ExecuteRequest) (*ExecuteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Execute not implemented")
 }
+func (UnimplementedExecutorServer) ExecuteStream(*ExecuteRequest, Executor_ExecuteStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExecuteStream not implemented")
+}
 func (UnimplementedExecutorServer) Metadata(context.Context, *emptypb.Empty) (*MetadataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Metadata not implemented")
 }
@@ -123,6 +161,27 @@ func _Executor_Execute_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Executor_ExecuteStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExecuteRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExecutorServer).ExecuteStream(m, &executorExecuteStreamServer{stream})
+}
+
+type Executor_ExecuteStreamServer interface {
+	Send(*ExecuteStreamResponse) error
+	grpc.ServerStream
+}
+
+type executorExecuteStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *executorExecuteStreamServer) Send(m *ExecuteStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Executor_Metadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -179,6 +238,12 @@ var Executor_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _Executor_Help_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExecuteStream",
+			Handler:       _Executor_ExecuteStream_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "executor.proto",
 }
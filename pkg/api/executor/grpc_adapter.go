@@ -3,11 +3,15 @@ package executor
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 
 	"github.com/hashicorp/go-plugin"
 	"github.com/slack-go/slack"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/kubeshop/botkube/pkg/api"
@@ -20,6 +24,16 @@ type Executor interface {
 	Help(context.Context) (api.Message, error)
 }
 
+// StreamExecutor is an optional interface implemented by executors which stream the output of long-running commands,
+// such as `kubectl logs -f`. The output of executors which don't implement it is sent in a single response.
+type StreamExecutor interface {
+	ExecuteStream(context.Context, ExecuteInput, SendOutputFn) error
+}
+
+// SendOutputFn sends the output produced since the previous call.
+// It returns an error if the output cannot be delivered, for example, when the user stopped the command.
+type SendOutputFn func(ExecuteOutput) error
+
 type (
 	// ExecuteInput holds the input of the Execute function.
 	ExecuteInput struct {
@@ -66,7 +80,10 @@ type (
 // a compatible version between client and server. If this is set, Handshake.ProtocolVersion is not required.
 const ProtocolVersion = 2
 
-var _ plugin.GRPCPlugin = &Plugin{}
+var (
+	_ plugin.GRPCPlugin = &Plugin{}
+	_ StreamExecutor    = &grpcClient{}
+)
 
 // Plugin This is the implementation of plugin.GRPCPlugin, so we can serve and consume different Botkube Executors.
 type Plugin struct {
@@ -97,21 +114,9 @@ type grpcClient struct {
 }
 
 func (p *grpcClient) Execute(ctx context.Context, in ExecuteInput) (ExecuteOutput, error) {
-	grpcInput := &ExecuteRequest{
-		Command: in.Command,
-		Configs: in.Configs,
-		Context: &ExecuteContext{
-			IsInteractivitySupported: in.Context.IsInteractivitySupported,
-			KubeConfig:               in.Context.KubeConfig,
-		},
-	}
-
-	if in.Context.IsInteractivitySupported && in.Context.SlackState != nil {
-		rawState, err := json.Marshal(in.Context.SlackState)
-		if err != nil {
-			return ExecuteOutput{}, fmt.Errorf("while marshaling slack state: %w", err)
-		}
-		grpcInput.Context.SlackState = rawState
+	grpcInput, err := executeRequestToGRPC(in)
+	if err != nil {
+		return ExecuteOutput{}, err
 	}
 
 	res, err := p.client.Execute(ctx, grpcInput)
@@ -119,11 +124,9 @@ func (p *grpcClient) Execute(ctx context.Context, in ExecuteInput) (ExecuteOutpu
 		return ExecuteOutput{}, err
 	}
 
-	var msg api.Message
-	if len(res.Message) != 0 && string(res.Message) != "" {
-		if err := json.Unmarshal(res.Message, &msg); err != nil {
-			return ExecuteOutput{}, fmt.Errorf("while unmarshalling message from JSON: %w", err)
-		}
+	msg, err := unmarshalMessage(res.Message)
+	if err != nil {
+		return ExecuteOutput{}, err
 	}
 
 	return ExecuteOutput{
@@ -131,6 +134,49 @@ func (p *grpcClient) Execute(ctx context.Context, in ExecuteInput) (ExecuteOutpu
 	}, nil
 }
 
+// ExecuteStream calls a given function with each output streamed by the plugin. It blocks until the stream is finished.
+func (p *grpcClient) ExecuteStream(ctx context.Context, in ExecuteInput, send SendOutputFn) error {
+	grpcInput, err := executeRequestToGRPC(in)
+	if err != nil {
+		return err
+	}
+
+	stream, err := p.client.ExecuteStream(ctx, grpcInput)
+	if err != nil {
+		return err
+	}
+
+	received := false
+	for {
+		// RecvMsg blocks until it receives a message into m or the stream is
+		// done. It returns io.EOF when the stream completes successfully.
+		res, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if !received && status.Code(err) == codes.Unimplemented {
+			// the plugin was built with an older API version, which doesn't support streaming
+			out, err := p.Execute(ctx, in)
+			if err != nil {
+				return err
+			}
+			return send(out)
+		}
+		if err != nil {
+			return err
+		}
+		received = true
+
+		msg, err := unmarshalMessage(res.Message)
+		if err != nil {
+			return err
+		}
+		if err := send(ExecuteOutput{Message: msg}); err != nil {
+			return err
+		}
+	}
+}
+
 func (p *grpcClient) Metadata(ctx context.Context) (api.MetadataOutput, error) {
 	resp, err := p.client.Metadata(ctx, &emptypb.Empty{})
 	if err != nil {
@@ -166,22 +212,12 @@ type grpcServer struct {
 }
 
 func (p *grpcServer) Execute(ctx context.Context, request *ExecuteRequest) (*ExecuteResponse, error) {
-	var slackState slack.BlockActionStates
-	if request.Context != nil && request.Context.SlackState != nil {
-		if err := json.Unmarshal(request.Context.SlackState, &slackState); err != nil {
-			return nil, fmt.Errorf("while unmarshalling slack state from JSON: %w", err)
-		}
+	in, err := executeRequestFromGRPC(request)
+	if err != nil {
+		return nil, err
 	}
 
-	out, err := p.Impl.Execute(ctx, ExecuteInput{
-		Command: request.Command,
-		Configs: request.Configs,
-		Context: ExecuteInputContext{
-			SlackState:               &slackState,
-			IsInteractivitySupported: request.Context.IsInteractivitySupported,
-			KubeConfig:               request.Context.KubeConfig,
-		},
-	})
+	out, err := p.Impl.Execute(ctx, in)
 	if err != nil {
 		return nil, err
 	}
@@ -195,6 +231,37 @@ func (p *grpcServer) Execute(ctx context.Context, request *ExecuteRequest) (*Exe
 	}, nil
 }
 
+func (p *grpcServer) ExecuteStream(request *ExecuteRequest, gstream Executor_ExecuteStreamServer) error {
+	// the context is canceled when the client stops the stream
+	ctx := gstream.Context()
+
+	in, err := executeRequestFromGRPC(request)
+	if err != nil {
+		return err
+	}
+
+	send := func(out ExecuteOutput) error {
+		marshalled, err := json.Marshal(out.Message)
+		if err != nil {
+			return fmt.Errorf("while marshalling message to JSON: %w", err)
+		}
+		return gstream.Send(&ExecuteStreamResponse{
+			Message: marshalled,
+		})
+	}
+
+	streamer, ok := p.Impl.(StreamExecutor)
+	if !ok {
+		out, err := p.Impl.Execute(ctx, in)
+		if err != nil {
+			return err
+		}
+		return send(out)
+	}
+
+	return streamer.ExecuteStream(ctx, in, send)
+}
+
 func (p *grpcServer) Metadata(ctx context.Context, _ *emptypb.Empty) (*MetadataResponse, error) {
 	meta, err := p.Impl.Metadata(ctx)
 	if err != nil {
@@ -225,6 +292,55 @@ func (p *grpcServer) Help(ctx context.Context, _ *emptypb.Empty) (*HelpResponse,
 	}, nil
 }
 
+func executeRequestToGRPC(in ExecuteInput) (*ExecuteRequest, error) {
+	grpcInput := &ExecuteRequest{
+		Command: in.Command,
+		Configs: in.Configs,
+		Context: &ExecuteContext{
+			IsInteractivitySupported: in.Context.IsInteractivitySupported,
+			KubeConfig:               in.Context.KubeConfig,
+		},
+	}
+
+	if in.Context.IsInteractivitySupported && in.Context.SlackState != nil {
+		rawState, err := json.Marshal(in.Context.SlackState)
+		if err != nil {
+			return nil, fmt.Errorf("while marshaling slack state: %w", err)
+		}
+		grpcInput.Context.SlackState = rawState
+	}
+	return grpcInput, nil
+}
+
+func executeRequestFromGRPC(request *ExecuteRequest) (ExecuteInput, error) {
+	var slackState slack.BlockActionStates
+	if request.Context != nil && request.Context.SlackState != nil {
+		if err := json.Unmarshal(request.Context.SlackState, &slackState); err != nil {
+			return ExecuteInput{}, fmt.Errorf("while unmarshalling slack state from JSON: %w", err)
+		}
+	}
+
+	return ExecuteInput{
+		Command: request.Command,
+		Configs: request.Configs,
+		Context: ExecuteInputContext{
+			SlackState:               &slackState,
+			IsInteractivitySupported: request.Context.IsInteractivitySupported,
+			KubeConfig:               request.Context.KubeConfig,
+		},
+	}, nil
+}
+
+func unmarshalMessage(raw []byte) (api.Message, error) {
+	var msg api.Message
+	if len(raw) != 0 && string(raw) != "" {
+		if err := json.Unmarshal(raw, &msg); err != nil {
+			return api.Message{}, fmt.Errorf("while unmarshalling message from JSON: %w", err)
+		}
+	}
+	return msg, nil
+}
+
 // Serve serves given plugins.
 func Serve(p map[string]plugin.Plugin) {
 	plugin.Serve(&plugin.ServeConfig{
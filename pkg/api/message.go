@@ -54,6 +54,19 @@ type Message struct {
 	PlaintextInputs   LabelInputs `json:"plaintextInputs,omitempty"`
 	OnlyVisibleForYou bool        `json:"onlyVisibleForYou,omitempty"`
 	ReplaceOriginal   bool        `json:"replaceOriginal,omitempty"`
+	// ReplyInThread makes the message posted as a reply in the thread of the message it responds to, if supported by the platform.
+	ReplyInThread bool `json:"replyInThread,omitempty"`
+	// Attachments are uploaded as files together with the message, e.g. a large command output or a generated diagram.
+	Attachments []Attachment `json:"attachments,omitempty"`
+}
+
+// Attachment represents a file sent together with a message.
+type Attachment struct {
+	// Name is the file name shown on communication platforms, e.g. "pods.yaml".
+	Name string `json:"name"`
+	// ContentType is the MIME type of the file, e.g. "text/plain" or "image/png".
+	ContentType string `json:"contentType,omitempty"`
+	Data        []byte `json:"data"`
 }
 
 func (msg *Message) IsEmpty() bool {
@@ -70,6 +83,9 @@ func (msg *Message) IsEmpty() bool {
 	if !msg.Timestamp.IsZero() {
 		return false
 	}
+	if msg.HasAttachments() {
+		return false
+	}
 
 	return true
 }
@@ -79,6 +95,11 @@ func (msg *Message) HasSections() bool {
 	return len(msg.Sections) != 0
 }
 
+// HasAttachments returns true if message has files attached.
+func (msg *Message) HasAttachments() bool {
+	return len(msg.Attachments) != 0
+}
+
 // HasInputs returns true if message has interactive inputs.
 func (msg *Message) HasInputs() bool {
 	return len(msg.PlaintextInputs) != 0
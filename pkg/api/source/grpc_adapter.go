@@ -95,8 +95,11 @@ type (
 	}
 
 	Event struct {
-		Message         api.Message
-		RawObject       any
+		Message   api.Message
+		RawObject any
+		// Labels of the event object, which are matched by the routing rules. They are not part of RawObject,
+		// as it is sent to sinks, where a dynamic set of labels could blow up the index mappings.
+		Labels          map[string]string `json:",omitempty"`
 		AnalyticsLabels map[string]interface{}
 	}
 )
@@ -19,6 +19,13 @@ type Bot interface {
 	notifier.Bot
 }
 
+// BindingsUpdater is implemented by bots which can update channel bindings without restart.
+type BindingsUpdater interface {
+	// UpdateBindings replaces bindings of the configured channels with the ones from a given configuration.
+	// Channels are matched by their alias. Channels which are not configured yet are ignored.
+	UpdateBindings(cfg config.Communications)
+}
+
 // ExecutorFactory facilitates creation of execute.Executor instances.
 type ExecutorFactory interface {
 	NewDefault(cfg execute.NewDefaultInput) execute.Executor
@@ -56,6 +63,44 @@ type channelConfigByName struct {
 	notify bool
 }
 
+func notificationsByName(channels map[string]channelConfigByName) map[string]config.ChannelNotification {
+	out := make(map[string]config.ChannelNotification, len(channels))
+	for identifier, channel := range channels {
+		out[identifier] = channel.Notification
+	}
+	return out
+}
+
+func notificationsByID(channels map[string]channelConfigByID) map[string]config.ChannelNotification {
+	out := make(map[string]config.ChannelNotification, len(channels))
+	for identifier, channel := range channels {
+		out[identifier] = channel.Notification
+	}
+	return out
+}
+
+func channelsByNameWithBindings(channels map[string]channelConfigByName, cfg config.IdentifiableMap[config.ChannelBindingsByName]) map[string]channelConfigByName {
+	out := make(map[string]channelConfigByName, len(channels))
+	for identifier, channel := range channels {
+		if updated, found := cfg[channel.alias]; found {
+			channel.Bindings = updated.Bindings
+		}
+		out[identifier] = channel
+	}
+	return out
+}
+
+func channelsByIDWithBindings(channels map[string]channelConfigByID, cfg config.IdentifiableMap[config.ChannelBindingsByName]) map[string]channelConfigByID {
+	out := make(map[string]channelConfigByID, len(channels))
+	for identifier, channel := range channels {
+		if updated, found := cfg[channel.alias]; found {
+			channel.Bindings = updated.Bindings
+		}
+		out[identifier] = channel
+	}
+	return out
+}
+
 func AsNotifiers(bots map[string]Bot) []notifier.Bot {
 	notifiers := make([]notifier.Bot, 0, len(bots))
 	for _, bot := range bots {
@@ -0,0 +1,420 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+const (
+	digestCheckInterval   = 30 * time.Second
+	digestPersistInterval = 10 * time.Second
+
+	// digestMaxEntries limits the number of buffered notifications per channel to keep the persisted state reasonably small.
+	digestMaxEntries = 500
+	// digestMaxGroups limits the number of groups rendered in a single digest message.
+	digestMaxGroups = 10
+)
+
+// DigestPersistenceManager persists notifications buffered for channels in the digest mode.
+type DigestPersistenceManager interface {
+	PersistDigest(ctx context.Context, key string, digest config.DigestRuntimeState) error
+	GetDigests(ctx context.Context) (map[string]config.DigestRuntimeState, error)
+}
+
+type digestSendFn func(ctx context.Context, channel string, msg interactive.CoreMessage) error
+
+// notificationDigest buffers notifications for channels in the digest mode and periodically sends a single summary message per channel.
+type notificationDigest struct {
+	log           logrus.FieldLogger
+	cfgManager    DigestPersistenceManager
+	commGroupName string
+	platform      config.CommPlatformIntegration
+	now           func() time.Time
+
+	// dynamicChannels is set for platforms where channels are not known upfront, such as MS Teams.
+	// Channels are then added with AddChannel once they are registered.
+	dynamicChannels bool
+
+	mu       sync.Mutex
+	channels map[string]config.ChannelNotification
+	buffers  map[string]*digestBuffer
+}
+
+type digestBuffer struct {
+	config.DigestRuntimeState
+	dirty bool
+}
+
+func newNotificationDigest(log logrus.FieldLogger, cfgManager DigestPersistenceManager, commGroupName string, platform config.CommPlatformIntegration, channels map[string]config.ChannelNotification) *notificationDigest {
+	digestChannels := make(map[string]config.ChannelNotification)
+	for name, notification := range channels {
+		if !notification.IsDigest() {
+			continue
+		}
+		digestChannels[name] = notification
+	}
+
+	return &notificationDigest{
+		log:           log.WithField("component", "Notification Digest"),
+		cfgManager:    cfgManager,
+		commGroupName: commGroupName,
+		platform:      platform,
+		channels:      digestChannels,
+		now:           time.Now,
+		buffers:       make(map[string]*digestBuffer),
+	}
+}
+
+// newDynamicNotificationDigest returns a digest for platforms where channels are registered at runtime.
+func newDynamicNotificationDigest(log logrus.FieldLogger, cfgManager DigestPersistenceManager, commGroupName string, platform config.CommPlatformIntegration) *notificationDigest {
+	digest := newNotificationDigest(log, cfgManager, commGroupName, platform, nil)
+	digest.dynamicChannels = true
+	return digest
+}
+
+// AddChannel registers a channel which is not known upfront. It is a no-op if the channel is not in the digest mode.
+func (d *notificationDigest) AddChannel(channel string, notification config.ChannelNotification) {
+	if !notification.IsDigest() {
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.channels[channel] = notification
+}
+
+// Add buffers a given message if a channel is in the digest mode. Returns false if the message should be sent right away.
+func (d *notificationDigest) Add(channel string, msg interactive.CoreMessage, sourceBindings []string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if _, found := d.channels[channel]; !found {
+		return false
+	}
+
+	buff := d.bufferFor(channel)
+	buff.Entries = append(buff.Entries, digestEntryFromMessage(msg, sourceBindings, d.now()))
+	if len(buff.Entries) > digestMaxEntries {
+		overflow := len(buff.Entries) - digestMaxEntries
+		buff.Entries = buff.Entries[overflow:]
+		buff.Dropped += overflow
+	}
+	buff.dirty = true
+	return true
+}
+
+// Run restores persisted digests and sends them periodically until the context is canceled.
+func (d *notificationDigest) Run(ctx context.Context, send digestSendFn) {
+	d.mu.Lock()
+	noChannels := len(d.channels) == 0 && !d.dynamicChannels
+	d.mu.Unlock()
+	if noChannels {
+		return
+	}
+
+	d.restore(ctx)
+
+	checkTicker := time.NewTicker(digestCheckInterval)
+	defer checkTicker.Stop()
+	persistTicker := time.NewTicker(digestPersistInterval)
+	defer persistTicker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			// use a new context, as the parent one is already canceled
+			persistCtx, cancel := context.WithTimeout(context.Background(), digestPersistInterval)
+			d.persist(persistCtx)
+			cancel()
+			return
+		case <-checkTicker.C:
+			d.sendDue(ctx, send)
+		case <-persistTicker.C:
+			d.persist(ctx)
+		}
+	}
+}
+
+func (d *notificationDigest) restore(ctx context.Context) {
+	if d.cfgManager == nil {
+		return
+	}
+
+	digests, err := d.cfgManager.GetDigests(ctx)
+	if err != nil {
+		d.log.WithError(err).Error("Failed to restore persisted digests. Starting with empty ones.")
+		return
+	}
+
+	prefix := d.key("")
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for key, state := range digests {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		channel := strings.TrimPrefix(key, prefix)
+		if _, found := d.channels[channel]; !found && !d.dynamicChannels {
+			continue
+		}
+		buff := d.bufferFor(channel)
+		buff.LastSentAt = state.LastSentAt
+		buff.Dropped += state.Dropped
+		buff.Entries = append(state.Entries, buff.Entries...)
+	}
+}
+
+func (d *notificationDigest) sendDue(ctx context.Context, send digestSendFn) {
+	d.mu.Lock()
+	channels := make(map[string]config.ChannelNotification, len(d.channels))
+	for channel, notification := range d.channels {
+		channels[channel] = notification
+	}
+	d.mu.Unlock()
+
+	now := d.now()
+	for channel, notification := range channels {
+		msg, popped, ok := d.popIfDue(channel, notification.Digest, now)
+		if !ok {
+			continue
+		}
+
+		if err := send(ctx, channel, msg); err != nil {
+			d.log.WithError(err).Errorf("Failed to send digest to channel %q. It will be retried.", channel)
+			d.requeue(channel, popped)
+		}
+	}
+}
+
+// popIfDue returns the digest message if the interval elapsed, together with the state removed from the buffer,
+// so it can be requeued if the message cannot be sent.
+func (d *notificationDigest) popIfDue(channel string, cfg config.DigestNotification, now time.Time) (interactive.CoreMessage, config.DigestRuntimeState, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	buff := d.bufferFor(channel)
+	if buff.LastSentAt.IsZero() {
+		// start counting the interval from the first observation
+		buff.LastSentAt = now
+		buff.dirty = true
+	}
+
+	interval := cfg.Interval
+	if interval <= 0 {
+		interval = config.DefaultDigestInterval
+	}
+	if now.Sub(buff.LastSentAt) < interval {
+		return interactive.CoreMessage{}, config.DigestRuntimeState{}, false
+	}
+
+	if len(buff.Entries) == 0 {
+		buff.LastSentAt = now
+		return interactive.CoreMessage{}, config.DigestRuntimeState{}, false
+	}
+
+	popped := buff.DigestRuntimeState
+	buff.Entries, buff.Dropped, buff.LastSentAt = nil, 0, now
+	buff.dirty = true
+
+	maxDetails := cfg.MaxDetails
+	if maxDetails <= 0 {
+		maxDetails = config.DefaultDigestMaxDetails
+	}
+	return renderDigest(popped.Entries, popped.Dropped, popped.LastSentAt, maxDetails), popped, true
+}
+
+// requeue puts back the state returned by popIfDue in front of the notifications buffered in the meantime.
+func (d *notificationDigest) requeue(channel string, popped config.DigestRuntimeState) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	buff := d.bufferFor(channel)
+	buff.Entries = append(popped.Entries, buff.Entries...)
+	buff.Dropped += popped.Dropped
+	buff.LastSentAt = popped.LastSentAt
+	if len(buff.Entries) > digestMaxEntries {
+		overflow := len(buff.Entries) - digestMaxEntries
+		buff.Entries = buff.Entries[overflow:]
+		buff.Dropped += overflow
+	}
+	buff.dirty = true
+}
+
+func (d *notificationDigest) persist(ctx context.Context) {
+	if d.cfgManager == nil {
+		return
+	}
+
+	d.mu.Lock()
+	toPersist := make(map[string]config.DigestRuntimeState)
+	for channel, buff := range d.buffers {
+		if !buff.dirty {
+			continue
+		}
+		toPersist[channel] = buff.DigestRuntimeState
+		buff.dirty = false
+	}
+	d.mu.Unlock()
+
+	for channel, state := range toPersist {
+		if err := d.cfgManager.PersistDigest(ctx, d.key(channel), state); err != nil {
+			d.log.WithError(err).Errorf("Failed to persist digest for channel %q. Retrying later...", channel)
+			d.markDirty(channel)
+		}
+	}
+}
+
+func (d *notificationDigest) markDirty(channel string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.bufferFor(channel).dirty = true
+}
+
+// bufferFor returns a buffer for a given channel. It must be called with the mutex held.
+func (d *notificationDigest) bufferFor(channel string) *digestBuffer {
+	buff, found := d.buffers[channel]
+	if !found {
+		buff = &digestBuffer{}
+		d.buffers[channel] = buff
+	}
+	return buff
+}
+
+func (d *notificationDigest) key(channel string) string {
+	return config.DigestKey(d.commGroupName, d.platform, channel)
+}
+
+func digestEntryFromMessage(msg interactive.CoreMessage, sourceBindings []string, now time.Time) config.DigestEntry {
+	entry := config.DigestEntry{
+		Source:    strings.Join(sourceBindings, ", "),
+		Timestamp: now,
+		Summary:   msg.BaseBody.Plaintext,
+	}
+	if !msg.Timestamp.IsZero() {
+		entry.Timestamp = msg.Timestamp
+	}
+
+	if len(msg.Sections) == 0 {
+		return entry
+	}
+
+	section := msg.Sections[0]
+	var name string
+	for _, field := range section.TextFields {
+		switch field.Key {
+		case "Kind":
+			entry.Kind = field.Value
+		case "Namespace":
+			entry.Namespace = field.Value
+		case "Name":
+			name = field.Value
+		}
+	}
+
+	switch {
+	case section.Header != "" && name != "":
+		entry.Summary = fmt.Sprintf("%s: %s", section.Header, name)
+	case section.Header != "":
+		entry.Summary = section.Header
+	case entry.Summary == "":
+		entry.Summary = section.Base.Body.Plaintext
+	}
+	return entry
+}
+
+type digestGroup struct {
+	source, namespace, kind string
+	entries                 []config.DigestEntry
+}
+
+func renderDigest(entries []config.DigestEntry, dropped int, since time.Time, maxDetails int) interactive.CoreMessage {
+	index := map[string]*digestGroup{}
+	var groups []*digestGroup
+	for _, entry := range entries {
+		key := strings.Join([]string{entry.Source, entry.Namespace, entry.Kind}, "/")
+		group, found := index[key]
+		if !found {
+			group = &digestGroup{source: entry.Source, namespace: entry.Namespace, kind: entry.Kind}
+			index[key] = group
+			groups = append(groups, group)
+		}
+		group.entries = append(group.entries, entry)
+	}
+
+	sort.SliceStable(groups, func(i, j int) bool {
+		return len(groups[i].entries) > len(groups[j].entries)
+	})
+
+	total := len(entries) + dropped
+	msg := api.Message{
+		Sections: []api.Section{
+			{
+				Base: api.Base{
+					Header:      fmt.Sprintf("📬 Notifications digest: %d event(s) since %s", total, since.Format(time.RFC1123)),
+					Description: fmt.Sprintf("Grouped by source, namespace and kind. Showing up to %d latest event(s) per group.", maxDetails),
+				},
+			},
+		},
+	}
+
+	for idx, group := range groups {
+		if idx >= digestMaxGroups {
+			msg.Sections = append(msg.Sections, api.Section{
+				Context: api.ContextItems{
+					{Text: fmt.Sprintf("...and %d more group(s)", len(groups)-digestMaxGroups)},
+				},
+			})
+			break
+		}
+
+		section := api.Section{
+			TextFields: api.TextFields{
+				{Key: "Source", Value: group.source},
+				{Key: "Count", Value: fmt.Sprintf("%d", len(group.entries))},
+			},
+		}
+		if group.namespace != "" {
+			section.TextFields = append(section.TextFields, api.TextField{Key: "Namespace", Value: group.namespace})
+		}
+		if group.kind != "" {
+			section.TextFields = append(section.TextFields, api.TextField{Key: "Kind", Value: group.kind})
+		}
+
+		latest := group.entries
+		if len(latest) > maxDetails {
+			latest = latest[len(latest)-maxDetails:]
+		}
+		var items []string
+		for i := len(latest) - 1; i >= 0; i-- {
+			items = append(items, fmt.Sprintf("%s (%s)", latest[i].Summary, latest[i].Timestamp.Format(time.Kitchen)))
+		}
+		section.BulletLists = api.BulletLists{
+			{Title: "Latest events", Items: items},
+		}
+
+		msg.Sections = append(msg.Sections, section)
+	}
+
+	if dropped > 0 {
+		msg.Sections = append(msg.Sections, api.Section{
+			Context: api.ContextItems{
+				{Text: fmt.Sprintf("%d older event(s) were dropped as the digest buffer was full.", dropped)},
+			},
+		})
+	}
+
+	return interactive.CoreMessage{
+		Message: msg,
+	}
+}
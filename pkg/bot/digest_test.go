@@ -0,0 +1,228 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+func TestNotificationDigestAdd(t *testing.T) {
+	// given
+	digest := newNotificationDigest(logrus.New(), nil, "default-group", config.SocketSlackCommPlatformIntegration, map[string]config.ChannelNotification{
+		"digest-channel": {Mode: config.DigestNotificationMode},
+		"realtime-channel": {
+			Mode: config.RealTimeNotificationMode,
+		},
+		"default-channel": {},
+	})
+
+	// when
+	buffered := map[string]bool{}
+	for _, channel := range []string{"digest-channel", "realtime-channel", "default-channel", "unknown"} {
+		buffered[channel] = digest.Add(channel, fixDigestMessage("Pod", "default", "nginx"), []string{"k8s-events"})
+	}
+
+	// then
+	assert.Equal(t, map[string]bool{
+		"digest-channel":   true,
+		"realtime-channel": false,
+		"default-channel":  false,
+		"unknown":          false,
+	}, buffered)
+	require.Contains(t, digest.buffers, "digest-channel")
+	assert.Equal(t, []config.DigestEntry{
+		{
+			Source:    "k8s-events",
+			Namespace: "default",
+			Kind:      "Pod",
+			Summary:   "v1/pods error: nginx",
+			Timestamp: fixDigestTimestamp,
+		},
+	}, digest.buffers["digest-channel"].Entries)
+}
+
+func TestNotificationDigestAdd_DropsOldestEntries(t *testing.T) {
+	// given
+	digest := newNotificationDigest(logrus.New(), nil, "default-group", config.DiscordCommPlatformIntegration, map[string]config.ChannelNotification{
+		"digest-channel": {Mode: config.DigestNotificationMode},
+	})
+
+	// when
+	for i := 0; i < digestMaxEntries+3; i++ {
+		digest.Add("digest-channel", fixDigestMessage("Pod", "default", fmt.Sprintf("pod-%d", i)), []string{"k8s-events"})
+	}
+
+	// then
+	buff := digest.buffers["digest-channel"]
+	assert.Len(t, buff.Entries, digestMaxEntries)
+	assert.Equal(t, 3, buff.Dropped)
+	assert.Equal(t, "v1/pods error: pod-3", buff.Entries[0].Summary)
+}
+
+func TestNotificationDigestDynamicChannels(t *testing.T) {
+	// given
+	persisted := map[string]config.DigestRuntimeState{
+		config.DigestKey("default-group", config.TeamsCommPlatformIntegration, "conv-1"): {
+			Entries: []config.DigestEntry{{Source: "k8s-events", Summary: "restored", Timestamp: fixDigestTimestamp}},
+		},
+		config.DigestKey("other-group", config.TeamsCommPlatformIntegration, "conv-1"): {
+			Entries: []config.DigestEntry{{Source: "k8s-events", Summary: "other group", Timestamp: fixDigestTimestamp}},
+		},
+	}
+	digest := newDynamicNotificationDigest(logrus.New(), &fakeDigestManager{digests: persisted}, "default-group", config.TeamsCommPlatformIntegration)
+	digest.restore(context.Background())
+
+	// when
+	bufferedBeforeAdd := digest.Add("conv-1", fixDigestMessage("Pod", "default", "nginx"), []string{"k8s-events"})
+	digest.AddChannel("conv-1", config.ChannelNotification{Mode: config.DigestNotificationMode})
+	digest.AddChannel("conv-2", config.ChannelNotification{Mode: config.RealTimeNotificationMode})
+	bufferedAfterAdd := digest.Add("conv-1", fixDigestMessage("Pod", "default", "nginx"), []string{"k8s-events"})
+	bufferedRealTime := digest.Add("conv-2", fixDigestMessage("Pod", "default", "nginx"), []string{"k8s-events"})
+
+	// then
+	assert.False(t, bufferedBeforeAdd)
+	assert.True(t, bufferedAfterAdd)
+	assert.False(t, bufferedRealTime)
+
+	require.Contains(t, digest.buffers, "conv-1")
+	entries := digest.buffers["conv-1"].Entries
+	require.Len(t, entries, 2)
+	assert.Equal(t, "restored", entries[0].Summary)
+	assert.Equal(t, "v1/pods error: nginx", entries[1].Summary)
+}
+
+func TestNotificationDigestPopIfDue(t *testing.T) {
+	// given
+	now := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+	cfg := config.DigestNotification{Interval: time.Hour, MaxDetails: 2}
+	digest := newNotificationDigest(logrus.New(), nil, "default-group", config.MattermostCommPlatformIntegration, map[string]config.ChannelNotification{
+		"digest-channel": {Mode: config.DigestNotificationMode, Digest: cfg},
+	})
+
+	// when
+	_, _, sentOnStart := digest.popIfDue("digest-channel", cfg, now)
+
+	for _, name := range []string{"nginx", "redis", "mongo"} {
+		digest.Add("digest-channel", fixDigestMessage("Pod", "default", name), []string{"k8s-events"})
+	}
+	digest.Add("digest-channel", fixDigestMessage("Deployment", "prod", "api"), []string{"k8s-events"})
+
+	_, _, sentBeforeInterval := digest.popIfDue("digest-channel", cfg, now.Add(30*time.Minute))
+	msg, _, sentAfterInterval := digest.popIfDue("digest-channel", cfg, now.Add(time.Hour))
+
+	// then
+	assert.False(t, sentOnStart)
+	assert.False(t, sentBeforeInterval)
+	require.True(t, sentAfterInterval)
+	assert.Empty(t, digest.buffers["digest-channel"].Entries)
+
+	require.Len(t, msg.Sections, 3)
+	assert.Contains(t, msg.Sections[0].Header, "4 event(s)")
+
+	podsGroup := msg.Sections[1]
+	assert.Equal(t, api.TextFields{
+		{Key: "Source", Value: "k8s-events"},
+		{Key: "Count", Value: "3"},
+		{Key: "Namespace", Value: "default"},
+		{Key: "Kind", Value: "Pod"},
+	}, podsGroup.TextFields)
+	require.Len(t, podsGroup.BulletLists, 1)
+	assert.Equal(t, []string{
+		"v1/pods error: mongo (10:00AM)",
+		"v1/pods error: redis (10:00AM)",
+	}, podsGroup.BulletLists[0].Items)
+
+	deployGroup := msg.Sections[2]
+	assert.Contains(t, deployGroup.TextFields, api.TextField{Key: "Kind", Value: "Deployment"})
+	assert.Contains(t, deployGroup.TextFields, api.TextField{Key: "Count", Value: "1"})
+}
+
+func TestNotificationDigestSendDue_RequeuesOnError(t *testing.T) {
+	// given
+	now := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+	digest := newNotificationDigest(logrus.New(), nil, "default-group", config.SocketSlackCommPlatformIntegration, map[string]config.ChannelNotification{
+		"digest-channel": {Mode: config.DigestNotificationMode, Digest: config.DigestNotification{Interval: time.Hour}},
+	})
+	digest.now = func() time.Time { return now }
+	digest.Add("digest-channel", fixDigestMessage("Pod", "default", "nginx"), []string{"k8s-events"})
+	buff := digest.buffers["digest-channel"]
+	buff.LastSentAt = now.Add(-2 * time.Hour)
+	buff.Dropped = 2
+
+	var sent []interactive.CoreMessage
+	failingSend := func(context.Context, string, interactive.CoreMessage) error {
+		// a notification buffered while the digest is being sent
+		digest.Add("digest-channel", fixDigestMessage("Pod", "default", "redis"), []string{"k8s-events"})
+		return errors.New("test error")
+	}
+	send := func(_ context.Context, _ string, msg interactive.CoreMessage) error {
+		sent = append(sent, msg)
+		return nil
+	}
+
+	// when
+	digest.sendDue(context.Background(), failingSend)
+
+	// then
+	buff = digest.buffers["digest-channel"]
+	require.Len(t, buff.Entries, 2)
+	assert.Equal(t, "v1/pods error: nginx", buff.Entries[0].Summary)
+	assert.Equal(t, "v1/pods error: redis", buff.Entries[1].Summary)
+	assert.Equal(t, 2, buff.Dropped)
+	assert.Equal(t, now.Add(-2*time.Hour), buff.LastSentAt)
+
+	// when
+	digest.sendDue(context.Background(), send)
+
+	// then
+	require.Len(t, sent, 1)
+	assert.Contains(t, sent[0].Sections[0].Header, "4 event(s)")
+	assert.Empty(t, digest.buffers["digest-channel"].Entries)
+	assert.Zero(t, digest.buffers["digest-channel"].Dropped)
+}
+
+var fixDigestTimestamp = time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+
+func fixDigestMessage(kind, namespace, name string) interactive.CoreMessage {
+	return interactive.CoreMessage{
+		Message: api.Message{
+			Timestamp: fixDigestTimestamp,
+			Sections: []api.Section{
+				{
+					Base: api.Base{
+						Header: fmt.Sprintf("v1/%ss error", strings.ToLower(kind)),
+					},
+					TextFields: api.TextFields{
+						{Key: "Kind", Value: kind},
+						{Key: "Name", Value: name},
+						{Key: "Namespace", Value: namespace},
+					},
+				},
+			},
+		},
+	}
+}
+
+type fakeDigestManager struct {
+	digests map[string]config.DigestRuntimeState
+}
+
+func (f *fakeDigestManager) PersistDigest(_ context.Context, key string, digest config.DigestRuntimeState) error {
+	f.digests[key] = digest
+	return nil
+}
+
+func (f *fakeDigestManager) GetDigests(context.Context) (map[string]config.DigestRuntimeState, error) {
+	return f.digests, nil
+}
@@ -1,6 +1,7 @@
 package bot
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -20,6 +21,7 @@ import (
 	"github.com/kubeshop/botkube/pkg/execute"
 	"github.com/kubeshop/botkube/pkg/execute/command"
 	"github.com/kubeshop/botkube/pkg/multierror"
+	"github.com/kubeshop/botkube/pkg/notifier"
 	"github.com/kubeshop/botkube/pkg/sliceutil"
 )
 
@@ -27,7 +29,10 @@ import (
 //    - split to multiple files in a separate package,
 //    - review all the methods and see if they can be simplified.
 
-var _ Bot = &Discord{}
+var (
+	_ Bot             = &Discord{}
+	_ BindingsUpdater = &Discord{}
+)
 
 const (
 	// discordBotMentionRegexFmt supports also nicknames (the exclamation mark).
@@ -36,6 +41,11 @@ const (
 
 	// discordMaxMessageSize max size before a message should be uploaded as a file.
 	discordMaxMessageSize = 2000
+
+	// discordThreadName is the name of threads started for replies from multiple clusters.
+	discordThreadName = "Botkube replies"
+	// discordThreadArchiveDuration is the inactivity time in minutes after which the thread is archived.
+	discordThreadArchiveDuration = 1440
 )
 
 // Discord listens for user's message, execute commands and sends back the response.
@@ -54,6 +64,8 @@ type Discord struct {
 	messages              chan discordMessage
 	discordMessageWorkers *pool.Pool
 	shutdownOnce          sync.Once
+	digest                *notificationDigest
+	quietHours            *channelQuietHours
 }
 
 // discordMessage contains message details to execute command and send back the result.
@@ -62,7 +74,7 @@ type discordMessage struct {
 }
 
 // NewDiscord creates a new Discord instance.
-func NewDiscord(log logrus.FieldLogger, commGroupName string, cfg config.Discord, executorFactory ExecutorFactory, reporter AnalyticsReporter) (*Discord, error) {
+func NewDiscord(log logrus.FieldLogger, commGroupName string, cfg config.Discord, executorFactory ExecutorFactory, reporter AnalyticsReporter, cfgManager DigestPersistenceManager) (*Discord, error) {
 	botMentionRegex, err := discordBotMentionRegex(cfg.BotID)
 	if err != nil {
 		return nil, err
@@ -90,6 +102,8 @@ func NewDiscord(log logrus.FieldLogger, commGroupName string, cfg config.Discord
 		renderer:              NewDiscordRenderer(),
 		messages:              make(chan discordMessage, platformMessageChannelSize),
 		discordMessageWorkers: pool.New().WithMaxGoroutines(platformMessageWorkersCount),
+		digest:                newNotificationDigest(log, cfgManager, commGroupName, config.DiscordCommPlatformIntegration, notificationsByID(channelsCfg)),
+		quietHours:            newChannelQuietHours(log, notificationsByID(channelsCfg)),
 	}, nil
 }
 
@@ -129,6 +143,9 @@ func (b *Discord) Start(ctx context.Context) error {
 
 	b.log.Info("Botkube connected to Discord!")
 	go b.startMessageProcessor(ctx)
+	go b.digest.Run(ctx, func(_ context.Context, channelID string, msg interactive.CoreMessage) error {
+		return b.send(channelID, msg)
+	})
 	<-ctx.Done()
 	b.log.Info("Shutdown requested. Finishing...")
 	b.shutdown()
@@ -137,9 +154,20 @@ func (b *Discord) Start(ctx context.Context) error {
 
 // SendMessage sends interactive message to selected Discord channels.
 // Context is not supported by client: See https://github.com/bwmarrin/discordgo/issues/752.
-func (b *Discord) SendMessage(_ context.Context, msg interactive.CoreMessage, sourceBindings []string) error {
+func (b *Discord) SendMessage(ctx context.Context, msg interactive.CoreMessage, sourceBindings []string, receivers *notifier.Receivers) error {
 	errs := multierror.New()
 	for _, channelID := range b.getChannelsToNotify(sourceBindings) {
+		if !receivers.AllowsChannel(channelID) {
+			b.log.Debugf("Skipping notification for channel %q as it's not selected by routing rules", channelID)
+			continue
+		}
+		if b.quietHours.IsActive(channelID) {
+			b.log.Debugf("Skipping notification for channel %q due to quiet hours", channelID)
+			continue
+		}
+		if b.digest.Add(channelID, msg, sourceBindings) {
+			continue
+		}
 		err := b.send(channelID, msg)
 		if err != nil {
 			errs = multierror.Append(errs, fmt.Errorf("while sending Discord message to channel %q: %w", channelID, err))
@@ -246,6 +274,7 @@ func (b *Discord) handleMessage(ctx context.Context, dm discordMessage) error {
 		CommGroupName:   b.commGroupName,
 		Platform:        b.IntegrationName(),
 		NotifierHandler: b,
+		MessageStreamer: &discordMessageStreamer{b: b, channelID: dm.Event.ChannelID},
 		Conversation: execute.Conversation{
 			Alias:            channel.alias,
 			DisplayName:      channel.name,
@@ -259,11 +288,17 @@ func (b *Discord) handleMessage(ctx context.Context, dm discordMessage) error {
 		User: execute.UserInput{
 			Mention:     fmt.Sprintf("<@%s>", dm.Event.Author.ID),
 			DisplayName: dm.Event.Author.String(),
+			ID:          dm.Event.Author.ID,
+			Email:       dm.Event.Author.Email,
 		},
 	})
 
 	response := e.Execute(ctx)
-	err := b.send(dm.Event.ChannelID, response)
+	channelID := dm.Event.ChannelID
+	if response.ReplyInThread {
+		channelID = b.threadChannelID(dm.Event.ChannelID, dm.Event.ID)
+	}
+	err := b.send(channelID, response)
 	if err != nil {
 		return fmt.Errorf("while sending message: %w", err)
 	}
@@ -288,6 +323,57 @@ func (b *Discord) send(channelID string, resp interactive.CoreMessage) error {
 	return nil
 }
 
+// threadChannelID returns the ID of the thread started from a given message, creating the thread if it doesn't exist yet.
+// Threads started from a message have the same ID as the message. If the thread cannot be used, the channel ID is returned.
+func (b *Discord) threadChannelID(channelID, messageID string) string {
+	channel, err := b.api.Channel(channelID)
+	if err == nil && channel.IsThread() {
+		// the message was already sent in a thread
+		return channelID
+	}
+
+	_, err = b.api.MessageThreadStartComplex(channelID, messageID, &discordgo.ThreadStart{
+		Name:                discordThreadName,
+		AutoArchiveDuration: discordThreadArchiveDuration,
+	})
+	// other Botkube instances replying to the same message may start the thread first
+	if err != nil && !isDiscordErrCode(err, discordgo.ErrCodeThreadAlreadyCreatedForThisMessage) {
+		b.log.Errorf("Failed to start thread for message %q, replying in the channel instead: %s", messageID, discordError(err, channelID))
+		return channelID
+	}
+	return messageID
+}
+
+var _ execute.EditableMessageStreamer = &discordMessageStreamer{}
+
+// discordMessageStreamer posts and updates the live output of streamed commands in a given channel.
+type discordMessageStreamer struct {
+	b         *Discord
+	channelID string
+}
+
+// PostStreamMessage posts a new message and returns its ID.
+func (s *discordMessageStreamer) PostStreamMessage(_ context.Context, msg interactive.CoreMessage) (string, error) {
+	msg.ReplaceBotNamePlaceholder(s.b.BotName())
+	out, err := s.b.api.ChannelMessageSendComplex(s.channelID, &discordgo.MessageSend{
+		Content: s.b.renderer.MessageToMarkdown(msg),
+		Files:   discordFiles(msg.Attachments),
+	})
+	if err != nil {
+		return "", fmt.Errorf("while sending message: %w", discordError(err, s.channelID))
+	}
+	return out.ID, nil
+}
+
+// UpdateStreamMessage replaces the content of a message with a given ID.
+func (s *discordMessageStreamer) UpdateStreamMessage(_ context.Context, msgID string, msg interactive.CoreMessage) error {
+	msg.ReplaceBotNamePlaceholder(s.b.BotName())
+	if _, err := s.b.api.ChannelMessageEdit(s.channelID, msgID, s.b.renderer.MessageToMarkdown(msg)); err != nil {
+		return fmt.Errorf("while editing message: %w", discordError(err, s.channelID))
+	}
+	return nil
+}
+
 // BotName returns the Bot name.
 func (b *Discord) BotName() string {
 	// Note: we can use the botID, but it's not rendered well.
@@ -309,6 +395,21 @@ func (b *Discord) setChannels(channels map[string]channelConfigByID) {
 	b.channels = channels
 }
 
+// UpdateBindings replaces bindings of the configured channels.
+func (b *Discord) UpdateBindings(cfg config.Communications) {
+	b.channelsMutex.Lock()
+	defer b.channelsMutex.Unlock()
+
+	channels := make(map[string]channelConfigByID, len(b.channels))
+	for identifier, channel := range b.channels {
+		if updated, found := cfg.Discord.Channels[channel.alias]; found {
+			channel.Bindings = updated.Bindings
+		}
+		channels[identifier] = channel
+	}
+	b.channels = channels
+}
+
 func (b *Discord) findAndTrimBotMention(msg string) (string, bool) {
 	if !b.botMentionRegex.MatchString(msg) {
 		return "", false
@@ -326,12 +427,12 @@ func (b *Discord) formatMessage(msg interactive.CoreMessage) (*discordgo.Message
 	if len(plaintext) >= discordMaxMessageSize {
 		return &discordgo.MessageSend{
 			Content: msg.Description,
-			Files: []*discordgo.File{
+			Files: append([]*discordgo.File{
 				{
 					Name:   "Response.txt",
 					Reader: strings.NewReader(plaintext),
 				},
-			},
+			}, discordFiles(msg.Attachments)...),
 		}, nil
 	}
 
@@ -339,6 +440,7 @@ func (b *Discord) formatMessage(msg interactive.CoreMessage) (*discordgo.Message
 	if msg.Type != api.NonInteractiveSingleSection {
 		return &discordgo.MessageSend{
 			Content: b.renderer.MessageToMarkdown(msg),
+			Files:   discordFiles(msg.Attachments),
 		}, nil
 	}
 
@@ -356,6 +458,19 @@ func (b *Discord) formatMessage(msg interactive.CoreMessage) (*discordgo.Message
 	}, nil
 }
 
+// discordFiles returns files attached to a message, so they are uploaded together with it.
+func discordFiles(attachments []api.Attachment) []*discordgo.File {
+	var out []*discordgo.File
+	for _, attachment := range attachments {
+		out = append(out, &discordgo.File{
+			Name:        attachment.Name,
+			ContentType: attachment.ContentType,
+			Reader:      bytes.NewReader(attachment.Data),
+		})
+	}
+	return out
+}
+
 func (b *Discord) shutdown() {
 	b.shutdownOnce.Do(func() {
 		b.log.Info("Shutting down discord message processor...")
@@ -403,6 +518,12 @@ func discordBotMentionRegex(botID string) (*regexp.Regexp, error) {
 	return botMentionRegex, nil
 }
 
+// isDiscordErrCode returns true if a given error is a Discord API error with a given code.
+func isDiscordErrCode(err error, code int) bool {
+	var restErr *discordgo.RESTError
+	return errors.As(err, &restErr) && restErr.Message != nil && restErr.Message.Code == code
+}
+
 func discordError(err error, channel string) error {
 	switch err := err.(type) {
 	case *discordgo.RESTError:
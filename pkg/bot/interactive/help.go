@@ -60,7 +60,7 @@ func (h *HelpMessage) cluster() []api.Section {
 			{
 				Base: api.Base{
 					Header:      "Multi-cluster mode",
-					Description: "If you have multiple clusters configured for this channel, specify the cluster name when typing commands.",
+					Description: "If you have multiple clusters configured for this channel, specify the cluster name when typing commands. Use a glob pattern or a comma-separated list to run a command on multiple clusters, e.g. `--cluster-name='prod-*'`. Each matching cluster replies in a thread. On Slack and Mattermost, add `--aggregate` to also get a single comparison table of all replies.",
 					Body: api.Body{
 						CodeBlock: fmt.Sprintf("--cluster-name=%s\n", h.clusterName),
 					},
@@ -139,6 +139,17 @@ func (h *HelpMessage) notificationSections() []api.Section {
 				h.btnBuilder.ForCommandWithDescCmd("Adjust notifications", "edit SourceBindings"),
 			},
 		},
+		{
+			Base: api.Base{
+				Header: "Silence notifications during maintenance",
+				Body: api.Body{
+					CodeBlock: fmt.Sprintf("%s create silence --for 2h [--source name] [--namespace name] [--reason text]", api.MessageBotNamePlaceholder),
+				},
+			},
+			Buttons: []api.Button{
+				h.btnBuilder.ForCommandWithoutDesc("List silences", "list silences"),
+			},
+		},
 	}
 }
 
@@ -164,6 +175,17 @@ func (h *HelpMessage) executorSections() []api.Section {
 				h.btnBuilder.ForURL("Executors and aliases help", "https://docs.botkube.io/usage/executor"),
 			},
 		},
+		{
+			Base: api.Base{
+				Header: "Run long commands in the background",
+				Body: api.Body{
+					CodeBlock: fmt.Sprintf("%s run --async <command>", api.MessageBotNamePlaceholder),
+				},
+			},
+			Buttons: []api.Button{
+				h.btnBuilder.ForCommandWithoutDesc("List jobs", "list jobs"),
+			},
+		},
 	}
 }
 
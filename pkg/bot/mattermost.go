@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/url"
 	"regexp"
+	"sort"
 	"strings"
 	"sync"
 
@@ -21,6 +22,7 @@ import (
 	"github.com/kubeshop/botkube/pkg/execute"
 	"github.com/kubeshop/botkube/pkg/execute/command"
 	"github.com/kubeshop/botkube/pkg/multierror"
+	"github.com/kubeshop/botkube/pkg/notifier"
 	"github.com/kubeshop/botkube/pkg/sliceutil"
 )
 
@@ -28,7 +30,10 @@ import (
 //    - split to multiple files in a separate package,
 //    - review all the methods and see if they can be simplified.
 
-var _ Bot = &Mattermost{}
+var (
+	_ Bot             = &Mattermost{}
+	_ BindingsUpdater = &Mattermost{}
+)
 
 const (
 	// WebSocketProtocol stores protocol initials for web socket
@@ -66,10 +71,12 @@ type Mattermost struct {
 	notifyMutex     sync.Mutex
 	botMentionRegex *regexp.Regexp
 	renderer        *MattermostRenderer
-	userNamesForID  map[string]string
+	usersForID      map[string]*model.User
 	messages        chan mattermostMessage
 	messageWorkers  *pool.Pool
 	shutdownOnce    sync.Once
+	digest          *notificationDigest
+	quietHours      *channelQuietHours
 }
 
 // mattermostMessage contains message details to execute command and send back the result
@@ -78,7 +85,7 @@ type mattermostMessage struct {
 }
 
 // NewMattermost creates a new Mattermost instance.
-func NewMattermost(ctx context.Context, log logrus.FieldLogger, commGroupName string, cfg config.Mattermost, executorFactory ExecutorFactory, reporter AnalyticsReporter) (*Mattermost, error) {
+func NewMattermost(ctx context.Context, log logrus.FieldLogger, commGroupName string, cfg config.Mattermost, executorFactory ExecutorFactory, reporter AnalyticsReporter, cfgManager DigestPersistenceManager) (*Mattermost, error) {
 	botMentionRegex, err := mattermostBotMentionRegex(cfg.BotName)
 	if err != nil {
 		return nil, err
@@ -135,9 +142,11 @@ func NewMattermost(ctx context.Context, log logrus.FieldLogger, commGroupName st
 		channels:        channelsByIDCfg,
 		botMentionRegex: botMentionRegex,
 		renderer:        NewMattermostRenderer(),
-		userNamesForID:  map[string]string{},
+		usersForID:      map[string]*model.User{},
 		messages:        make(chan mattermostMessage, platformMessageChannelSize),
 		messageWorkers:  pool.New().WithMaxGoroutines(platformMessageWorkersCount),
+		digest:          newNotificationDigest(log, cfgManager, commGroupName, config.MattermostCommPlatformIntegration, notificationsByID(channelsByIDCfg)),
+		quietHours:      newChannelQuietHours(log, notificationsByID(channelsByIDCfg)),
 	}, nil
 }
 
@@ -174,6 +183,7 @@ func (b *Mattermost) Start(ctx context.Context) error {
 	b.log.Info("Botkube connected to Mattermost!")
 
 	go b.startMessageProcessor(ctx)
+	go b.digest.Run(ctx, b.send)
 
 	for {
 		select {
@@ -262,9 +272,13 @@ func (b *Mattermost) handleMessage(ctx context.Context, mm mattermostMessage) er
 		}
 	}
 
-	userName, err := b.getUserName(ctx, post.UserId)
+	var userName, userEmail string
+	user, err := b.getUser(ctx, post.UserId)
 	if err != nil {
-		b.log.Errorf("while getting user name: %s", err.Error())
+		b.log.Errorf("while getting user: %s", err.Error())
+	}
+	if user != nil {
+		userName, userEmail = user.Username, user.Email
 	}
 	if userName == "" {
 		userName = post.UserId
@@ -274,6 +288,8 @@ func (b *Mattermost) handleMessage(ctx context.Context, mm mattermostMessage) er
 		CommGroupName:   b.commGroupName,
 		Platform:        b.IntegrationName(),
 		NotifierHandler: b,
+		MessageStreamer: &mattermostMessageStreamer{b: b, channelID: channelID},
+		FanOutThread:    &mattermostFanOutThread{b: b, channelID: channelID, post: post},
 		Conversation: execute.Conversation{
 			Alias:            channel.alias,
 			DisplayName:      channel.name,
@@ -286,11 +302,20 @@ func (b *Mattermost) handleMessage(ctx context.Context, mm mattermostMessage) er
 		User: execute.UserInput{
 			//Mention:     "", // not used currently
 			DisplayName: userName,
+			ID:          post.UserId,
+			Email:       userEmail,
 		},
 		Message: req,
 	})
 	response := e.Execute(ctx)
-	err = b.send(ctx, channelID, response)
+	rootID := ""
+	if response.ReplyInThread {
+		rootID = post.RootId
+		if rootID == "" {
+			rootID = post.Id
+		}
+	}
+	err = b.sendPost(ctx, channelID, rootID, response)
 	if err != nil {
 		return fmt.Errorf("while sending message: %w", err)
 	}
@@ -300,6 +325,11 @@ func (b *Mattermost) handleMessage(ctx context.Context, mm mattermostMessage) er
 
 // Send messages to Mattermost
 func (b *Mattermost) send(ctx context.Context, channelID string, resp interactive.CoreMessage) error {
+	return b.sendPost(ctx, channelID, "", resp)
+}
+
+// sendPost sends a message to Mattermost. If the root ID is specified, the message is posted as a reply in its thread.
+func (b *Mattermost) sendPost(ctx context.Context, channelID, rootID string, resp interactive.CoreMessage) error {
 	b.log.Debugf("Sending message to channel %q: %+v", channelID, resp)
 
 	resp.ReplaceBotNamePlaceholder(b.BotName())
@@ -307,6 +337,7 @@ func (b *Mattermost) send(ctx context.Context, channelID string, resp interactiv
 	if err != nil {
 		return fmt.Errorf("while formatting message: %w", err)
 	}
+	post.RootId = rootID
 
 	if _, _, err := b.apiClient.CreatePost(ctx, post); err != nil {
 		b.log.Error("Failed to send message. Error: ", err)
@@ -316,12 +347,103 @@ func (b *Mattermost) send(ctx context.Context, channelID string, resp interactiv
 	return nil
 }
 
+var _ execute.EditableMessageStreamer = &mattermostMessageStreamer{}
+
+// mattermostMessageStreamer posts and updates the live output of streamed commands in a given channel.
+type mattermostMessageStreamer struct {
+	b         *Mattermost
+	channelID string
+}
+
+// PostStreamMessage posts a new message and returns its ID.
+func (s *mattermostMessageStreamer) PostStreamMessage(ctx context.Context, msg interactive.CoreMessage) (string, error) {
+	msg.ReplaceBotNamePlaceholder(s.b.BotName())
+	fileIDs, err := s.b.uploadAttachments(ctx, s.channelID, msg.Attachments)
+	if err != nil {
+		return "", err
+	}
+	post, _, err := s.b.apiClient.CreatePost(ctx, &model.Post{
+		ChannelId: s.channelID,
+		Message:   s.b.renderer.MessageToMarkdown(msg),
+		FileIds:   fileIDs,
+	})
+	if err != nil {
+		return "", fmt.Errorf("while creating post: %w", err)
+	}
+	return post.Id, nil
+}
+
+// UpdateStreamMessage replaces the content of a message with a given ID.
+func (s *mattermostMessageStreamer) UpdateStreamMessage(ctx context.Context, msgID string, msg interactive.CoreMessage) error {
+	msg.ReplaceBotNamePlaceholder(s.b.BotName())
+	content := s.b.renderer.MessageToMarkdown(msg)
+	if _, _, err := s.b.apiClient.PatchPost(ctx, msgID, &model.PostPatch{Message: &content}); err != nil {
+		return fmt.Errorf("while updating post: %w", err)
+	}
+	return nil
+}
+
+var _ execute.FanOutThread = &mattermostFanOutThread{}
+
+// mattermostFanOutThread posts and reads replies in the thread of a given command post.
+type mattermostFanOutThread struct {
+	b         *Mattermost
+	channelID string
+	post      *model.Post
+}
+
+func (t *mattermostFanOutThread) rootID() string {
+	if t.post.RootId != "" {
+		return t.post.RootId
+	}
+	return t.post.Id
+}
+
+// PostThreadReply posts a plain text reply in the thread of the command post.
+func (t *mattermostFanOutThread) PostThreadReply(ctx context.Context, text string) error {
+	_, _, err := t.b.apiClient.CreatePost(ctx, &model.Post{
+		ChannelId: t.channelID,
+		RootId:    t.rootID(),
+		Message:   text,
+	})
+	if err != nil {
+		return fmt.Errorf("while creating post: %w", err)
+	}
+	return nil
+}
+
+// ThreadReplies returns texts of the replies posted in the thread after the command post.
+func (t *mattermostFanOutThread) ThreadReplies(ctx context.Context) ([]string, error) {
+	thread, _, err := t.b.apiClient.GetPostThread(ctx, t.rootID(), "", false)
+	if err != nil {
+		return nil, fmt.Errorf("while getting post thread: %w", err)
+	}
+
+	posts := thread.ToSlice()
+	sort.Slice(posts, func(i, j int) bool {
+		return posts[i].CreateAt < posts[j].CreateAt
+	})
+
+	var out []string
+	for _, post := range posts {
+		if post.CreateAt <= t.post.CreateAt || post.Id == t.post.Id {
+			continue
+		}
+		out = append(out, post.Message)
+	}
+	return out, nil
+}
+
 func (b *Mattermost) formatMessage(ctx context.Context, msg interactive.CoreMessage, channelID string) (*model.Post, error) {
 	// 1. Check the size and upload message as a file if it's too long
 	plaintext := interactive.MessageToPlaintext(msg, interactive.NewlineFormatter)
 	if len(plaintext) == 0 {
 		return nil, errors.New("while reading Mattermost response: empty response")
 	}
+	fileIDs, err := b.uploadAttachments(ctx, channelID, msg.Attachments)
+	if err != nil {
+		return nil, err
+	}
 	if len(plaintext) >= mattermostMaxMessageSize {
 		uploadResponse, _, err := b.apiClient.UploadFileAsRequestBody(
 			ctx,
@@ -336,7 +458,7 @@ func (b *Mattermost) formatMessage(ctx context.Context, msg interactive.CoreMess
 		return &model.Post{
 			ChannelId: channelID,
 			Message:   msg.Description,
-			FileIds:   []string{uploadResponse.FileInfos[0].Id},
+			FileIds:   append([]string{uploadResponse.FileInfos[0].Id}, fileIDs...),
 		}, nil
 	}
 
@@ -345,6 +467,7 @@ func (b *Mattermost) formatMessage(ctx context.Context, msg interactive.CoreMess
 		return &model.Post{
 			ChannelId: channelID,
 			Message:   b.renderer.MessageToMarkdown(msg),
+			FileIds:   fileIDs,
 		}, nil
 	}
 
@@ -363,6 +486,19 @@ func (b *Mattermost) formatMessage(ctx context.Context, msg interactive.CoreMess
 	}, nil
 }
 
+// uploadAttachments uploads files attached to a message and returns their IDs, so they can be referenced in a post.
+func (b *Mattermost) uploadAttachments(ctx context.Context, channelID string, attachments []api.Attachment) ([]string, error) {
+	var fileIDs []string
+	for _, attachment := range attachments {
+		uploadResponse, _, err := b.apiClient.UploadFileAsRequestBody(ctx, attachment.Data, channelID, attachment.Name)
+		if err != nil {
+			return nil, fmt.Errorf("while uploading %q attachment: %w", attachment.Name, err)
+		}
+		fileIDs = append(fileIDs, uploadResponse.FileInfos[0].Id)
+	}
+	return fileIDs, nil
+}
+
 // Check if Mattermost server is reachable
 func (b *Mattermost) checkServerConnection(ctx context.Context) error {
 	// Check api connection
@@ -430,9 +566,20 @@ func (b *Mattermost) getChannelsToNotify(eventSources []string) []string {
 }
 
 // SendMessage sends message to selected Mattermost channels.
-func (b *Mattermost) SendMessage(ctx context.Context, msg interactive.CoreMessage, sourceBindings []string) error {
+func (b *Mattermost) SendMessage(ctx context.Context, msg interactive.CoreMessage, sourceBindings []string, receivers *notifier.Receivers) error {
 	errs := multierror.New()
 	for _, channelID := range b.getChannelsToNotify(sourceBindings) {
+		if !receivers.AllowsChannel(channelID) {
+			b.log.Debugf("Skipping notification for channel %q as it's not selected by routing rules", channelID)
+			continue
+		}
+		if b.quietHours.IsActive(channelID) {
+			b.log.Debugf("Skipping notification for channel %q due to quiet hours", channelID)
+			continue
+		}
+		if b.digest.Add(channelID, msg, sourceBindings) {
+			continue
+		}
 		err := b.send(ctx, channelID, msg)
 		if err != nil {
 			errs = multierror.Append(errs, fmt.Errorf("while sending Mattermost message to channel %q: %w", channelID, err))
@@ -482,19 +629,26 @@ func (b *Mattermost) setChannels(channels map[string]channelConfigByID) {
 	b.channels = channels
 }
 
-func (b *Mattermost) getUserName(ctx context.Context, userID string) (string, error) {
-	userName, exists := b.userNamesForID[userID]
+// UpdateBindings replaces bindings of the configured channels.
+func (b *Mattermost) UpdateBindings(cfg config.Communications) {
+	b.channelsMutex.Lock()
+	defer b.channelsMutex.Unlock()
+	b.channels = channelsByIDWithBindings(b.channels, cfg.Mattermost.Channels)
+}
+
+func (b *Mattermost) getUser(ctx context.Context, userID string) (*model.User, error) {
+	user, exists := b.usersForID[userID]
 	if exists {
-		return userName, nil
+		return user, nil
 	}
 
 	user, _, err := b.apiClient.GetUser(ctx, userID, "")
 	if err != nil {
-		return "", fmt.Errorf("while getting user with ID %q: %w", userID, err)
+		return nil, fmt.Errorf("while getting user with ID %q: %w", userID, err)
 	}
-	b.userNamesForID[userID] = user.Username
+	b.usersForID[userID] = user
 
-	return user.Username, nil
+	return user, nil
 }
 
 func (b *Mattermost) shutdown() {
@@ -0,0 +1,93 @@
+package bot
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+// PreviewFormat describes the format of a rendered message preview.
+type PreviewFormat string
+
+const (
+	// MarkdownPreviewFormat is used for platforms which receive messages as Markdown text.
+	MarkdownPreviewFormat PreviewFormat = "markdown"
+	// SlackBlocksPreviewFormat is used for Slack Block Kit messages.
+	SlackBlocksPreviewFormat PreviewFormat = "slack-blocks"
+	// TeamsCardPreviewFormat is used for Microsoft Teams Adaptive Cards.
+	TeamsCardPreviewFormat PreviewFormat = "teams-card"
+	// DiscordEmbedPreviewFormat is used for Discord message embeds.
+	DiscordEmbedPreviewFormat PreviewFormat = "discord-embed"
+	// MattermostAttachmentsPreviewFormat is used for Mattermost message attachments.
+	MattermostAttachmentsPreviewFormat PreviewFormat = "mattermost-attachments"
+)
+
+// MessagePreview holds a message rendered in the same way as a given communication platform renders it before sending.
+type MessagePreview struct {
+	Format PreviewFormat
+	Body   string
+}
+
+// RenderPreview renders a given message for a given communication platform. Structured payloads,
+// such as Slack blocks, are returned as indented JSON.
+func RenderPreview(platform config.CommPlatformIntegration, botName string, msg interactive.CoreMessage) (MessagePreview, error) {
+	msg.ReplaceBotNamePlaceholder(botName)
+	isSingleSection := msg.Type == api.NonInteractiveSingleSection
+
+	switch platform {
+	case config.SocketSlackCommPlatformIntegration, config.CloudSlackCommPlatformIntegration:
+		if !msg.HasSections() && !msg.HasInputs() {
+			return markdownPreview(NewSlackRenderer().MessageToMarkdown(msg)), nil
+		}
+		return jsonPreview(SlackBlocksPreviewFormat, NewSlackRenderer().RenderAsSlackBlocks(msg))
+	case config.SlackCommPlatformIntegration:
+		return markdownPreview(NewSlackRenderer().MessageToMarkdown(msg)), nil
+	case config.TeamsCommPlatformIntegration:
+		renderer := NewTeamsRenderer()
+		if !isSingleSection {
+			return markdownPreview(renderer.MessageToMarkdown(msg)), nil
+		}
+		card, err := renderer.NonInteractiveSectionToCard(msg)
+		if err != nil {
+			return MessagePreview{}, fmt.Errorf("while rendering Teams card: %w", err)
+		}
+		return jsonPreview(TeamsCardPreviewFormat, card)
+	case config.DiscordCommPlatformIntegration:
+		renderer := NewDiscordRenderer()
+		if !isSingleSection {
+			return markdownPreview(renderer.MessageToMarkdown(msg)), nil
+		}
+		embed, err := renderer.NonInteractiveSectionToCard(msg)
+		if err != nil {
+			return MessagePreview{}, fmt.Errorf("while rendering Discord embed: %w", err)
+		}
+		return jsonPreview(DiscordEmbedPreviewFormat, embed)
+	case config.MattermostCommPlatformIntegration:
+		renderer := NewMattermostRenderer()
+		if !isSingleSection {
+			return markdownPreview(renderer.MessageToMarkdown(msg)), nil
+		}
+		attachments, err := renderer.NonInteractiveSectionToCard(msg)
+		if err != nil {
+			return MessagePreview{}, fmt.Errorf("while rendering Mattermost attachments: %w", err)
+		}
+		return jsonPreview(MattermostAttachmentsPreviewFormat, attachments)
+	}
+
+	return markdownPreview(interactive.RenderMessage(interactive.DefaultMDFormatter(), msg)), nil
+}
+
+func markdownPreview(body string) MessagePreview {
+	return MessagePreview{Format: MarkdownPreviewFormat, Body: body}
+}
+
+func jsonPreview(format PreviewFormat, in any) (MessagePreview, error) {
+	raw, err := json.MarshalIndent(in, "", "  ")
+	if err != nil {
+		return MessagePreview{}, fmt.Errorf("while marshaling %s: %w", format, err)
+	}
+	return MessagePreview{Format: format, Body: string(raw)}, nil
+}
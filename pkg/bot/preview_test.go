@@ -0,0 +1,83 @@
+package bot
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+func TestRenderPreview(t *testing.T) {
+	// given
+	msg := interactive.CoreMessage{Message: FixNonInteractiveSingleSection()}
+
+	tests := []struct {
+		name      string
+		platform  config.CommPlatformIntegration
+		expFormat PreviewFormat
+	}{
+		{
+			name:      "Socket Slack",
+			platform:  config.SocketSlackCommPlatformIntegration,
+			expFormat: SlackBlocksPreviewFormat,
+		},
+		{
+			name:      "Legacy Slack",
+			platform:  config.SlackCommPlatformIntegration,
+			expFormat: MarkdownPreviewFormat,
+		},
+		{
+			name:      "Teams",
+			platform:  config.TeamsCommPlatformIntegration,
+			expFormat: TeamsCardPreviewFormat,
+		},
+		{
+			name:      "Discord",
+			platform:  config.DiscordCommPlatformIntegration,
+			expFormat: DiscordEmbedPreviewFormat,
+		},
+		{
+			name:      "Mattermost",
+			platform:  config.MattermostCommPlatformIntegration,
+			expFormat: MattermostAttachmentsPreviewFormat,
+		},
+		{
+			name:      "Webhook",
+			platform:  config.WebhookCommPlatformIntegration,
+			expFormat: MarkdownPreviewFormat,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			out, err := RenderPreview(tc.platform, "@Botkube", msg)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.expFormat, out.Format)
+			assert.Contains(t, out.Body, "Section Header")
+			if tc.expFormat != MarkdownPreviewFormat {
+				assert.True(t, json.Valid([]byte(out.Body)))
+			}
+		})
+	}
+}
+
+func TestRenderPreviewMultiSectionFallsBackToMarkdown(t *testing.T) {
+	// given
+	msg := interactive.CoreMessage{Message: FixNonInteractiveSingleSection()}
+	msg.Type = api.DefaultMessage
+
+	// when
+	out, err := RenderPreview(config.DiscordCommPlatformIntegration, "@Botkube", msg)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, MarkdownPreviewFormat, out.Format)
+	assert.Contains(t, out.Body, "Section Header")
+}
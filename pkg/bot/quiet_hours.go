@@ -0,0 +1,49 @@
+package bot
+
+import (
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+// channelQuietHours checks whether notifications for a given channel are muted due to the configured quiet hours.
+type channelQuietHours struct {
+	channels map[string][]config.ParsedQuietHours
+	now      func() time.Time
+}
+
+// newChannelQuietHours parses the quiet hours of all channels upfront, so they are not parsed for every notification.
+func newChannelQuietHours(log logrus.FieldLogger, channels map[string]config.ChannelNotification) *channelQuietHours {
+	log = log.WithField("component", "Quiet Hours")
+
+	quietHours := make(map[string][]config.ParsedQuietHours)
+	for name, notification := range channels {
+		for _, item := range notification.QuietHours {
+			parsed, err := item.Parse()
+			if err != nil {
+				// the configuration is validated on startup, so it shouldn't happen
+				log.WithError(err).Errorf("Failed to parse quiet hours for channel %q. Skipping them...", name)
+				continue
+			}
+			quietHours[name] = append(quietHours[name], parsed)
+		}
+	}
+
+	return &channelQuietHours{
+		channels: quietHours,
+		now:      time.Now,
+	}
+}
+
+// IsActive returns true if a given channel is in its quiet hours.
+func (q *channelQuietHours) IsActive(channel string) bool {
+	now := q.now()
+	for _, quietHours := range q.channels[channel] {
+		if quietHours.IsActive(now) {
+			return true
+		}
+	}
+	return false
+}
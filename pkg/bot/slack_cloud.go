@@ -32,6 +32,7 @@ import (
 	"github.com/kubeshop/botkube/pkg/execute/command"
 	"github.com/kubeshop/botkube/pkg/formatx"
 	"github.com/kubeshop/botkube/pkg/multierror"
+	"github.com/kubeshop/botkube/pkg/notifier"
 	"github.com/kubeshop/botkube/pkg/sliceutil"
 )
 
@@ -44,7 +45,10 @@ const (
 	quotaExceededMsg        = "Quota exceeded detected. Stopping reconnecting to Botkube Cloud gRPC API..."
 )
 
-var _ Bot = &CloudSlack{}
+var (
+	_ Bot             = &CloudSlack{}
+	_ BindingsUpdater = &CloudSlack{}
+)
 
 // CloudSlack listens for user's message, execute commands and sends back the response.
 type CloudSlack struct {
@@ -54,7 +58,7 @@ type CloudSlack struct {
 	executorFactory  ExecutorFactory
 	reporter         cloudSlackAnalyticsReporter
 	commGroupName    string
-	realNamesForID   map[string]string
+	usersForID       map[string]slackUserDetails
 	botMentionRegex  *regexp.Regexp
 	botID            string
 	channelsMutex    sync.RWMutex
@@ -63,6 +67,8 @@ type CloudSlack struct {
 	notifyMutex      sync.Mutex
 	clusterName      string
 	msgStatusTracker *SlackMessageStatusTracker
+	digest           *notificationDigest
+	quietHours       *channelQuietHours
 }
 
 // cloudSlackAnalyticsReporter defines a reporter that collects analytics data.
@@ -76,7 +82,8 @@ func NewCloudSlack(log logrus.FieldLogger,
 	cfg config.CloudSlack,
 	clusterName string,
 	executorFactory ExecutorFactory,
-	reporter cloudSlackAnalyticsReporter) (*CloudSlack, error) {
+	reporter cloudSlackAnalyticsReporter,
+	cfgManager DigestPersistenceManager) (*CloudSlack, error) {
 	client := slack.New(cfg.Token)
 
 	_, err := client.AuthTest()
@@ -106,12 +113,16 @@ func NewCloudSlack(log logrus.FieldLogger,
 		client:           client,
 		botID:            cfg.BotID,
 		clusterName:      clusterName,
-		realNamesForID:   map[string]string{},
+		usersForID:       map[string]slackUserDetails{},
 		msgStatusTracker: NewSlackMessageStatusTracker(log, client),
+		digest:           newNotificationDigest(log, cfgManager, commGroupName, config.CloudSlackCommPlatformIntegration, notificationsByName(channels)),
+		quietHours:       newChannelQuietHours(log, notificationsByName(channels)),
 	}, nil
 }
 
 func (b *CloudSlack) Start(ctx context.Context) error {
+	go b.digest.Run(ctx, b.sendDigest)
+
 	if b.cfg.ExecutionEventStreamingDisabled {
 		b.log.Warn(quotaExceededMsg)
 		return nil
@@ -256,14 +267,15 @@ func (b *CloudSlack) handleStreamMessage(ctx context.Context, data *pb.ConnectRe
 		switch ev := innerEvent.Data.(type) {
 		case *slackevents.AppMentionEvent:
 			b.log.Debugf("Got app mention %s", formatx.StructDumper().Sdump(innerEvent))
-			userName := b.getRealNameWithFallbackToUserID(ctx, ev.User)
+			user := b.getUserDetailsWithFallbackToUserID(ctx, ev.User)
 			msg := slackMessage{
 				Text:            ev.Text,
 				Channel:         ev.Channel,
 				ThreadTimeStamp: ev.ThreadTimeStamp,
 				UserID:          ev.User,
 				EventTimeStamp:  ev.EventTimeStamp,
-				UserName:        userName,
+				UserName:        user.realName,
+				UserEmail:       user.email,
 				CommandOrigin:   command.TypedOrigin,
 			}
 
@@ -329,14 +341,15 @@ func (b *CloudSlack) handleStreamMessage(ctx context.Context, data *pb.ConnectRe
 
 			state := removeBotNameFromIDs(b.BotName(), callback.BlockActionState)
 
-			userName := b.getRealNameWithFallbackToUserID(ctx, callback.User.ID)
+			user := b.getUserDetailsWithFallbackToUserID(ctx, callback.User.ID)
 			msg := slackMessage{
 				Text:            cmd,
 				Channel:         channelID,
 				ThreadTimeStamp: threadTs,
 				TriggerID:       callback.TriggerID,
 				UserID:          callback.User.ID,
-				UserName:        userName,
+				UserName:        user.realName,
+				UserEmail:       user.email,
 				CommandOrigin:   cmdOrigin,
 				State:           state,
 				EventTimeStamp:  callback.Message.Timestamp,
@@ -354,12 +367,13 @@ func (b *CloudSlack) handleStreamMessage(ctx context.Context, data *pb.ConnectRe
 					act.ActionID = actID // normalize event
 
 					cmd, cmdOrigin := resolveBlockActionCommand(act)
-					userName := b.getRealNameWithFallbackToUserID(ctx, callback.User.ID)
+					user := b.getUserDetailsWithFallbackToUserID(ctx, callback.User.ID)
 					msg := slackMessage{
 						Text:           cmd,
 						Channel:        callback.View.PrivateMetadata,
 						UserID:         callback.User.ID,
-						UserName:       userName,
+						UserName:       user.realName,
+						UserEmail:      user.email,
 						EventTimeStamp: "", // there is no timestamp for interactive callbacks
 						CommandOrigin:  cmdOrigin,
 					}
@@ -377,9 +391,20 @@ func (b *CloudSlack) handleStreamMessage(ctx context.Context, data *pb.ConnectRe
 	return nil, false
 }
 
-func (b *CloudSlack) SendMessage(ctx context.Context, msg interactive.CoreMessage, sourceBindings []string) error {
+func (b *CloudSlack) SendMessage(ctx context.Context, msg interactive.CoreMessage, sourceBindings []string, receivers *notifier.Receivers) error {
 	errs := multierror.New()
 	for _, channelName := range b.getChannelsToNotify(sourceBindings) {
+		if !receivers.AllowsChannel(channelName) {
+			b.log.Debugf("Skipping notification for channel %q as it's not selected by routing rules", channelName)
+			continue
+		}
+		if b.quietHours.IsActive(channelName) {
+			b.log.Debugf("Skipping notification for channel %q due to quiet hours", channelName)
+			continue
+		}
+		if b.digest.Add(channelName, msg, sourceBindings) {
+			continue
+		}
 		msgMetadata := slackMessage{
 			Channel:         channelName,
 			ThreadTimeStamp: "",
@@ -395,6 +420,13 @@ func (b *CloudSlack) SendMessage(ctx context.Context, msg interactive.CoreMessag
 	return errs.ErrorOrNil()
 }
 
+func (b *CloudSlack) sendDigest(ctx context.Context, channelName string, msg interactive.CoreMessage) error {
+	return b.send(ctx, slackMessage{
+		Channel: channelName,
+		BlockID: uuid.New().String(),
+	}, msg)
+}
+
 func (b *CloudSlack) SendMessageToAll(ctx context.Context, msg interactive.CoreMessage) error {
 	errs := multierror.New()
 	for _, channel := range b.getChannels() {
@@ -421,24 +453,29 @@ func (b *CloudSlack) IntegrationName() config.CommPlatformIntegration {
 	return config.CloudSlackCommPlatformIntegration
 }
 
-func (b *CloudSlack) getRealNameWithFallbackToUserID(ctx context.Context, userID string) string {
-	realName, exists := b.realNamesForID[userID]
+func (b *CloudSlack) getUserDetailsWithFallbackToUserID(ctx context.Context, userID string) slackUserDetails {
+	details, exists := b.usersForID[userID]
 	if exists {
-		return realName
+		return details
 	}
 
 	user, err := b.client.GetUserInfoContext(ctx, userID)
 	if err != nil {
 		b.log.Errorf("while getting user info: %s", err.Error())
-		return userID
+		return slackUserDetails{realName: userID}
 	}
 
 	if user == nil || user.RealName == "" {
-		return userID
+		return slackUserDetails{realName: userID}
 	}
 
-	b.realNamesForID[userID] = user.RealName
-	return user.RealName
+	details = slackUserDetails{
+		realName: user.RealName,
+		// Email is returned only if the app has the `users:read.email` scope.
+		email: user.Profile.Email,
+	}
+	b.usersForID[userID] = details
+	return details
 }
 
 func (b *CloudSlack) handleMessage(ctx context.Context, event slackMessage) error {
@@ -469,6 +506,7 @@ func (b *CloudSlack) handleMessage(ctx context.Context, event slackMessage) erro
 		CommGroupName:   b.commGroupName,
 		Platform:        b.IntegrationName(),
 		NotifierHandler: b,
+		MessageStreamer: b.newMessageStreamer(event),
 		Conversation: execute.Conversation{
 			Alias:            channel.alias,
 			ID:               channel.Identifier(),
@@ -483,6 +521,8 @@ func (b *CloudSlack) handleMessage(ctx context.Context, event slackMessage) erro
 		User: execute.UserInput{
 			Mention:     fmt.Sprintf("<@%s>", event.UserID),
 			DisplayName: event.UserName,
+			ID:          event.UserID,
+			Email:       event.UserEmail,
 		},
 	})
 
@@ -503,6 +543,7 @@ func (b *CloudSlack) handleMessage(ctx context.Context, event slackMessage) erro
 func (b *CloudSlack) send(ctx context.Context, event slackMessage, resp interactive.CoreMessage) error {
 	b.log.Debugf("Sending message to channel %q: %+v", event.Channel, resp)
 
+	event = threadReplyIfRequested(event, resp)
 	resp.ReplaceBotNamePlaceholder(b.BotName(), api.BotNameWithClusterName(b.clusterName))
 	markdown := b.renderer.MessageToMarkdown(resp)
 
@@ -521,6 +562,7 @@ func (b *CloudSlack) send(ctx context.Context, event slackMessage, resp interact
 		resp = interactive.CoreMessage{
 			Message: api.Message{
 				PlaintextInputs: resp.PlaintextInputs,
+				Attachments:     resp.Attachments,
 			},
 		}
 	}
@@ -554,16 +596,35 @@ func (b *CloudSlack) send(ctx context.Context, event slackMessage, resp interact
 		if _, err := b.client.PostEphemeralContext(ctx, event.Channel, event.UserID, options...); err != nil {
 			return fmt.Errorf("while posting Slack message visible only to user: %w", err)
 		}
+		// files are always visible to everyone in the channel, so they are sent to the user directly
+		if err := uploadAttachmentsToSlackDM(ctx, b.client, event.UserID, resp.Attachments); err != nil {
+			return err
+		}
 	} else {
-		if _, _, err := b.client.PostMessageContext(ctx, event.Channel, options...); err != nil {
+		_, ts, err := b.client.PostMessageContext(ctx, event.Channel, options...)
+		if err != nil {
 			return fmt.Errorf("while posting Slack message: %w", err)
 		}
+		if err := uploadAttachmentsToSlack(ctx, b.client, event, ts, resp.Attachments); err != nil {
+			return err
+		}
 	}
 
 	b.log.Debugf("Message successfully sent to channel %q", event.Channel)
 	return nil
 }
 
+func (b *CloudSlack) newMessageStreamer(event slackMessage) *slackMessageStreamer {
+	return &slackMessageStreamer{
+		client: b.client,
+		event:  event,
+		render: func(msg interactive.CoreMessage) slack.MsgOption {
+			msg.ReplaceBotNamePlaceholder(b.BotName(), api.BotNameWithClusterName(b.clusterName))
+			return b.renderer.RenderInteractiveMessage(msg)
+		},
+	}
+}
+
 func (b *CloudSlack) findAndTrimBotMention(msg string) (string, bool) {
 	if !b.botMentionRegex.MatchString(msg) {
 		return "", false
@@ -637,6 +698,13 @@ func (b *CloudSlack) setChannels(channels map[string]channelConfigByName) {
 	b.channels = channels
 }
 
+// UpdateBindings replaces bindings of the configured channels.
+func (b *CloudSlack) UpdateBindings(cfg config.Communications) {
+	b.channelsMutex.Lock()
+	defer b.channelsMutex.Unlock()
+	b.channels = channelsByNameWithBindings(b.channels, cfg.CloudSlack.Channels)
+}
+
 func (b *CloudSlack) getChannelsToNotify(sourceBindings []string) []string {
 	var out []string
 	for _, cfg := range b.getChannels() {
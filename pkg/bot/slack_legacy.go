@@ -17,6 +17,7 @@ import (
 	"github.com/kubeshop/botkube/pkg/execute"
 	"github.com/kubeshop/botkube/pkg/execute/command"
 	"github.com/kubeshop/botkube/pkg/multierror"
+	"github.com/kubeshop/botkube/pkg/notifier"
 	"github.com/kubeshop/botkube/pkg/sliceutil"
 )
 
@@ -36,7 +37,10 @@ const (
 	slackMaxMessageSize = 3001
 )
 
-var _ Bot = &Slack{}
+var (
+	_ Bot             = &Slack{}
+	_ BindingsUpdater = &Slack{}
+)
 
 // Slack listens for user's message, execute commands and sends back the response.
 type Slack struct {
@@ -255,6 +259,7 @@ func (b *Slack) handleMessage(ctx context.Context, msg slackLegacyMessage) error
 		CommGroupName:   b.commGroupName,
 		Platform:        b.IntegrationName(),
 		NotifierHandler: b,
+		Bot:             b,
 		Conversation: execute.Conversation{
 			Alias:            channel.alias,
 			ID:               channel.Identifier(),
@@ -267,6 +272,7 @@ func (b *Slack) handleMessage(ctx context.Context, msg slackLegacyMessage) error
 		User: execute.UserInput{
 			Mention:     fmt.Sprintf("<@%s>", msg.User),
 			DisplayName: msg.User, // this integration is officially not supported, so no need to ensure it has a nice display name
+			ID:          msg.User,
 		},
 	})
 	response := e.Execute(ctx)
@@ -336,9 +342,13 @@ func (b *Slack) getChannelsToNotify(sourceBindings []string) []string {
 }
 
 // SendMessage sends message to selected Slack channels.
-func (b *Slack) SendMessage(ctx context.Context, msg interactive.CoreMessage, sourceBindings []string) error {
+func (b *Slack) SendMessage(ctx context.Context, msg interactive.CoreMessage, sourceBindings []string, receivers *notifier.Receivers) error {
 	errs := multierror.New()
 	for _, channelName := range b.getChannelsToNotify(sourceBindings) {
+		if !receivers.AllowsChannel(channelName) {
+			b.log.Debugf("Skipping notification for channel %q as it's not selected by routing rules", channelName)
+			continue
+		}
 		msgMetadata := slackLegacyMessage{
 			Channel:         channelName,
 			ThreadTimeStamp: "",
@@ -353,6 +363,11 @@ func (b *Slack) SendMessage(ctx context.Context, msg interactive.CoreMessage, so
 	return errs.ErrorOrNil()
 }
 
+// PostMessage sends message to a given Slack channel.
+func (b *Slack) PostMessage(ctx context.Context, channelName string, msg interactive.CoreMessage) error {
+	return b.send(ctx, slackLegacyMessage{Channel: channelName}, msg, false)
+}
+
 // SendMessageToAll sends message to all Slack channels.
 func (b *Slack) SendMessageToAll(ctx context.Context, msg interactive.CoreMessage) error {
 	errs := multierror.New()
@@ -389,6 +404,13 @@ func (b *Slack) setChannels(channels map[string]channelConfigByName) {
 	b.channels = channels
 }
 
+// UpdateBindings replaces bindings of the configured channels.
+func (b *Slack) UpdateBindings(cfg config.Communications) {
+	b.channelsMutex.Lock()
+	defer b.channelsMutex.Unlock()
+	b.channels = channelsByNameWithBindings(b.channels, cfg.Slack.Channels)
+}
+
 func (b *Slack) findAndTrimBotMention(msg string) (string, bool) {
 	if !b.botMentionRegex.MatchString(msg) {
 		return "", false
@@ -1,14 +1,20 @@
 package bot
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"regexp"
+	"strings"
 
 	"github.com/sirupsen/logrus"
 	"github.com/slack-go/slack"
 
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
 	"github.com/kubeshop/botkube/pkg/config"
 	conversationx "github.com/kubeshop/botkube/pkg/conversation"
+	"github.com/kubeshop/botkube/pkg/execute"
 	"github.com/kubeshop/botkube/pkg/execute/command"
 )
 
@@ -61,6 +67,7 @@ type slackMessage struct {
 	ThreadTimeStamp string
 	UserID          string
 	UserName        string
+	UserEmail       string
 	TriggerID       string
 	CommandOrigin   command.Origin
 	State           *slack.BlockActionStates
@@ -68,3 +75,152 @@ type slackMessage struct {
 	BlockID         string
 	EventTimeStamp  string
 }
+
+// slackUserDetails contains cached details about a Slack user.
+type slackUserDetails struct {
+	realName string
+	email    string
+}
+
+// threadReplyIfRequested returns the event with the thread set to the event message,
+// so the response is posted in its thread if it's requested by the message.
+func threadReplyIfRequested(event slackMessage, resp interactive.CoreMessage) slackMessage {
+	if resp.ReplyInThread && event.ThreadTimeStamp == "" {
+		event.ThreadTimeStamp = event.EventTimeStamp
+	}
+	return event
+}
+
+var _ execute.FanOutThread = &slackFanOutThread{}
+
+// slackTextUnescaper reverts the escaping which Slack applies to the text of messages.
+var slackTextUnescaper = strings.NewReplacer("&lt;", "<", "&gt;", ">", "&amp;", "&")
+
+// slackFanOutThread posts and reads replies in the thread of a given command message.
+// Reading replies requires the `channels:history` scope, or `groups:history` for private channels.
+type slackFanOutThread struct {
+	client *slack.Client
+	event  slackMessage
+}
+
+func (t *slackFanOutThread) threadTimeStamp() string {
+	if t.event.ThreadTimeStamp != "" {
+		return t.event.ThreadTimeStamp
+	}
+	return t.event.EventTimeStamp
+}
+
+// PostThreadReply posts a plain text reply in the thread of the command message.
+func (t *slackFanOutThread) PostThreadReply(ctx context.Context, text string) error {
+	_, _, err := t.client.PostMessageContext(ctx, t.event.Channel, slack.MsgOptionText(text, false), slack.MsgOptionTS(t.threadTimeStamp()))
+	if err != nil {
+		return fmt.Errorf("while posting Slack message: %w", slackError(err, t.event.Channel))
+	}
+	return nil
+}
+
+// ThreadReplies returns texts of the replies posted in the thread after the command message.
+func (t *slackFanOutThread) ThreadReplies(ctx context.Context) ([]string, error) {
+	params := &slack.GetConversationRepliesParameters{
+		ChannelID: t.event.Channel,
+		Timestamp: t.threadTimeStamp(),
+		Oldest:    t.event.EventTimeStamp,
+	}
+
+	var out []string
+	for {
+		msgs, hasMore, cursor, err := t.client.GetConversationRepliesContext(ctx, params)
+		if err != nil {
+			return nil, fmt.Errorf("while getting Slack thread replies: %w", slackError(err, t.event.Channel))
+		}
+		for _, msg := range msgs {
+			if msg.Timestamp == t.event.EventTimeStamp || msg.Timestamp == params.Timestamp {
+				continue
+			}
+			out = append(out, slackTextUnescaper.Replace(msg.Text))
+		}
+		if !hasMore || cursor == "" {
+			return out, nil
+		}
+		params.Cursor = cursor
+	}
+}
+
+var _ execute.EditableMessageStreamer = &slackMessageStreamer{}
+
+// slackMessageStreamer posts and updates the live output of streamed commands in the conversation or thread of a given message.
+type slackMessageStreamer struct {
+	client *slack.Client
+	event  slackMessage
+	render func(msg interactive.CoreMessage) slack.MsgOption
+}
+
+// PostStreamMessage posts a new message and returns its timestamp, which identifies it.
+func (s *slackMessageStreamer) PostStreamMessage(ctx context.Context, msg interactive.CoreMessage) (string, error) {
+	options := []slack.MsgOption{s.render(msg)}
+	if s.event.ThreadTimeStamp != "" {
+		options = append(options, slack.MsgOptionTS(s.event.ThreadTimeStamp))
+	}
+
+	_, ts, err := s.client.PostMessageContext(ctx, s.event.Channel, options...)
+	if err != nil {
+		return "", fmt.Errorf("while posting Slack message: %w", slackError(err, s.event.Channel))
+	}
+	if err := uploadAttachmentsToSlack(ctx, s.client, s.event, ts, msg.Attachments); err != nil {
+		return "", err
+	}
+	return ts, nil
+}
+
+// UpdateStreamMessage replaces the content of a message with a given timestamp.
+func (s *slackMessageStreamer) UpdateStreamMessage(ctx context.Context, msgID string, msg interactive.CoreMessage) error {
+	if _, _, _, err := s.client.UpdateMessageContext(ctx, s.event.Channel, msgID, s.render(msg)); err != nil {
+		return fmt.Errorf("while updating Slack message: %w", slackError(err, s.event.Channel))
+	}
+	return nil
+}
+
+// uploadAttachmentsToSlack uploads files attached to a message. They are posted in the thread of the message with a given timestamp,
+// unless the message was already sent in a thread.
+func uploadAttachmentsToSlack(ctx context.Context, client *slack.Client, event slackMessage, msgTS string, attachments []api.Attachment) error {
+	threadTS := event.ThreadTimeStamp
+	if threadTS == "" {
+		threadTS = msgTS
+	}
+
+	return uploadFilesToSlack(ctx, client, event.Channel, threadTS, attachments)
+}
+
+// uploadAttachmentsToSlackDM uploads files attached to a message visible only to a given user.
+// Files shared in a channel are visible to all its members, so they are sent as a direct message instead.
+func uploadAttachmentsToSlackDM(ctx context.Context, client *slack.Client, userID string, attachments []api.Attachment) error {
+	if len(attachments) == 0 {
+		return nil
+	}
+
+	channel, _, _, err := client.OpenConversationContext(ctx, &slack.OpenConversationParameters{
+		Users: []string{userID},
+	})
+	if err != nil {
+		return fmt.Errorf("while opening direct message conversation: %w", err)
+	}
+
+	return uploadFilesToSlack(ctx, client, channel.ID, "", attachments)
+}
+
+func uploadFilesToSlack(ctx context.Context, client *slack.Client, channel, threadTS string, attachments []api.Attachment) error {
+	for _, attachment := range attachments {
+		_, err := client.UploadFileV2Context(ctx, slack.UploadFileV2Parameters{
+			Filename:        attachment.Name,
+			Title:           attachment.Name,
+			Reader:          bytes.NewReader(attachment.Data),
+			FileSize:        len(attachment.Data),
+			Channel:         channel,
+			ThreadTimestamp: threadTS,
+		})
+		if err != nil {
+			return fmt.Errorf("while uploading %q attachment: %w", attachment.Name, slackError(err, channel))
+		}
+	}
+	return nil
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/kubeshop/botkube/pkg/execute/command"
 	"github.com/kubeshop/botkube/pkg/formatx"
 	"github.com/kubeshop/botkube/pkg/multierror"
+	"github.com/kubeshop/botkube/pkg/notifier"
 	"github.com/kubeshop/botkube/pkg/sliceutil"
 )
 
@@ -30,7 +31,10 @@ import (
 //    - split to multiple files in a separate package,
 //    - review all the methods and see if they can be simplified.
 
-var _ Bot = &SocketSlack{}
+var (
+	_ Bot             = &SocketSlack{}
+	_ BindingsUpdater = &SocketSlack{}
+)
 
 // SocketSlack listens for user's message, execute commands and sends back the response.
 type SocketSlack struct {
@@ -45,11 +49,13 @@ type SocketSlack struct {
 	botMentionRegex  *regexp.Regexp
 	commGroupName    string
 	renderer         *SlackRenderer
-	realNamesForID   map[string]string
+	usersForID       map[string]slackUserDetails
 	msgStatusTracker *SlackMessageStatusTracker
 	messages         chan slackMessage
 	messageWorkers   *pool.Pool
 	shutdownOnce     sync.Once
+	digest           *notificationDigest
+	quietHours       *channelQuietHours
 }
 
 // socketSlackAnalyticsReporter defines a reporter that collects analytics data.
@@ -59,7 +65,7 @@ type socketSlackAnalyticsReporter interface {
 }
 
 // NewSocketSlack creates a new SocketSlack instance.
-func NewSocketSlack(log logrus.FieldLogger, commGroupName string, cfg config.SocketSlack, executorFactory ExecutorFactory, reporter socketSlackAnalyticsReporter) (*SocketSlack, error) {
+func NewSocketSlack(log logrus.FieldLogger, commGroupName string, cfg config.SocketSlack, executorFactory ExecutorFactory, reporter socketSlackAnalyticsReporter, cfgManager DigestPersistenceManager) (*SocketSlack, error) {
 	client := slack.New(cfg.BotToken, slack.OptionAppLevelToken(cfg.AppToken))
 
 	authResp, err := client.AuthTest()
@@ -88,10 +94,12 @@ func NewSocketSlack(log logrus.FieldLogger, commGroupName string, cfg config.Soc
 		commGroupName:    commGroupName,
 		renderer:         NewSlackRenderer(),
 		botMentionRegex:  botMentionRegex,
-		realNamesForID:   map[string]string{},
+		usersForID:       map[string]slackUserDetails{},
 		msgStatusTracker: NewSlackMessageStatusTracker(log, client),
 		messages:         make(chan slackMessage, platformMessageChannelSize),
 		messageWorkers:   pool.New().WithMaxGoroutines(platformMessageWorkersCount),
+		digest:           newNotificationDigest(log, cfgManager, commGroupName, config.SocketSlackCommPlatformIntegration, notificationsByName(channels)),
+		quietHours:       newChannelQuietHours(log, notificationsByName(channels)),
 	}, nil
 }
 
@@ -113,6 +121,7 @@ func (b *SocketSlack) Start(ctx context.Context) error {
 	}()
 
 	go b.startMessageProcessor(ctx)
+	go b.digest.Run(ctx, b.sendDigest)
 
 	for {
 		select {
@@ -142,14 +151,15 @@ func (b *SocketSlack) Start(ctx context.Context) error {
 					switch ev := innerEvent.Data.(type) {
 					case *slackevents.AppMentionEvent:
 						b.log.Debugf("Got app mention %s", formatx.StructDumper().Sdump(innerEvent))
-						userName := b.getRealNameWithFallbackToUserID(ctx, ev.User)
+						user := b.getUserDetailsWithFallbackToUserID(ctx, ev.User)
 						msg := slackMessage{
 							Text:            ev.Text,
 							Channel:         ev.Channel,
 							ThreadTimeStamp: ev.ThreadTimeStamp,
 							EventTimeStamp:  ev.EventTimeStamp,
 							UserID:          ev.User,
-							UserName:        userName,
+							UserName:        user.realName,
+							UserEmail:       user.email,
 							CommandOrigin:   command.TypedOrigin,
 						}
 
@@ -202,14 +212,15 @@ func (b *SocketSlack) Start(ctx context.Context) error {
 
 					state := removeBotNameFromIDs(b.BotName(), callback.BlockActionState)
 
-					userName := b.getRealNameWithFallbackToUserID(ctx, callback.User.ID)
+					user := b.getUserDetailsWithFallbackToUserID(ctx, callback.User.ID)
 					msg := slackMessage{
 						Text:            cmd,
 						Channel:         channelID,
 						ThreadTimeStamp: threadTs,
 						TriggerID:       callback.TriggerID,
 						UserID:          callback.User.ID,
-						UserName:        userName,
+						UserName:        user.realName,
+						UserEmail:       user.email,
 						CommandOrigin:   cmdOrigin,
 						State:           state,
 						EventTimeStamp:  callback.Message.Timestamp,
@@ -225,12 +236,13 @@ func (b *SocketSlack) Start(ctx context.Context) error {
 							act.ActionID = actID // normalize event
 
 							cmd, cmdOrigin := resolveBlockActionCommand(act)
-							userName := b.getRealNameWithFallbackToUserID(ctx, callback.User.ID)
+							user := b.getUserDetailsWithFallbackToUserID(ctx, callback.User.ID)
 							msg := slackMessage{
 								Text:           cmd,
 								Channel:        callback.View.PrivateMetadata,
 								UserID:         callback.User.ID,
-								UserName:       userName,
+								UserName:       user.realName,
+								UserEmail:      user.email,
 								EventTimeStamp: "", // there is no timestamp for interactive modals
 								CommandOrigin:  cmdOrigin,
 							}
@@ -362,6 +374,8 @@ func (b *SocketSlack) handleMessage(ctx context.Context, event slackMessage) err
 		CommGroupName:   b.commGroupName,
 		Platform:        b.IntegrationName(),
 		NotifierHandler: b,
+		MessageStreamer: b.newMessageStreamer(event),
+		FanOutThread:    &slackFanOutThread{client: b.client, event: event},
 		Conversation: execute.Conversation{
 			Alias:            channel.alias,
 			ID:               channel.Identifier(),
@@ -376,6 +390,8 @@ func (b *SocketSlack) handleMessage(ctx context.Context, event slackMessage) err
 		User: execute.UserInput{
 			Mention:     fmt.Sprintf("<@%s>", event.UserID),
 			DisplayName: event.UserName,
+			ID:          event.UserID,
+			Email:       event.UserEmail,
 		},
 	})
 
@@ -396,6 +412,7 @@ func (b *SocketSlack) handleMessage(ctx context.Context, event slackMessage) err
 func (b *SocketSlack) send(ctx context.Context, event slackMessage, resp interactive.CoreMessage) error {
 	b.log.Debugf("Sending message to channel %q: %+v", event.Channel, resp)
 
+	event = threadReplyIfRequested(event, resp)
 	resp.ReplaceBotNamePlaceholder(b.BotName())
 	markdown := b.renderer.MessageToMarkdown(resp)
 
@@ -414,6 +431,7 @@ func (b *SocketSlack) send(ctx context.Context, event slackMessage, resp interac
 		resp = interactive.CoreMessage{
 			Message: api.Message{
 				PlaintextInputs: resp.PlaintextInputs,
+				Attachments:     resp.Attachments,
 			},
 		}
 	}
@@ -445,10 +463,18 @@ func (b *SocketSlack) send(ctx context.Context, event slackMessage, resp interac
 		if _, err := b.client.PostEphemeralContext(ctx, event.Channel, event.UserID, options...); err != nil {
 			return fmt.Errorf("while posting Slack message visible only to user: %w", err)
 		}
+		// files are always visible to everyone in the channel, so they are sent to the user directly
+		if err := uploadAttachmentsToSlackDM(ctx, b.client, event.UserID, resp.Attachments); err != nil {
+			return err
+		}
 	} else {
-		if _, _, err := b.client.PostMessageContext(ctx, event.Channel, options...); err != nil {
+		_, ts, err := b.client.PostMessageContext(ctx, event.Channel, options...)
+		if err != nil {
 			return fmt.Errorf("while posting Slack message: %w", slackError(err, event.Channel))
 		}
+		if err := uploadAttachmentsToSlack(ctx, b.client, event, ts, resp.Attachments); err != nil {
+			return err
+		}
 	}
 
 	b.log.Debugf("Message successfully sent to channel %q", event.Channel)
@@ -473,9 +499,20 @@ func (b *SocketSlack) getChannelsToNotify(sourceBindings []string) []string {
 }
 
 // SendMessage sends message with interactive sections to selected Slack channels.
-func (b *SocketSlack) SendMessage(ctx context.Context, msg interactive.CoreMessage, sourceBindings []string) error {
+func (b *SocketSlack) SendMessage(ctx context.Context, msg interactive.CoreMessage, sourceBindings []string, receivers *notifier.Receivers) error {
 	errs := multierror.New()
 	for _, channelName := range b.getChannelsToNotify(sourceBindings) {
+		if !receivers.AllowsChannel(channelName) {
+			b.log.Debugf("Skipping notification for channel %q as it's not selected by routing rules", channelName)
+			continue
+		}
+		if b.quietHours.IsActive(channelName) {
+			b.log.Debugf("Skipping notification for channel %q due to quiet hours", channelName)
+			continue
+		}
+		if b.digest.Add(channelName, msg, sourceBindings) {
+			continue
+		}
 		msgMetadata := slackMessage{
 			Channel:         channelName,
 			ThreadTimeStamp: "",
@@ -491,6 +528,13 @@ func (b *SocketSlack) SendMessage(ctx context.Context, msg interactive.CoreMessa
 	return errs.ErrorOrNil()
 }
 
+func (b *SocketSlack) sendDigest(ctx context.Context, channelName string, msg interactive.CoreMessage) error {
+	return b.send(ctx, slackMessage{
+		Channel: channelName,
+		BlockID: uuid.New().String(),
+	}, msg)
+}
+
 // SendMessageToAll sends message with interactive sections to all Slack channels.
 func (b *SocketSlack) SendMessageToAll(ctx context.Context, msg interactive.CoreMessage) error {
 	errs := multierror.New()
@@ -527,6 +571,13 @@ func (b *SocketSlack) setChannels(channels map[string]channelConfigByName) {
 	b.channels = channels
 }
 
+// UpdateBindings replaces bindings of the configured channels.
+func (b *SocketSlack) UpdateBindings(cfg config.Communications) {
+	b.channelsMutex.Lock()
+	defer b.channelsMutex.Unlock()
+	b.channels = channelsByNameWithBindings(b.channels, cfg.SocketSlack.Channels)
+}
+
 func (b *SocketSlack) findAndTrimBotMention(msg string) (string, bool) {
 	if !b.botMentionRegex.MatchString(msg) {
 		return "", false
@@ -564,6 +615,17 @@ func resolveBlockActionCommand(act slack.BlockAction) (string, command.Origin) {
 	return cmd, cmdOrigin
 }
 
+func (b *SocketSlack) newMessageStreamer(event slackMessage) *slackMessageStreamer {
+	return &slackMessageStreamer{
+		client: b.client,
+		event:  event,
+		render: func(msg interactive.CoreMessage) slack.MsgOption {
+			msg.ReplaceBotNamePlaceholder(b.BotName())
+			return b.renderer.RenderInteractiveMessage(msg)
+		},
+	}
+}
+
 func (b *SocketSlack) getThreadOptionIfNeeded(event slackMessage, file *slack.File) slack.MsgOption {
 	//if the message is from thread then add an option to return the response to the thread
 	if event.ThreadTimeStamp != "" {
@@ -584,22 +646,27 @@ func (b *SocketSlack) getThreadOptionIfNeeded(event slackMessage, file *slack.Fi
 	return nil
 }
 
-func (b *SocketSlack) getRealNameWithFallbackToUserID(ctx context.Context, userID string) string {
-	realName, exists := b.realNamesForID[userID]
+func (b *SocketSlack) getUserDetailsWithFallbackToUserID(ctx context.Context, userID string) slackUserDetails {
+	details, exists := b.usersForID[userID]
 	if exists {
-		return realName
+		return details
 	}
 
 	user, err := b.client.GetUserInfoContext(ctx, userID)
 	if err != nil {
 		b.log.Errorf("while getting user info: %s", err.Error())
-		return userID
+		return slackUserDetails{realName: userID}
 	}
 
 	if user == nil || user.RealName == "" {
-		return userID
+		return slackUserDetails{realName: userID}
 	}
 
-	b.realNamesForID[userID] = user.RealName
-	return user.RealName
+	details = slackUserDetails{
+		realName: user.RealName,
+		// Email is returned only if the app has the `users:read.email` scope.
+		email: user.Profile.Email,
+	}
+	b.usersForID[userID] = details
+	return details
 }
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/url"
 	"regexp"
+	"strings"
 	"sync"
 
 	"github.com/gorilla/mux"
@@ -23,6 +24,7 @@ import (
 	"github.com/kubeshop/botkube/pkg/execute"
 	"github.com/kubeshop/botkube/pkg/execute/command"
 	"github.com/kubeshop/botkube/pkg/multierror"
+	"github.com/kubeshop/botkube/pkg/notifier"
 	"github.com/kubeshop/botkube/pkg/sliceutil"
 )
 
@@ -36,6 +38,7 @@ const (
 	defaultPort        = "3978"
 	longRespNotice     = "Response is too long. Sending last few lines. Please send DM to Botkube to get complete response."
 	convTypePersonal   = "personal"
+	convTypeChannel    = "channel"
 	contentTypeCard    = "application/vnd.microsoft.card.adaptive"
 	contentTypeFile    = "application/vnd.microsoft.teams.card.file.consent"
 	responseFileName   = "response.txt"
@@ -43,11 +46,17 @@ const (
 	activityAccept     = "accept"
 	activityUploadInfo = "uploadInfo"
 
+	// teamsMessageIDSuffix is appended to the channel conversation ID to address the thread of a given message.
+	teamsMessageIDSuffix = ";messageid="
+
 	// teamsMaxMessageSize max size before a message should be uploaded as a file.
 	teamsMaxMessageSize = 15700
 )
 
-var _ Bot = &Teams{}
+var (
+	_ Bot             = &Teams{}
+	_ BindingsUpdater = &Teams{}
+)
 
 const teamsBotMentionPrefixFmt = "^<at>%s</at>"
 
@@ -66,6 +75,7 @@ type Teams struct {
 	reporter        AnalyticsReporter
 	// TODO: Be consistent with other communicators when Teams supports multiple channels
 	//channels map[string][ChannelBindingsByName]
+	bindingsMutex      sync.RWMutex
 	bindings           config.BotBindings
 	conversationsMutex sync.RWMutex
 	commGroupName      string
@@ -73,22 +83,28 @@ type Teams struct {
 	notifyMutex        sync.Mutex
 	botMentionRegex    *regexp.Regexp
 
-	botName     string
-	AppID       string
-	AppPassword string
-	MessagePath string
-	Port        string
-	ClusterName string
-	Adapter     core.Adapter
-	renderer    *TeamsRenderer
+	botName      string
+	AppID        string
+	AppPassword  string
+	MessagePath  string
+	Port         string
+	ClusterName  string
+	Adapter      core.Adapter
+	renderer     *TeamsRenderer
+	members      *teamsMemberResolver
+	pendingFiles *teamsPendingFiles
+
+	notification config.TeamsNotification
+	digest       *notificationDigest
 }
 
 type consentContext struct {
 	Command string
+	FileID  string
 }
 
 // NewTeams creates a new Teams instance.
-func NewTeams(log logrus.FieldLogger, commGroupName string, cfg config.Teams, clusterName string, executorFactory ExecutorFactory, reporter AnalyticsReporter) (*Teams, error) {
+func NewTeams(log logrus.FieldLogger, commGroupName string, cfg config.Teams, clusterName string, executorFactory ExecutorFactory, reporter AnalyticsReporter, cfgManager DigestPersistenceManager) (*Teams, error) {
 	botMentionRegex, err := teamsBotMentionRegex(cfg.BotName)
 	if err != nil {
 		return nil, err
@@ -116,8 +132,12 @@ func NewTeams(log logrus.FieldLogger, commGroupName string, cfg config.Teams, cl
 		MessagePath:     msgPath,
 		Port:            port,
 		renderer:        NewTeamsRenderer(),
+		members:         newTeamsMemberResolver(cfg.AppID, cfg.AppPassword),
+		pendingFiles:    newTeamsPendingFiles(),
 		conversations:   make(map[string]conversation),
 		botMentionRegex: botMentionRegex,
+		notification:    cfg.Notification,
+		digest:          newDynamicNotificationDigest(log, cfgManager, commGroupName, config.TeamsCommPlatformIntegration),
 	}, nil
 }
 
@@ -144,6 +164,10 @@ func (b *Teams) Start(ctx context.Context) error {
 		return fmt.Errorf("while reporting analytics: %w", err)
 	}
 
+	if b.notification.ChannelNotification().IsDigest() {
+		go b.digest.Run(ctx, b.sendDigest)
+	}
+
 	srv := httpx.NewServer(b.log, addr, router)
 	err = srv.Serve(ctx)
 	if err != nil {
@@ -172,7 +196,10 @@ func (b *Teams) processActivity(w http.ResponseWriter, req *http.Request) {
 
 	err = b.Adapter.ProcessActivity(ctx, activity, coreActivity.HandlerFuncs{
 		OnMessageFunc: func(turn *coreActivity.TurnContext) (schema.Activity, error) {
-			n, resp := b.processMessage(ctx, turn.Activity)
+			n, resp, msg := b.processMessage(ctx, turn.Activity)
+			if msg.ReplyInThread {
+				turn.Activity.Conversation.ID = teamsThreadConversationID(turn.Activity)
+			}
 			if n >= teamsMaxMessageSize {
 				if turn.Activity.Conversation.ConversationType == convTypePersonal {
 					// send file upload request
@@ -193,7 +220,7 @@ func (b *Teams) processActivity(w http.ResponseWriter, req *http.Request) {
 				}
 				resp = fmt.Sprintf("%s\n```\nCluster: %s\n%s", longRespNotice, b.ClusterName, resp[len(resp)-teamsMaxMessageSize:])
 			}
-			return turn.SendActivity(coreActivity.MsgOptionText(resp))
+			return turn.SendActivity(coreActivity.MsgOptionText(resp), b.attachmentsOption(turn.Activity.Conversation.ConversationType, msg.Attachments))
 		},
 
 		// handle invoke events
@@ -206,10 +233,30 @@ func (b *Teams) processActivity(w http.ResponseWriter, req *http.Request) {
 			if turn.Activity.Value["type"] != activityFileUpload {
 				return schema.Activity{}, nil
 			}
-			if turn.Activity.Value["action"] != activityAccept {
+			if turn.Activity.Value["context"] == nil {
 				return schema.Activity{}, nil
 			}
-			if turn.Activity.Value["context"] == nil {
+
+			// Parse context
+			consentCtx := consentContext{}
+			ctxJSON, err := json.Marshal(turn.Activity.Value["context"])
+			if err != nil {
+				return schema.Activity{}, fmt.Errorf("while marshalling activity context: %w", err)
+			}
+			if err := json.Unmarshal(ctxJSON, &consentCtx); err != nil {
+				return schema.Activity{}, fmt.Errorf("while unmarshalling activity context: %w", err)
+			}
+
+			var attachment api.Attachment
+			if consentCtx.FileID != "" {
+				var found bool
+				attachment, found = b.pendingFiles.Pop(consentCtx.FileID)
+				if !found {
+					return schema.Activity{}, nil
+				}
+			}
+
+			if turn.Activity.Value["action"] != activityAccept {
 				return schema.Activity{}, nil
 			}
 
@@ -223,19 +270,12 @@ func (b *Teams) processActivity(w http.ResponseWriter, req *http.Request) {
 				return schema.Activity{}, fmt.Errorf("while unmarshalling activity: %w", err)
 			}
 
-			// Parse context
-			consentCtx := consentContext{}
-			ctxJSON, err := json.Marshal(turn.Activity.Value["context"])
-			if err != nil {
-				return schema.Activity{}, fmt.Errorf("while marshalling activity context: %w", err)
-			}
-			if err := json.Unmarshal(ctxJSON, &consentCtx); err != nil {
-				return schema.Activity{}, fmt.Errorf("while unmarshalling activity context: %w", err)
+			resp := string(attachment.Data)
+			if consentCtx.FileID == "" {
+				activity.Text = consentCtx.Command
+				_, resp, _ = b.processMessage(ctx, activity)
 			}
 
-			activity.Text = consentCtx.Command
-			_, resp := b.processMessage(ctx, activity)
-
 			actJSON, err := json.MarshalIndent(turn.Activity, "", "  ")
 			if err != nil {
 				return schema.Activity{}, fmt.Errorf("while marshalling activity: %w", err)
@@ -268,7 +308,7 @@ func (b *Teams) processActivity(w http.ResponseWriter, req *http.Request) {
 	}
 }
 
-func (b *Teams) processMessage(ctx context.Context, activity schema.Activity) (int, string) {
+func (b *Teams) processMessage(ctx context.Context, activity schema.Activity) (int, string, interactive.CoreMessage) {
 	trimmedMsg := b.trimBotMention(activity.Text)
 
 	// Multicluster is not supported for Teams
@@ -276,28 +316,56 @@ func (b *Teams) processMessage(ctx context.Context, activity schema.Activity) (i
 	ref, err := b.getConversationReferenceFrom(activity)
 	if err != nil {
 		b.log.Errorf("while getting conversation reference: %s", err.Error())
-		return 0, ""
+		return 0, "", interactive.CoreMessage{}
 	}
 
 	e := b.executorFactory.NewDefault(execute.NewDefaultInput{
 		CommGroupName:   b.commGroupName,
 		Platform:        b.IntegrationName(),
 		NotifierHandler: newTeamsNotifMgrForActivity(b, ref),
+		MessageStreamer: &teamsMessageStreamer{b: b, ref: ref},
 		Conversation: execute.Conversation{
 			Alias:            "",
 			IsKnown:          true,
 			ID:               ref.ChannelID,
-			ExecutorBindings: b.bindings.Executors,
-			SourceBindings:   b.bindings.Sources,
+			ExecutorBindings: b.getBindings().Executors,
+			SourceBindings:   b.getBindings().Sources,
 			CommandOrigin:    command.TypedOrigin,
 		},
 		User: execute.UserInput{
 			//Mention:     "", // not used currently
 			DisplayName: activity.From.Name,
+			ID:          activity.From.ID,
+			Email:       b.userEmail(ctx, activity),
 		},
 		Message: trimmedMsg,
 	})
-	return b.convertInteractiveMessage(e.Execute(ctx), false)
+	resp := e.Execute(ctx)
+	n, converted := b.convertInteractiveMessage(resp, false)
+	return n, converted, resp
+}
+
+// teamsThreadConversationID returns the conversation ID of the reply chain started by a given channel activity.
+// Replies sent to such conversation are posted in the thread instead of as a new channel post.
+func teamsThreadConversationID(activity schema.Activity) string {
+	convID := activity.Conversation.ID
+	if activity.Conversation.ConversationType != convTypeChannel || strings.Contains(convID, teamsMessageIDSuffix) {
+		return convID
+	}
+	return convID + teamsMessageIDSuffix + activity.ID
+}
+
+// userEmail returns email of the activity author. It returns an empty string if the email cannot be resolved.
+func (b *Teams) userEmail(ctx context.Context, activity schema.Activity) string {
+	if b.members == nil {
+		return ""
+	}
+	email, err := b.members.Email(ctx, activity.ServiceURL, activity.Conversation.ID, activity.From.ID)
+	if err != nil {
+		b.log.Errorf("while getting user email: %s", err.Error())
+		return ""
+	}
+	return email
 }
 
 func (b *Teams) convertInteractiveMessage(in interactive.CoreMessage, forceMarkdown bool) (int, string) {
@@ -344,7 +412,7 @@ func (b *Teams) putRequest(u string, data []byte) (err error) {
 }
 
 // SendMessage sends message to MS Teams to selected conversations.
-func (b *Teams) SendMessage(ctx context.Context, msg interactive.CoreMessage, sourceBindings []string) error {
+func (b *Teams) SendMessage(ctx context.Context, msg interactive.CoreMessage, sourceBindings []string, receivers *notifier.Receivers) error {
 	msg.ReplaceBotNamePlaceholder(b.BotName())
 	errs := multierror.New()
 
@@ -355,6 +423,13 @@ func (b *Teams) SendMessage(ctx context.Context, msg interactive.CoreMessage, so
 
 	for _, ref := range b.getConversationRefsToNotify(sourceBindings) {
 		channelID := ref.ChannelID
+		if !receivers.AllowsChannel(channelID) {
+			b.log.Debugf("Skipping notification for channel %q as it's not selected by routing rules", channelID)
+			continue
+		}
+		if b.digest.Add(channelID, msg, sourceBindings) {
+			continue
+		}
 		b.log.Debugf("Sending message to channel %q", channelID)
 		err := b.Adapter.ProactiveMessage(ctx, ref, coreActivity.HandlerFuncs{
 			OnMessageFunc: func(turn *coreActivity.TurnContext) (schema.Activity, error) {
@@ -435,7 +510,32 @@ func (b *Teams) SetNotificationsEnabled(enabled bool, ref schema.ConversationRef
 	conv.notify = enabled
 	conversations[ref.ChannelID] = conv
 	b.setConversations(conversations)
+	b.digest.AddChannel(ref.ChannelID, b.notification.ChannelNotification())
+
+	return nil
+}
+
+func (b *Teams) sendDigest(ctx context.Context, channelID string, msg interactive.CoreMessage) error {
+	conv, found := b.getConversations()[channelID]
+	if !found || !conv.notify {
+		b.log.Debugf("Skipping digest for channel %q as notifications are disabled.", channelID)
+		return nil
+	}
+
+	msg.ReplaceBotNamePlaceholder(b.BotName())
+	activityMsg, err := b.renderMessage(msg)
+	if err != nil {
+		return err
+	}
 
+	err = b.Adapter.ProactiveMessage(ctx, conv.ref, coreActivity.HandlerFuncs{
+		OnMessageFunc: func(turn *coreActivity.TurnContext) (schema.Activity, error) {
+			return turn.SendActivity(activityMsg)
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("while sending Teams digest to channel %q: %w", channelID, err)
+	}
 	return nil
 }
 
@@ -474,7 +574,7 @@ func (b *Teams) getConversationRefsToNotify(sourceBindings []string) []schema.Co
 			continue
 		}
 
-		if !sliceutil.Intersect(sourceBindings, b.bindings.Sources) {
+		if !sliceutil.Intersect(sourceBindings, b.getBindings().Sources) {
 			continue
 		}
 
@@ -495,6 +595,19 @@ func (b *Teams) setConversations(conversations map[string]conversation) {
 	b.conversations = conversations
 }
 
+func (b *Teams) getBindings() config.BotBindings {
+	b.bindingsMutex.RLock()
+	defer b.bindingsMutex.RUnlock()
+	return b.bindings
+}
+
+// UpdateBindings replaces bindings of the MS Teams conversations.
+func (b *Teams) UpdateBindings(cfg config.Communications) {
+	b.bindingsMutex.Lock()
+	defer b.bindingsMutex.Unlock()
+	b.bindings = cfg.Teams.Bindings
+}
+
 // The whole integration should be rewritten using a different library. See the TODO on the top of the file.
 func (b *Teams) getConversationReferenceFrom(activity schema.Activity) (schema.ConversationReference, error) {
 	// Such ref has the ChannelID property always set to `msteams`. Why? ¯\_(ツ)_/¯
@@ -575,3 +688,30 @@ var emojiMapping = map[string]string{
 	":large_green_circle:":      "🟢",
 	":new:":                     "🆕",
 }
+
+var _ execute.MessageStreamer = &teamsMessageStreamer{}
+
+// teamsMessageStreamer posts the live output of streamed commands in chunks, as bot messages cannot be edited.
+type teamsMessageStreamer struct {
+	b   *Teams
+	ref schema.ConversationReference
+}
+
+// PostStreamMessage posts a new message in the conversation. The returned ID is always empty.
+func (s *teamsMessageStreamer) PostStreamMessage(ctx context.Context, msg interactive.CoreMessage) (string, error) {
+	msg.ReplaceBotNamePlaceholder(s.b.BotName())
+	activityMsg, err := s.b.renderMessage(msg)
+	if err != nil {
+		return "", err
+	}
+
+	err = s.b.Adapter.ProactiveMessage(ctx, s.ref, coreActivity.HandlerFuncs{
+		OnMessageFunc: func(turn *coreActivity.TurnContext) (schema.Activity, error) {
+			return turn.SendActivity(activityMsg, s.b.attachmentsOption(s.ref.Conversation.ConversationType, msg.Attachments))
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("while sending Teams message: %w", err)
+	}
+	return "", nil
+}
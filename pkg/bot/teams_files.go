@@ -0,0 +1,78 @@
+package bot
+
+import (
+	"sync"
+
+	"github.com/google/uuid"
+	coreActivity "github.com/infracloudio/msbotbuilder-go/core/activity"
+	"github.com/infracloudio/msbotbuilder-go/schema"
+
+	"github.com/kubeshop/botkube/pkg/api"
+)
+
+const teamsAttachmentsInChannelNotice = "The full output was attached as a file, but MS Teams allows bots to upload files only in personal chats. Send the command in a direct message to Botkube to get it."
+
+// teamsPendingFiles holds attachments waiting for the user's consent to be uploaded to their OneDrive.
+// See: https://learn.microsoft.com/en-us/microsoftteams/platform/bots/how-to/bots-filesv4#use-the-teams-bot-apis
+type teamsPendingFiles struct {
+	mu    sync.Mutex
+	files map[string]api.Attachment
+}
+
+func newTeamsPendingFiles() *teamsPendingFiles {
+	return &teamsPendingFiles{
+		files: map[string]api.Attachment{},
+	}
+}
+
+// Add stores a given attachment and returns its ID.
+func (f *teamsPendingFiles) Add(attachment api.Attachment) string {
+	id := uuid.NewString()
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.files[id] = attachment
+	return id
+}
+
+// Pop returns and removes the attachment with a given ID.
+func (f *teamsPendingFiles) Pop(id string) (api.Attachment, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	attachment, found := f.files[id]
+	delete(f.files, id)
+	return attachment, found
+}
+
+// attachmentsOption adds files attached to a message as file consent cards. Once the user accepts a given card,
+// the file is uploaded to their OneDrive. Bots can send file consent cards only in personal chats,
+// so in other conversations a notice is appended to the message text instead.
+func (b *Teams) attachmentsOption(conversationType string, attachments []api.Attachment) coreActivity.MsgOption {
+	return func(activity *schema.Activity) error {
+		if len(attachments) == 0 {
+			return nil
+		}
+
+		if conversationType != convTypePersonal {
+			activity.Text += "\n\n" + teamsAttachmentsInChannelNotice
+			return nil
+		}
+
+		for _, attachment := range attachments {
+			fileCtx := map[string]interface{}{
+				"fileID": b.pendingFiles.Add(attachment),
+			}
+			activity.Attachments = append(activity.Attachments, schema.Attachment{
+				ContentType: contentTypeFile,
+				Name:        attachment.Name,
+				Content: map[string]interface{}{
+					"description":    attachment.Name,
+					"sizeInBytes":    len(attachment.Data),
+					"acceptContext":  fileCtx,
+					"declineContext": fileCtx,
+				},
+			})
+		}
+		return nil
+	}
+}
@@ -0,0 +1,100 @@
+package bot
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/infracloudio/msbotbuilder-go/connector/auth"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+const (
+	teamsTokenURL             = "https://login.microsoftonline.com/botframework.com/oauth2/v2.0/token"
+	teamsMemberRequestTimeout = 5 * time.Second
+)
+
+// teamsMember describes a subset of the Teams channel account returned by the Bot Connector API.
+type teamsMember struct {
+	Email             string `json:"email"`
+	UserPrincipalName string `json:"userPrincipalName"`
+}
+
+// teamsMemberResolver gets user emails from the Bot Connector conversation members API,
+// as incoming activities contain only user IDs and display names.
+type teamsMemberResolver struct {
+	httpCli *http.Client
+
+	mu     sync.Mutex
+	emails map[string]string
+}
+
+func newTeamsMemberResolver(appID, appPassword string) *teamsMemberResolver {
+	creds := clientcredentials.Config{
+		ClientID:     appID,
+		ClientSecret: appPassword,
+		TokenURL:     teamsTokenURL,
+		Scopes:       []string{auth.ToChannelFromBotOauthScope},
+	}
+
+	httpCli := creds.Client(context.Background())
+	httpCli.Timeout = teamsMemberRequestTimeout
+	return &teamsMemberResolver{
+		httpCli: httpCli,
+		emails:  map[string]string{},
+	}
+}
+
+// Email returns email of a given conversation member. Results are cached per user ID.
+func (r *teamsMemberResolver) Email(ctx context.Context, serviceURL, conversationID, userID string) (string, error) {
+	r.mu.Lock()
+	email, found := r.emails[userID]
+	r.mu.Unlock()
+	if found {
+		return email, nil
+	}
+
+	member, err := r.getMember(ctx, serviceURL, conversationID, userID)
+	if err != nil {
+		return "", err
+	}
+
+	email = member.Email
+	if email == "" {
+		email = member.UserPrincipalName
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.emails[userID] = email
+	return email, nil
+}
+
+func (r *teamsMemberResolver) getMember(ctx context.Context, serviceURL, conversationID, userID string) (teamsMember, error) {
+	endpoint := fmt.Sprintf("%s/v3/conversations/%s/members/%s", strings.TrimSuffix(serviceURL, "/"), url.PathEscape(conversationID), url.PathEscape(userID))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return teamsMember{}, err
+	}
+
+	resp, err := r.httpCli.Do(req)
+	if err != nil {
+		return teamsMember{}, fmt.Errorf("while getting conversation member: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return teamsMember{}, fmt.Errorf("got unexpected status code %d while getting conversation member", resp.StatusCode)
+	}
+
+	var member teamsMember
+	if err := json.NewDecoder(resp.Body).Decode(&member); err != nil {
+		return teamsMember{}, fmt.Errorf("while decoding conversation member: %w", err)
+	}
+	return member, nil
+}
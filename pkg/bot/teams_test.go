@@ -3,8 +3,11 @@ package bot
 import (
 	"testing"
 
+	"github.com/infracloudio/msbotbuilder-go/schema"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/pkg/api"
 )
 
 func TestTeams_TrimBotMention(t *testing.T) {
@@ -47,3 +50,105 @@ func TestTeams_TrimBotMention(t *testing.T) {
 		})
 	}
 }
+
+func TestTeams_AttachmentsOption(t *testing.T) {
+	// given
+	attachments := []api.Attachment{
+		{Name: "output.txt", ContentType: "text/plain", Data: []byte("pod-1  Running")},
+	}
+
+	t.Run("Personal chat", func(t *testing.T) {
+		// given
+		b := &Teams{pendingFiles: newTeamsPendingFiles()}
+		activity := schema.Activity{
+			Text:        "Only a preview of the output is shown.",
+			Attachments: []schema.Attachment{{Name: "existing"}},
+		}
+
+		// when
+		err := b.attachmentsOption(convTypePersonal, attachments)(&activity)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "Only a preview of the output is shown.", activity.Text)
+		require.Len(t, activity.Attachments, 2)
+		assert.Equal(t, schema.Attachment{Name: "existing"}, activity.Attachments[0])
+
+		consentCard := activity.Attachments[1]
+		assert.Equal(t, contentTypeFile, consentCard.ContentType)
+		assert.Equal(t, "output.txt", consentCard.Name)
+
+		content, ok := consentCard.Content.(map[string]interface{})
+		require.True(t, ok)
+		assert.Equal(t, len("pod-1  Running"), content["sizeInBytes"])
+		fileCtx, ok := content["acceptContext"].(map[string]interface{})
+		require.True(t, ok)
+		fileID, ok := fileCtx["fileID"].(string)
+		require.True(t, ok)
+
+		file, found := b.pendingFiles.Pop(fileID)
+		require.True(t, found)
+		assert.Equal(t, attachments[0], file)
+		_, found = b.pendingFiles.Pop(fileID)
+		assert.False(t, found)
+	})
+
+	t.Run("Channel", func(t *testing.T) {
+		// given
+		b := &Teams{pendingFiles: newTeamsPendingFiles()}
+		activity := schema.Activity{
+			Text: "Only a preview of the output is shown.",
+		}
+
+		// when
+		err := b.attachmentsOption("channel", attachments)(&activity)
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, activity.Attachments)
+		assert.Equal(t, "Only a preview of the output is shown.\n\n"+teamsAttachmentsInChannelNotice, activity.Text)
+		assert.Empty(t, b.pendingFiles.files)
+	})
+}
+
+func TestTeams_ThreadConversationID(t *testing.T) {
+	tests := []struct {
+		name     string
+		activity schema.Activity
+		expected string
+	}{
+		{
+			name: "Channel post",
+			activity: schema.Activity{
+				ID:           "1668426500000",
+				Conversation: schema.ConversationAccount{ID: "19:abc@thread.tacv2", ConversationType: "channel"},
+			},
+			expected: "19:abc@thread.tacv2;messageid=1668426500000",
+		},
+		{
+			name: "Reply in thread",
+			activity: schema.Activity{
+				ID:           "1668426600000",
+				Conversation: schema.ConversationAccount{ID: "19:abc@thread.tacv2;messageid=1668426500000", ConversationType: "channel"},
+			},
+			expected: "19:abc@thread.tacv2;messageid=1668426500000",
+		},
+		{
+			name: "Personal chat",
+			activity: schema.Activity{
+				ID:           "1668426500000",
+				Conversation: schema.ConversationAccount{ID: "a:1xyz", ConversationType: "personal"},
+			},
+			expected: "a:1xyz",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			actual := teamsThreadConversationID(tc.activity)
+
+			// then
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
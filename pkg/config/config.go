@@ -13,6 +13,8 @@ import (
 	"github.com/knadh/koanf/providers/rawbytes"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
+
+	"github.com/kubeshop/botkube/internal/cron"
 )
 
 //go:embed default.yaml
@@ -124,6 +126,9 @@ type Config struct {
 	Executors      map[string]Executors      `yaml:"executors" validate:"dive"`
 	Aliases        Aliases                   `yaml:"aliases" validate:"dive"`
 	Communications map[string]Communications `yaml:"communications"  validate:"required,min=1,dive"`
+	Routing        Routing                   `yaml:"routing,omitempty"`
+	ChatUsers      ChatUsers                 `yaml:"chatUsers,omitempty"`
+	RateLimits     RateLimits                `yaml:"rateLimits,omitempty"`
 
 	Analytics     Analytics        `yaml:"analytics"`
 	Settings      Settings         `yaml:"settings"`
@@ -241,6 +246,9 @@ type Plugin struct {
 	Enabled bool
 	Config  any
 	Context PluginContext
+	// Streaming enables the live output for executor plugins which support it, such as `kubectl logs -f`.
+	// Commands of other executors are answered with a single message.
+	Streaming bool `yaml:"streaming,omitempty"`
 }
 
 // PluginContext defines the context for given plugin.
@@ -299,11 +307,231 @@ const (
 	StaticPolicySubjectType PolicySubjectType = "Static"
 	// ChannelNamePolicySubjectType is the channel name policy type.
 	ChannelNamePolicySubjectType PolicySubjectType = "ChannelName"
+	// ChatUserPolicySubjectType is the chat user policy type. The Kubernetes user and groups are resolved based on ChatUsers configuration.
+	ChatUserPolicySubjectType PolicySubjectType = "ChatUser"
+)
+
+// UsesChatUser returns true if any of the policy subjects is resolved based on the chat user.
+func (p *PolicyRule) UsesChatUser() bool {
+	if p == nil {
+		return false
+	}
+	return p.User.Type == ChatUserPolicySubjectType || p.Group.Type == ChatUserPolicySubjectType
+}
+
+// RateLimits contains token-bucket limits for executing plugin commands. Limits which are not set are disabled.
+type RateLimits struct {
+	// User limits commands run by a single user.
+	User RateLimit `yaml:"user,omitempty"`
+	// Channel limits commands run in a single channel.
+	Channel RateLimit `yaml:"channel,omitempty"`
+	// Plugins limits commands run with a given executor plugin, e.g. `botkube/kubectl`, across all users and channels.
+	Plugins map[string]RateLimit `yaml:"plugins,omitempty" validate:"dive"`
+}
+
+// RateLimit defines a token bucket. The bucket holds up to Burst commands and it is refilled with Requests commands every Interval.
+type RateLimit struct {
+	Requests int           `yaml:"requests,omitempty" validate:"gte=0"`
+	Interval time.Duration `yaml:"interval,omitempty"`
+	// Burst defaults to Requests.
+	Burst int `yaml:"burst,omitempty" validate:"gte=0"`
+}
+
+// IsEnabled returns true if the limit is set.
+func (r RateLimit) IsEnabled() bool {
+	return r.Requests > 0 && r.Interval > 0
+}
+
+// ChatUsers maps communication platform users to Kubernetes users and groups.
+// The static mappings take precedence over the claims lookup.
+type ChatUsers struct {
+	Mappings     []ChatUserMapping `yaml:"mappings,omitempty" validate:"dive"`
+	ClaimsLookup ClaimsLookup      `yaml:"claimsLookup,omitempty"`
+}
+
+// ChatUserMapping maps a given platform user to the Kubernetes user and groups.
+type ChatUserMapping struct {
+	// PlatformUser is the user ID or email on a given communication platform.
+	PlatformUser string `yaml:"platformUser" validate:"required"`
+
+	// Platform narrows the mapping down to a given communication platform, e.g. "socketSlack". If empty, all platforms match.
+	Platform CommPlatformIntegration `yaml:"platform,omitempty"`
+
+	KubernetesUser   string   `yaml:"kubernetesUser" validate:"required"`
+	KubernetesGroups []string `yaml:"kubernetesGroups,omitempty"`
+}
+
+// ClaimsLookup contains configuration for resolving chat users with an OIDC-style userinfo endpoint.
+// The endpoint must return a JSON object with user and groups claims.
+type ClaimsLookup struct {
+	// URL is a Go template rendered for a given user, e.g. "https://idp.example.com/userinfo?email={{ .Email }}".
+	// Available fields: .ID, .Email, .DisplayName and .Platform. All fields are URL-escaped.
+	// BearerToken can be read from a Secret with the `valueFrom` syntax.
+	URL         string        `yaml:"url,omitempty"`
+	BearerToken string        `yaml:"bearerToken,omitempty"`
+	UserClaim   string        `yaml:"userClaim,omitempty"`
+	GroupsClaim string        `yaml:"groupsClaim,omitempty"`
+	CacheTTL    time.Duration `yaml:"cacheTTL,omitempty"`
+}
+
+const (
+	// DefaultClaimsLookupUserClaim is a default name of the claim with the Kubernetes user name.
+	DefaultClaimsLookupUserClaim = "email"
+	// DefaultClaimsLookupGroupsClaim is a default name of the claim with the Kubernetes groups.
+	DefaultClaimsLookupGroupsClaim = "groups"
+	// DefaultClaimsLookupCacheTTL is a default period of caching resolved users.
+	DefaultClaimsLookupCacheTTL = 5 * time.Minute
 )
 
 // Executors contains executors configuration parameters.
 type Executors struct {
-	Plugins Plugins `yaml:",inline" koanf:",remain"`
+	// Approval defines commands which are executed only after they are approved by another user.
+	Approval ApprovalPolicy `yaml:"approval,omitempty"`
+	Plugins  Plugins        `yaml:",inline" koanf:",remain"`
+}
+
+// DefaultApprovalTTL is a default period after which pending approval requests expire.
+const DefaultApprovalTTL = 15 * time.Minute
+
+// ApprovalPolicy defines commands which require approval before they are executed.
+type ApprovalPolicy struct {
+	// CommandPrefixes matches commands by their prefix, e.g. `kubectl delete`. Flags and their values are ignored,
+	// so `kubectl -n prod delete pod` matches the `kubectl delete` prefix.
+	CommandPrefixes []string `yaml:"commandPrefixes,omitempty"`
+	// CommandRegexes matches the whole command, e.g. `^helm (rollback|uninstall)`.
+	// Commands are also matched without flags placed between the binary and the verb.
+	CommandRegexes []string `yaml:"commandRegexes,omitempty"`
+	// Approvers holds platform user IDs or emails of users who can approve commands.
+	Approvers []string `yaml:"approvers,omitempty"`
+	// TTL defines how long approval requests are pending. Defaults to DefaultApprovalTTL.
+	TTL time.Duration `yaml:"ttl,omitempty"`
+}
+
+// IsEnabled returns true if any command requires approval.
+func (p ApprovalPolicy) IsEnabled() bool {
+	return len(p.CommandPrefixes) > 0 || len(p.CommandRegexes) > 0
+}
+
+// Matches returns true if a given command requires approval.
+func (p ApprovalPolicy) Matches(cmd string) bool {
+	args := strings.Fields(cmd)
+	for _, prefix := range p.CommandPrefixes {
+		if matchesArgsPrefix(args, positionalArgs(strings.Fields(prefix))) {
+			return true
+		}
+	}
+
+	candidates := append([]string{cmd}, withoutLeadingFlags(args)...)
+	for _, expr := range p.CommandRegexes {
+		for _, candidate := range candidates {
+			matched, err := regexp.MatchString(expr, candidate)
+			if err != nil {
+				// invalid expressions are reported by the validator, so it shouldn't happen
+				break
+			}
+			if matched {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// matchesArgsPrefix returns true if positional arguments of a given command start with a given prefix.
+// It's not known which flags take values, so a flag followed by a non-flag argument is checked both ways.
+func matchesArgsPrefix(args, prefix []string) bool {
+	if len(prefix) == 0 || len(args) == 0 || args[0] != prefix[0] {
+		return false
+	}
+
+	type position struct{ arg, prefix int }
+	visited := map[position]struct{}{}
+	var match func(pos position) bool
+	match = func(pos position) bool {
+		if pos.prefix == len(prefix) {
+			return true
+		}
+		if pos.arg >= len(args) {
+			return false
+		}
+		if _, found := visited[pos]; found {
+			return false
+		}
+		visited[pos] = struct{}{}
+
+		arg := args[pos.arg]
+		if !isFlag(arg) {
+			return arg == prefix[pos.prefix] && match(position{pos.arg + 1, pos.prefix + 1})
+		}
+		if match(position{pos.arg + 1, pos.prefix}) {
+			return true
+		}
+		// the flag may take the next argument as its value
+		return flagMayTakeValue(arg, args, pos.arg) && match(position{pos.arg + 2, pos.prefix})
+	}
+	return match(position{1, 1})
+}
+
+// withoutLeadingFlags returns command variants without flags placed between the binary and each possible verb.
+// For example, for `kubectl -n prod delete pod` it returns `kubectl prod delete pod` and `kubectl delete pod`.
+func withoutLeadingFlags(args []string) []string {
+	if len(args) < 2 {
+		return nil
+	}
+
+	var out []string
+	for i := 1; i < len(args); i++ {
+		if isFlag(args[i]) {
+			continue
+		}
+		out = append(out, strings.Join(append([]string{args[0]}, args[i:]...), " "))
+		if !flagMayTakeValue(args[i-1], args, i-1) {
+			// it's not a flag value, so it's the verb
+			break
+		}
+	}
+	return out
+}
+
+// positionalArgs returns arguments without flags.
+func positionalArgs(args []string) []string {
+	var out []string
+	for _, arg := range args {
+		if isFlag(arg) {
+			continue
+		}
+		out = append(out, arg)
+	}
+	return out
+}
+
+func flagMayTakeValue(arg string, args []string, idx int) bool {
+	return isFlag(arg) && arg != "--" && !strings.Contains(arg, "=") && idx+1 < len(args) && !isFlag(args[idx+1])
+}
+
+func isFlag(arg string) bool {
+	return strings.HasPrefix(arg, "-") && len(arg) > 1
+}
+
+// IsApprover returns true if a given user is allowed to approve commands.
+func (p ApprovalPolicy) IsApprover(userID, email string) bool {
+	for _, approver := range p.Approvers {
+		if userID != "" && approver == userID {
+			return true
+		}
+		if email != "" && strings.EqualFold(approver, email) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetTTL returns the approval request TTL.
+func (p ApprovalPolicy) GetTTL() time.Duration {
+	if p.TTL > 0 {
+		return p.TTL
+	}
+	return DefaultApprovalTTL
 }
 
 // CollectCommandPrefixes returns list of command prefixes for all executors, even disabled ones.
@@ -406,7 +634,131 @@ func (r *RegexConstraints) IsAllowed(value string) (bool, error) {
 
 // ChannelNotification contains notification configuration for a given platform.
 type ChannelNotification struct {
-	Disabled bool `yaml:"disabled"`
+	Disabled bool               `yaml:"disabled"`
+	Mode     NotificationMode   `yaml:"mode" validate:"omitempty,oneof=realTime digest"`
+	Digest   DigestNotification `yaml:"digest"`
+
+	// QuietHours defines recurring periods during which notifications are not sent to a given channel.
+	QuietHours []QuietHours `yaml:"quietHours" validate:"dive"`
+}
+
+// NotificationMode defines how notifications are delivered to a given channel.
+type NotificationMode string
+
+const (
+	// RealTimeNotificationMode sends every notification as soon as it is received. It is the default mode.
+	RealTimeNotificationMode NotificationMode = "realTime"
+
+	// DigestNotificationMode buffers notifications and periodically sends a single summary message.
+	DigestNotificationMode NotificationMode = "digest"
+)
+
+// DefaultDigestInterval is a default interval of sending the digest notifications.
+const DefaultDigestInterval = 15 * time.Minute
+
+// DefaultDigestMaxDetails is a default number of the latest notifications listed for every digest group.
+const DefaultDigestMaxDetails = 5
+
+// DigestNotification contains configuration for the digest notification mode.
+type DigestNotification struct {
+	// Interval defines how often the digest is sent. If not specified, DefaultDigestInterval is used.
+	Interval time.Duration `yaml:"interval"`
+
+	// MaxDetails defines how many of the latest notifications are listed for every group. If not specified, DefaultDigestMaxDetails is used.
+	MaxDetails int `yaml:"maxDetails"`
+}
+
+// IsDigest returns true if notifications for a given channel should be sent as digest.
+func (c ChannelNotification) IsDigest() bool {
+	return c.Mode == DigestNotificationMode
+}
+
+// QuietHours defines a recurring period during which notifications are not sent.
+type QuietHours struct {
+	// Schedule is a cron expression in the standard 5-field format, which defines when the quiet hours start.
+	Schedule string `yaml:"schedule" validate:"required"`
+
+	// Duration defines how long the quiet hours last.
+	Duration time.Duration `yaml:"duration" validate:"required"`
+
+	// Timezone is an IANA time zone name used to evaluate the schedule. If not specified, UTC is used.
+	Timezone string `yaml:"timezone"`
+}
+
+// Parse parses the schedule and the time zone, so they can be evaluated for every notification without the parsing overhead.
+func (q QuietHours) Parse() (ParsedQuietHours, error) {
+	schedule, err := cron.Parse(q.Schedule)
+	if err != nil {
+		return ParsedQuietHours{}, fmt.Errorf("while parsing schedule: %w", err)
+	}
+
+	loc := time.UTC
+	if q.Timezone != "" {
+		loc, err = time.LoadLocation(q.Timezone)
+		if err != nil {
+			return ParsedQuietHours{}, fmt.Errorf("while loading timezone: %w", err)
+		}
+	}
+
+	return ParsedQuietHours{
+		schedule: schedule,
+		location: loc,
+		duration: q.Duration,
+	}, nil
+}
+
+// ParsedQuietHours holds quiet hours with the parsed schedule and time zone.
+type ParsedQuietHours struct {
+	schedule *cron.Schedule
+	location *time.Location
+	duration time.Duration
+}
+
+// IsActive returns true if a given time is within the quiet hours.
+func (q ParsedQuietHours) IsActive(now time.Time) bool {
+	_, found := q.schedule.LastBefore(now.In(q.location), q.duration)
+	return found
+}
+
+// Routing holds rules which narrow down the channels and sinks that receive a given source event.
+type Routing struct {
+	// Rules are evaluated in order. If none of them matches, the event is delivered based on the channel and sink bindings.
+	Rules []RoutingRule `yaml:"rules" validate:"dive"`
+}
+
+// RoutingRule routes events matching given criteria to the selected receivers.
+type RoutingRule struct {
+	Name      string           `yaml:"name" validate:"required"`
+	Match     RoutingMatch     `yaml:"match"`
+	Receivers RoutingReceivers `yaml:"receivers"`
+
+	// Continue defines whether the next rules are evaluated once this rule matches.
+	Continue bool `yaml:"continue"`
+}
+
+// RoutingMatch defines criteria that an event must meet to match a given rule. Empty criteria match all events.
+type RoutingMatch struct {
+	Sources    []string          `yaml:"sources"`
+	Levels     []Level           `yaml:"levels"`
+	Namespaces RegexConstraints  `yaml:"namespaces"`
+	Kinds      []string          `yaml:"kinds"`
+	Labels     map[string]string `yaml:"labels"`
+}
+
+// RoutingReceivers holds the channels and sinks that receive events matching a given rule.
+// Only receivers bound to the event source are taken into account.
+type RoutingReceivers struct {
+	// Channels contains names or IDs of the communication platform channels.
+	Channels []string `yaml:"channels"`
+
+	// Sinks contains names of the sink integrations, e.g. "elasticsearch" or "webhook", which select the sink in all
+	// communication groups. To select a sink from a given communication group, prefix it with the group name, e.g. "default-group/webhook".
+	Sinks []string `yaml:"sinks"`
+}
+
+// SinkReceiverName returns a name which selects a sink integration from a given communication group in routing rules.
+func SinkReceiverName(commGroupName string, sink CommPlatformIntegration) string {
+	return fmt.Sprintf("%s/%s", commGroupName, sink)
 }
 
 // Communications contains communication platforms that are supported.
@@ -421,6 +773,33 @@ type Communications struct {
 	Elasticsearch Elasticsearch `yaml:"elasticsearch,omitempty"`
 }
 
+// WithoutBotBindings returns a copy of the configuration without bindings of bot channels.
+// It's used to check if only the bindings changed, as they can be updated without restarting the bots.
+func (c Communications) WithoutBotBindings() Communications {
+	c.Slack.Channels = channelsByNameWithoutBindings(c.Slack.Channels)
+	c.SocketSlack.Channels = channelsByNameWithoutBindings(c.SocketSlack.Channels)
+	c.CloudSlack.Channels = channelsByNameWithoutBindings(c.CloudSlack.Channels)
+	c.Mattermost.Channels = channelsByNameWithoutBindings(c.Mattermost.Channels)
+	c.Teams.Bindings = BotBindings{}
+
+	channels := make(IdentifiableMap[ChannelBindingsByID], len(c.Discord.Channels))
+	for alias, channel := range c.Discord.Channels {
+		channel.Bindings = BotBindings{}
+		channels[alias] = channel
+	}
+	c.Discord.Channels = channels
+	return c
+}
+
+func channelsByNameWithoutBindings(in IdentifiableMap[ChannelBindingsByName]) IdentifiableMap[ChannelBindingsByName] {
+	out := make(IdentifiableMap[ChannelBindingsByName], len(in))
+	for alias, channel := range in {
+		channel.Bindings = BotBindings{}
+		out[alias] = channel
+	}
+	return out
+}
+
 // Slack holds Slack integration config.
 // Deprecated: Legacy Slack integration has been deprecated and removed from the Slack App Directory.
 // Use SocketSlack integration instead.
@@ -502,6 +881,23 @@ type Teams struct {
 	// TODO: Be consistent with other communicators when MS Teams support multiple channels
 	//Channels     IdentifiableMap[ChannelBindingsByName] `yaml:"channels"`
 	Bindings BotBindings `yaml:"bindings" validate:"required_if=Enabled true"`
+
+	// Notification applies to all conversations which have notifications turned on.
+	Notification TeamsNotification `yaml:"notification"`
+}
+
+// TeamsNotification contains notification configuration for MS Teams conversations.
+type TeamsNotification struct {
+	Mode   NotificationMode   `yaml:"mode" validate:"omitempty,oneof=realTime digest"`
+	Digest DigestNotification `yaml:"digest"`
+}
+
+// ChannelNotification returns the notification configuration for a single conversation.
+func (n TeamsNotification) ChannelNotification() ChannelNotification {
+	return ChannelNotification{
+		Mode:   n.Mode,
+		Digest: n.Digest,
+	}
 }
 
 // Discord configuration for authentication and send notifications
@@ -517,6 +913,52 @@ type Webhook struct {
 	Enabled  bool         `yaml:"enabled"`
 	URL      string       `yaml:"url"`
 	Bindings SinkBindings `yaml:"bindings" validate:"required_if=Enabled true"`
+
+	// Headers are added to every request. The map key is the header name.
+	// Header values, credentials and the signing secret can be read from Secrets with the `valueFrom` syntax.
+	Headers map[string]string `yaml:"headers,omitempty"`
+	Auth    WebhookAuth       `yaml:"auth,omitempty"`
+	Signing WebhookSigning    `yaml:"signing,omitempty"`
+	TLS     WebhookTLS        `yaml:"tls,omitempty"`
+}
+
+// WebhookAuth contains authentication settings for the webhook requests. Only one method can be used at a time.
+type WebhookAuth struct {
+	BearerToken string           `yaml:"bearerToken,omitempty"`
+	Basic       WebhookBasicAuth `yaml:"basic,omitempty"`
+}
+
+// WebhookBasicAuth contains credentials for the HTTP basic authentication.
+type WebhookBasicAuth struct {
+	Username string `yaml:"username,omitempty"`
+	Password string `yaml:"password,omitempty"`
+}
+
+// WebhookSigning contains settings for the HMAC-SHA256 payload signing.
+// The signature is calculated for the "{timestamp}.{body}" string, so receivers can reject replayed requests.
+type WebhookSigning struct {
+	Secret          string `yaml:"secret,omitempty"`
+	Header          string `yaml:"header,omitempty"`
+	TimestampHeader string `yaml:"timestampHeader,omitempty"`
+}
+
+const (
+	// DefaultWebhookSignatureHeader is a default name of the header with the payload signature.
+	DefaultWebhookSignatureHeader = "X-Botkube-Signature"
+
+	// DefaultWebhookTimestampHeader is a default name of the header with the Unix timestamp used for signing.
+	DefaultWebhookTimestampHeader = "X-Botkube-Timestamp"
+)
+
+// WebhookTLS contains TLS settings for the webhook requests.
+type WebhookTLS struct {
+	// CAFile is a path to the PEM-encoded CA bundle used to verify the server certificate. System CAs are used if not set.
+	CAFile string `yaml:"caFile,omitempty"`
+
+	// CertFile and KeyFile are paths to the PEM-encoded client certificate and key used for mTLS.
+	// They are read for every new connection, so a rotated certificate is picked up without restart.
+	CertFile string `yaml:"certFile,omitempty"`
+	KeyFile  string `yaml:"keyFile,omitempty"`
 }
 
 // CfgWatcher describes configuration for watching the configuration.
@@ -524,9 +966,36 @@ type CfgWatcher struct {
 	Enabled bool             `yaml:"enabled"`
 	Remote  RemoteCfgWatcher `yaml:"remote"`
 
-	InitialSyncTimeout time.Duration  `yaml:"initialSyncTimeout"`
-	TmpDir             string         `yaml:"tmpDir"`
-	Deployment         K8sResourceRef `yaml:"deployment"`
+	InitialSyncTimeout time.Duration       `yaml:"initialSyncTimeout"`
+	TmpDir             string              `yaml:"tmpDir"`
+	Deployment         K8sResourceRef      `yaml:"deployment"`
+	CRD                CRDCfgWatcher       `yaml:"crd,omitempty"`
+	ValueRefs          ValueRefsCfgWatcher `yaml:"valueRefs,omitempty"`
+}
+
+// CRDCfgWatcher describes configuration for loading and watching the configuration defined as Kubernetes custom resources.
+type CRDCfgWatcher struct {
+	Enabled bool `yaml:"enabled"`
+	// Namespaces limits the watched custom resources. If empty, custom resources from all namespaces are loaded.
+	Namespaces []string `yaml:"namespaces,omitempty"`
+	// AllowedChannels maps namespaces to channel names, or channel IDs for Discord, which BotkubeChannelBinding resources
+	// from a given namespace can bind to. Channels which are not listed cannot be bound.
+	AllowedChannels map[string][]string `yaml:"allowedChannels,omitempty"`
+	// AllowedBindings maps namespaces to names of sources and executors from outside of a given namespace, e.g. the ones from
+	// the base configuration, which BotkubeChannelBinding and BotkubeAction resources from a given namespace can bind.
+	// Resources from the same namespace can always be bound.
+	AllowedBindings map[string][]string `yaml:"allowedBindings,omitempty"`
+	// AllowedExecutorPlugins maps namespaces to executor plugin names, e.g. "botkube/kubectl", which BotkubeExecutor resources
+	// from a given namespace can enable. Plugins which are not listed cannot be enabled.
+	AllowedExecutorPlugins map[string][]string `yaml:"allowedExecutorPlugins,omitempty"`
+}
+
+// ValueRefsCfgWatcher describes configuration for re-resolving `valueFrom` references, e.g. after a Secret rotation.
+type ValueRefsCfgWatcher struct {
+	// PollInterval is the interval of checking if the referenced values changed. If zero, the values are resolved only on load.
+	PollInterval time.Duration `yaml:"pollInterval"`
+	// AllowedFileDirs lists directories which `valueFrom.file` references can read from. If empty, file references are rejected.
+	AllowedFileDirs []string `yaml:"allowedFileDirs,omitempty"`
 }
 
 // RemoteCfgWatcher describes configuration for watching the configuration using remote config provider.
@@ -547,6 +1016,239 @@ type Settings struct {
 	InformersResyncPeriod   time.Duration    `yaml:"informersResyncPeriod"`
 	Kubeconfig              string           `yaml:"kubeconfig"`
 	SACredentialsPathPrefix string           `yaml:"saCredentialsPathPrefix"`
+	SinkDelivery            SinkDelivery     `yaml:"sinkDelivery"`
+	Audit                   Audit            `yaml:"audit,omitempty"`
+	Streaming               Streaming        `yaml:"streaming,omitempty"`
+	LargeOutput             LargeOutput      `yaml:"largeOutput,omitempty"`
+	Pagination              Pagination       `yaml:"pagination,omitempty"`
+	Jobs                    Jobs             `yaml:"jobs,omitempty"`
+}
+
+const (
+	// DefaultJobsMaxDuration is a default time after which asynchronous jobs are canceled.
+	DefaultJobsMaxDuration = time.Hour
+	// DefaultJobsMaxRunning is a default number of asynchronous jobs which can run at the same time.
+	DefaultJobsMaxRunning = 10
+)
+
+// Jobs contains configuration for commands run asynchronously with `run --async`.
+type Jobs struct {
+	// MaxDuration is the time after which a job is canceled. Defaults to DefaultJobsMaxDuration.
+	MaxDuration time.Duration `yaml:"maxDuration,omitempty"`
+	// MaxRunning is the number of jobs which can run at the same time. New jobs are rejected above it. Defaults to DefaultJobsMaxRunning.
+	MaxRunning int `yaml:"maxRunning,omitempty"`
+}
+
+// GetMaxDuration returns the max duration of jobs.
+func (j Jobs) GetMaxDuration() time.Duration {
+	if j.MaxDuration > 0 {
+		return j.MaxDuration
+	}
+	return DefaultJobsMaxDuration
+}
+
+// GetMaxRunning returns the max number of running jobs.
+func (j Jobs) GetMaxRunning() int {
+	if j.MaxRunning > 0 {
+		return j.MaxRunning
+	}
+	return DefaultJobsMaxRunning
+}
+
+const (
+	// DefaultStreamingMaxDuration is a default time after which streamed commands are stopped.
+	DefaultStreamingMaxDuration = 15 * time.Minute
+	// DefaultStreamingUpdateInterval is a default interval of live message updates.
+	DefaultStreamingUpdateInterval = 3 * time.Second
+)
+
+// Streaming contains configuration for commands whose output is streamed by executor plugins.
+type Streaming struct {
+	// MaxDuration is the time after which a streamed command is stopped. Defaults to DefaultStreamingMaxDuration.
+	MaxDuration time.Duration `yaml:"maxDuration,omitempty"`
+	// UpdateInterval defines how often the live output is sent. Commands which finish earlier are answered with a single message.
+	// Defaults to DefaultStreamingUpdateInterval.
+	UpdateInterval time.Duration `yaml:"updateInterval,omitempty"`
+}
+
+// GetMaxDuration returns the max duration of streamed commands.
+func (s Streaming) GetMaxDuration() time.Duration {
+	if s.MaxDuration > 0 {
+		return s.MaxDuration
+	}
+	return DefaultStreamingMaxDuration
+}
+
+// GetUpdateInterval returns the interval of live message updates.
+func (s Streaming) GetUpdateInterval() time.Duration {
+	if s.UpdateInterval > 0 {
+		return s.UpdateInterval
+	}
+	return DefaultStreamingUpdateInterval
+}
+
+const (
+	// DefaultLargeOutputMaxInlineSize is a default max size of the command output posted inline. It fits the limits of all platforms.
+	DefaultLargeOutputMaxInlineSize = 1500
+	// DefaultLargeOutputPreviewLines is a default number of lines shown inline when the output is attached as a file.
+	DefaultLargeOutputPreviewLines = 10
+)
+
+// LargeOutput contains configuration for command outputs which are too large to be posted inline.
+type LargeOutput struct {
+	// MaxInlineSize is the max number of output bytes posted inline. Larger output is uploaded as a file with a short inline preview.
+	// Defaults to DefaultLargeOutputMaxInlineSize.
+	MaxInlineSize int `yaml:"maxInlineSize,omitempty"`
+	// PreviewLines is the number of the first output lines shown inline. Defaults to DefaultLargeOutputPreviewLines.
+	PreviewLines int `yaml:"previewLines,omitempty"`
+}
+
+// GetMaxInlineSize returns the max size of the output posted inline.
+func (o LargeOutput) GetMaxInlineSize() int {
+	if o.MaxInlineSize > 0 {
+		return o.MaxInlineSize
+	}
+	return DefaultLargeOutputMaxInlineSize
+}
+
+// GetPreviewLines returns the number of lines shown inline when the output is attached as a file.
+func (o LargeOutput) GetPreviewLines() int {
+	if o.PreviewLines > 0 {
+		return o.PreviewLines
+	}
+	return DefaultLargeOutputPreviewLines
+}
+
+const (
+	// DefaultPaginationCacheTTL is a default time for which paginated outputs are kept.
+	DefaultPaginationCacheTTL = 15 * time.Minute
+	// DefaultPaginationMaxCacheSizeMB is a default max size of all paginated outputs kept in memory.
+	DefaultPaginationMaxCacheSizeMB = 20
+)
+
+// Pagination contains configuration for paginated command outputs on interactive platforms.
+// Outputs larger than LargeOutput.MaxInlineSize are split into pages of that size.
+type Pagination struct {
+	// CacheTTL is the time after which a paginated output expires and can't be browsed anymore. Defaults to DefaultPaginationCacheTTL.
+	CacheTTL time.Duration `yaml:"cacheTTL,omitempty"`
+	// MaxCacheSizeMB is the max size of all paginated outputs. Once it's exceeded, the oldest outputs are removed.
+	// Defaults to DefaultPaginationMaxCacheSizeMB.
+	MaxCacheSizeMB int `yaml:"maxCacheSizeMB,omitempty"`
+}
+
+// GetCacheTTL returns the time for which paginated outputs are kept.
+func (p Pagination) GetCacheTTL() time.Duration {
+	if p.CacheTTL > 0 {
+		return p.CacheTTL
+	}
+	return DefaultPaginationCacheTTL
+}
+
+// GetMaxCacheSize returns the max size of all paginated outputs in bytes.
+func (p Pagination) GetMaxCacheSize() int {
+	if p.MaxCacheSizeMB > 0 {
+		return p.MaxCacheSizeMB * 1024 * 1024
+	}
+	return DefaultPaginationMaxCacheSizeMB * 1024 * 1024
+}
+
+const (
+	// DefaultAuditFileMaxSizeMB is a default size of the audit log file after which it is rotated.
+	DefaultAuditFileMaxSizeMB = 10
+	// DefaultAuditFileMaxBackups is a default number of rotated audit log files which are kept.
+	DefaultAuditFileMaxBackups = 3
+	// DefaultAuditWebhookQueueSize is a default number of audit entries waiting to be sent to the webhook.
+	DefaultAuditWebhookQueueSize = 1000
+)
+
+// Audit contains configuration for local audit reporters. They work independently of Botkube Cloud.
+type Audit struct {
+	File    AuditFile    `yaml:"file,omitempty"`
+	Stdout  AuditStdout  `yaml:"stdout,omitempty"`
+	Webhook AuditWebhook `yaml:"webhook,omitempty"`
+}
+
+// AuditFile contains configuration for the audit log file. It is also used to query audit entries from chat.
+type AuditFile struct {
+	Enabled bool   `yaml:"enabled,omitempty"`
+	Path    string `yaml:"path,omitempty" validate:"required_if=Enabled true"`
+
+	// MaxSizeMB is the file size after which the file is rotated. Defaults to DefaultAuditFileMaxSizeMB.
+	MaxSizeMB int `yaml:"maxSizeMB,omitempty"`
+	// MaxBackups is the number of rotated files which are kept. Defaults to DefaultAuditFileMaxBackups.
+	MaxBackups int `yaml:"maxBackups,omitempty"`
+
+	// Viewers holds platform user IDs or emails of users who can query audit entries from all channels.
+	// Other users can query only entries from the channel where they run the command.
+	Viewers []string `yaml:"viewers,omitempty"`
+}
+
+// IsViewer returns true if a given user can query audit entries from all channels.
+func (f AuditFile) IsViewer(userID, email string) bool {
+	for _, viewer := range f.Viewers {
+		if userID != "" && viewer == userID {
+			return true
+		}
+		if email != "" && strings.EqualFold(viewer, email) {
+			return true
+		}
+	}
+	return false
+}
+
+// AuditStdout contains configuration for printing audit entries to the standard output as JSON.
+type AuditStdout struct {
+	Enabled bool `yaml:"enabled,omitempty"`
+}
+
+// AuditWebhook contains configuration for sending audit entries to an HTTP endpoint.
+type AuditWebhook struct {
+	Enabled bool              `yaml:"enabled,omitempty"`
+	URL     string            `yaml:"url,omitempty" validate:"required_if=Enabled true"`
+	Headers map[string]string `yaml:"headers,omitempty"`
+
+	// QueueSize is the maximum number of entries waiting to be sent. When the queue is full, new entries are dropped.
+	// Defaults to DefaultAuditWebhookQueueSize.
+	QueueSize int `yaml:"queueSize,omitempty"`
+}
+
+// GetQueueSize returns the size of the webhook queue.
+func (w AuditWebhook) GetQueueSize() int {
+	if w.QueueSize > 0 {
+		return w.QueueSize
+	}
+	return DefaultAuditWebhookQueueSize
+}
+
+// SinkDelivery contains configuration for the queue which delivers events to sinks.
+type SinkDelivery struct {
+	// QueueSize is the maximum number of events waiting for delivery per sink. When the queue is full, new events are dropped.
+	QueueSize int `yaml:"queueSize"`
+
+	// QueueDir is an optional directory where queued events are stored, so they survive Botkube restarts.
+	QueueDir string `yaml:"queueDir"`
+
+	// Concurrency is the maximum number of events sent to a given sink at the same time.
+	Concurrency int `yaml:"concurrency"`
+
+	// MaxRetries is the number of retries after the first failed delivery attempt.
+	MaxRetries int `yaml:"maxRetries"`
+
+	// InitialBackoff is the delay before the first retry. It is doubled for every next retry, up to MaxBackoff.
+	InitialBackoff time.Duration `yaml:"initialBackoff"`
+	MaxBackoff     time.Duration `yaml:"maxBackoff"`
+
+	DeadLetter SinkDeadLetter `yaml:"deadLetter"`
+}
+
+// SinkDeadLetter contains configuration for storing events which couldn't be delivered.
+// If neither FileName nor ConfigMap is set, such events are only logged.
+type SinkDeadLetter struct {
+	FileName  string         `yaml:"fileName"`
+	ConfigMap K8sResourceRef `yaml:"configMap"`
+
+	// MaxEntries is the maximum number of events kept in the ConfigMap. The oldest ones are removed first.
+	MaxEntries int `yaml:"maxEntries"`
 }
 
 // Formatter log formatter
@@ -602,47 +1304,57 @@ type LoadWithDefaultsDetails struct {
 
 // LoadWithDefaults loads new configuration from files and environment variables.
 func LoadWithDefaults(configs [][]byte) (*Config, LoadWithDefaultsDetails, error) {
+	cfg, err := MergeWithDefaults(configs)
+	if err != nil {
+		return nil, LoadWithDefaultsDetails{}, err
+	}
+
+	result, err := ValidateStruct(*cfg)
+	if err != nil {
+		return nil, LoadWithDefaultsDetails{}, fmt.Errorf("while validating loaded configuration: %w", err)
+	}
+	if err := result.Criticals.ErrorOrNil(); err != nil {
+		return nil, LoadWithDefaultsDetails{}, fmt.Errorf("found critical validation errors: %w", err)
+	}
+
+	return cfg, LoadWithDefaultsDetails{
+		ValidateWarnings: result.Warnings.ErrorOrNil(),
+	}, nil
+}
+
+// MergeWithDefaults merges configuration from files and environment variables with the default configuration.
+// Unlike LoadWithDefaults, it doesn't validate the result.
+func MergeWithDefaults(configs [][]byte) (*Config, error) {
 	k := koanf.New(configDelimiter)
 
 	// load default settings
 	if err := k.Load(rawbytes.Provider(defaultConfiguration), koanfyaml.Parser()); err != nil {
-		return nil, LoadWithDefaultsDetails{}, fmt.Errorf("while loading default configuration: %w", err)
+		return nil, fmt.Errorf("while loading default configuration: %w", err)
 	}
 
 	// merge with user configs
 	for _, rawCfg := range configs {
 		if err := k.Load(rawbytes.Provider(rawCfg), koanfyaml.Parser()); err != nil {
-			return nil, LoadWithDefaultsDetails{}, err
+			return nil, err
 		}
 	}
 
-	// LoadWithDefaults environment variables and merge into the loaded config.
+	// load environment variables and merge into the loaded config.
 	err := k.Load(env.Provider(
 		configEnvVariablePrefix,
 		configDelimiter,
 		normalizeConfigEnvName,
 	), nil)
 	if err != nil {
-		return nil, LoadWithDefaultsDetails{}, err
+		return nil, err
 	}
 
 	var cfg Config
 	err = k.Unmarshal("", &cfg)
 	if err != nil {
-		return nil, LoadWithDefaultsDetails{}, err
+		return nil, err
 	}
-
-	result, err := ValidateStruct(cfg)
-	if err != nil {
-		return nil, LoadWithDefaultsDetails{}, fmt.Errorf("while validating loaded configuration: %w", err)
-	}
-	if err := result.Criticals.ErrorOrNil(); err != nil {
-		return nil, LoadWithDefaultsDetails{}, fmt.Errorf("found critical validation errors: %w", err)
-	}
-
-	return &cfg, LoadWithDefaultsDetails{
-		ValidateWarnings: result.Warnings.ErrorOrNil(),
-	}, nil
+	return &cfg, nil
 }
 
 func normalizeConfigEnvName(name string) string {
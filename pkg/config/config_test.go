@@ -4,6 +4,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/stretchr/testify/assert"
@@ -219,6 +220,70 @@ func TestLoadedConfigValidationErrors(t *testing.T) {
 				readTestdataFile(t, "sources-rbac.yaml"),
 			},
 		},
+		{
+			name: "ChatUser RBAC in sources and actions",
+			expErrMsg: heredoc.Doc(`
+				found critical validation errors: 2 errors occurred:
+					* Key: 'Config.Actions[show-created-resource].Bindings.kubectl-read-only' Plugin botkube/kubectl has 'ChatUser' RBAC policy. This is not supported for actions.
+					* Key: 'Config.Sources[cm-1].botkube/cm-watcher' Plugin botkube/cm-watcher has 'ChatUser' RBAC policy. This is not supported for sources.`),
+			configs: [][]byte{
+				readTestdataFile(t, "chat-user-rbac.yaml"),
+			},
+		},
+		{
+			name: "invalid approval policy",
+			expErrMsg: heredoc.Doc(`
+				found critical validation errors: 2 errors occurred:
+					* Key: 'Config.Executors[k8s-tools].Approval.CommandRegexes[0]' CommandRegexes[0] is not a valid regular expression: error parsing regexp: missing closing ): ` + "`^helm (rollback|uninstall`" + `
+					* Key: 'Config.Executors[k8s-tools].Approval.Approvers' Approvers must contain at least one user when commands require approval`),
+			configs: [][]byte{
+				readTestdataFile(t, "invalid-approval.yaml"),
+			},
+		},
+		{
+			name: "invalid rate limits",
+			expErrMsg: heredoc.Doc(`
+				found critical validation errors: 2 errors occurred:
+					* Key: 'Config.RateLimits.User.Interval' Interval must be greater than 0 when requests are limited
+					* Key: 'Config.RateLimits.Plugins[botkube/helm].Requests' Requests must be 0 or greater`),
+			configs: [][]byte{
+				readTestdataFile(t, "invalid-rate-limits.yaml"),
+			},
+		},
+		{
+			name: "invalid quiet hours",
+			expErrMsg: heredoc.Doc(`
+				found critical validation errors: 3 errors occurred:
+					* Key: 'Config.Communications[default-workspace].SocketSlack.Channels[alias].Notification.QuietHours[0].Schedule' Schedule is not a valid cron expression: invalid hour field "25": value 25 out of range [0, 23]
+					* Key: 'Config.Communications[default-workspace].SocketSlack.Channels[alias].Notification.QuietHours[0].Timezone' Timezone is not a valid time zone: unknown time zone Mars/Olympus_Mons
+					* Key: 'Config.Communications[default-workspace].SocketSlack.Channels[alias].Notification.QuietHours[1].Duration' Duration is a required field`),
+			configs: [][]byte{
+				readTestdataFile(t, "invalid-quiet-hours.yaml"),
+			},
+		},
+		{
+			name: "invalid routing rules",
+			expErrMsg: heredoc.Doc(`
+				found critical validation errors: 5 errors occurred:
+					* Key: 'Config.Routing.Rules[0].Receivers.Sinks[0]' Receivers.Sinks[0] must be one of [elasticsearch webhook], optionally prefixed with a communication group name
+					* Key: 'Config.Routing.Rules[0].Receivers.Sinks[2]' Receivers.Sinks[2] must be one of [elasticsearch webhook], optionally prefixed with a communication group name
+					* Key: 'Config.Routing.Rules[0].k8s-all-events' 'k8s-all-events' binding not defined in Config.Sources
+					* Key: 'Config.Routing.Rules[1].Name' Name is a required field
+					* Key: 'Config.Routing.Rules[1].Receivers' Receivers must contain at least one channel or sink`),
+			configs: [][]byte{
+				readTestdataFile(t, "invalid-routing.yaml"),
+			},
+		},
+		{
+			name: "invalid webhook",
+			expErrMsg: heredoc.Doc(`
+				found critical validation errors: 2 errors occurred:
+					* Key: 'Config.Communications[default-workspace].Webhook.Auth' Auth must define only one authentication method
+					* Key: 'Config.Communications[default-workspace].Webhook.TLS' TLS must define both certFile and keyFile for client authentication`),
+			configs: [][]byte{
+				readTestdataFile(t, "invalid-webhook.yaml"),
+			},
+		},
 		{
 			name: "Invalid channel names",
 			expErrMsg: heredoc.Doc(`
@@ -432,3 +497,44 @@ func TestRegexConstraints_IsAllowed(t *testing.T) {
 		})
 	}
 }
+
+func TestQuietHours_IsActive(t *testing.T) {
+	// given
+	quietHours, err := config.QuietHours{
+		Schedule: "0 22 * * *",
+		Duration: 8 * time.Hour,
+		Timezone: "Europe/Warsaw",
+	}.Parse()
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		now       time.Time
+		expActive bool
+	}{
+		"before quiet hours": {
+			now:       time.Date(2023, 1, 1, 20, 59, 0, 0, time.UTC), // 21:59 in Warsaw
+			expActive: false,
+		},
+		"at the start": {
+			now:       time.Date(2023, 1, 1, 21, 0, 0, 0, time.UTC),
+			expActive: true,
+		},
+		"after midnight": {
+			now:       time.Date(2023, 1, 2, 4, 30, 0, 0, time.UTC),
+			expActive: true,
+		},
+		"after quiet hours": {
+			now:       time.Date(2023, 1, 2, 5, 1, 0, 0, time.UTC),
+			expActive: false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// when
+			active := quietHours.IsActive(tc.now)
+
+			// then
+			assert.Equal(t, tc.expActive, active)
+		})
+	}
+}
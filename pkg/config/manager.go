@@ -38,6 +38,10 @@ type PersistenceManager interface {
 	PersistSourceBindings(ctx context.Context, commGroupName string, platform CommPlatformIntegration, channelAlias string, sourceBindings []string) error
 	PersistNotificationsEnabled(ctx context.Context, commGroupName string, platform CommPlatformIntegration, channelAlias string, enabled bool) error
 	PersistActionEnabled(ctx context.Context, name string, enabled bool) error
+	PersistDigest(ctx context.Context, key string, digest DigestRuntimeState) error
+	GetDigests(ctx context.Context) (map[string]DigestRuntimeState, error)
+	PersistSilences(ctx context.Context, silences []Silence) error
+	GetSilences(ctx context.Context) ([]Silence, error)
 	SetResourceVersion(resourceVersion int)
 }
 
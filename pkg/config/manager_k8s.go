@@ -148,4 +148,57 @@ func (m *K8sConfigPersistenceManager) PersistActionEnabled(ctx context.Context,
 	return cmStorage.Update(ctx, cm, state)
 }
 
+// PersistDigest updates startup config map with notifications buffered for a given channel in the digest mode.
+// While this method updates the Botkube ConfigMap, it doesn't reload Botkube itself.
+func (m *K8sConfigPersistenceManager) PersistDigest(ctx context.Context, key string, digest DigestRuntimeState) error {
+	cmStorage := configMapStorage[StartupState]{k8sCli: m.k8sCli, cfg: m.cfg.Startup}
+
+	state, cm, err := cmStorage.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if state.Digests == nil {
+		state.Digests = make(map[string]DigestRuntimeState)
+	}
+	state.Digests[key] = digest
+
+	return cmStorage.Update(ctx, cm, state)
+}
+
+// GetDigests returns all persisted digests.
+func (m *K8sConfigPersistenceManager) GetDigests(ctx context.Context) (map[string]DigestRuntimeState, error) {
+	cmStorage := configMapStorage[StartupState]{k8sCli: m.k8sCli, cfg: m.cfg.Startup}
+
+	state, _, err := cmStorage.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return state.Digests, nil
+}
+
+// PersistSilences updates startup config map with a given list of silences. It replaces the previously persisted ones.
+func (m *K8sConfigPersistenceManager) PersistSilences(ctx context.Context, silences []Silence) error {
+	cmStorage := configMapStorage[StartupState]{k8sCli: m.k8sCli, cfg: m.cfg.Startup}
+
+	state, cm, err := cmStorage.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	state.Silences = silences
+	return cmStorage.Update(ctx, cm, state)
+}
+
+// GetSilences returns all persisted silences.
+func (m *K8sConfigPersistenceManager) GetSilences(ctx context.Context) ([]Silence, error) {
+	cmStorage := configMapStorage[StartupState]{k8sCli: m.k8sCli, cfg: m.cfg.Startup}
+
+	state, _, err := cmStorage.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return state.Silences, nil
+}
+
 func (m *K8sConfigPersistenceManager) SetResourceVersion(resourceVersion int) {}
@@ -480,3 +480,57 @@ func TestPersistenceManager_PersistActionEnabled(t *testing.T) {
 		})
 	}
 }
+
+func TestPersistenceManager_PersistDigest(t *testing.T) {
+	// given
+	startupCfg := config.PartialPersistentConfig{
+		ConfigMap: config.K8sResourceRef{
+			Name:      "startup",
+			Namespace: "ns",
+		},
+		FileName: "_startup_state.yaml",
+	}
+	runtimeCfg := config.PartialPersistentConfig{
+		ConfigMap: config.K8sResourceRef{
+			Name:      "runtime",
+			Namespace: "ns",
+		},
+		FileName: "_runtime_state.yaml",
+	}
+	startupCfgMap := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      startupCfg.ConfigMap.Name,
+			Namespace: startupCfg.ConfigMap.Namespace,
+		},
+	}
+	runtimeCfgMap := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      runtimeCfg.ConfigMap.Name,
+			Namespace: runtimeCfg.ConfigMap.Namespace,
+		},
+	}
+	k8sCli := fake.NewSimpleClientset(startupCfgMap, runtimeCfgMap)
+	manager := config.NewManager(false, loggerx.NewNoop(), config.PersistentConfig{Startup: startupCfg, Runtime: runtimeCfg}, 0, k8sCli, nil, nil)
+
+	key := config.DigestKey("default-group", config.SocketSlackCommPlatformIntegration, "alerts")
+	digest := config.DigestRuntimeState{
+		Dropped: 1,
+		Entries: []config.DigestEntry{
+			{Source: "k8s-events", Namespace: "default", Kind: "Pod", Summary: "Pod created"},
+		},
+	}
+
+	// when
+	err := manager.PersistDigest(context.Background(), key, digest)
+	require.NoError(t, err)
+	digests, err := manager.GetDigests(context.Background())
+	require.NoError(t, err)
+
+	// then
+	assert.Equal(t, map[string]config.DigestRuntimeState{key: digest}, digests)
+
+	// runtime ConfigMap is watched by the Config Watcher, so it must not be updated
+	gotRuntimeCfgMap, err := k8sCli.CoreV1().ConfigMaps(runtimeCfg.ConfigMap.Namespace).Get(context.Background(), runtimeCfg.ConfigMap.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, runtimeCfgMap, gotRuntimeCfgMap)
+}
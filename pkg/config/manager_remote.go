@@ -171,6 +171,104 @@ func (m *RemotePersistenceManager) PersistActionEnabled(ctx context.Context, nam
 	return nil
 }
 
+// PersistDigest is a no-op, as Botkube Cloud doesn't store digests yet. Buffered notifications are kept in memory only,
+// so in the remote configuration mode they are lost when the agent restarts.
+func (m *RemotePersistenceManager) PersistDigest(context.Context, string, DigestRuntimeState) error {
+	return nil
+}
+
+// GetDigests returns no digests, as Botkube Cloud doesn't store them yet.
+func (m *RemotePersistenceManager) GetDigests(context.Context) (map[string]DigestRuntimeState, error) {
+	return nil, nil
+}
+
+// PersistSilences replaces notification silences stored in Botkube Cloud.
+func (m *RemotePersistenceManager) PersistSilences(ctx context.Context, silences []Silence) error {
+	logger := m.log.WithFields(logrus.Fields{
+		"deploymentID":    m.gql.DeploymentID(),
+		"resourceVersion": m.getResourceVersion(),
+		"silences":        len(silences),
+	})
+	logger.Debug("Updating silences")
+
+	input := make([]remoteapi.SilenceInput, 0, len(silences))
+	for _, silence := range silences {
+		input = append(input, remoteapi.SilenceInput{
+			ID:        silence.ID,
+			Source:    silence.Source,
+			Namespace: silence.Namespace,
+			Reason:    silence.Reason,
+			CreatedBy: silence.CreatedBy,
+			CreatedAt: silence.CreatedAt.Format(time.RFC3339),
+			ExpiresAt: silence.ExpiresAt.Format(time.RFC3339),
+		})
+	}
+
+	err := m.withRetry(ctx, logger, func() error {
+		var mutation struct {
+			Success bool `graphql:"patchDeploymentConfig(id: $id, input: $input)"`
+		}
+		variables := map[string]interface{}{
+			"id": graphql.ID(m.gql.DeploymentID()),
+			"input": remoteapi.PatchDeploymentConfigInput{
+				ResourceVersion: m.getResourceVersion(),
+				Silences: &remoteapi.SilencesPatchDeploymentConfigInput{
+					Silences: input,
+				},
+			},
+		}
+		if err := m.gql.Client().Mutate(ctx, &mutation, variables); err != nil {
+			return err
+		}
+
+		if !mutation.Success {
+			return fmt.Errorf("failed to persist %d silences", len(silences))
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "while persisting silences")
+	}
+	return nil
+}
+
+// GetSilences returns notification silences stored in Botkube Cloud.
+func (m *RemotePersistenceManager) GetSilences(ctx context.Context) ([]Silence, error) {
+	var query struct {
+		Deployment struct {
+			Silences []remoteapi.SilenceInput
+		} `graphql:"deployment(id: $id)"`
+	}
+	variables := map[string]interface{}{
+		"id": graphql.ID(m.gql.DeploymentID()),
+	}
+	if err := m.gql.Client().Query(ctx, &query, variables); err != nil {
+		return nil, fmt.Errorf("while querying silences: %w", err)
+	}
+
+	out := make([]Silence, 0, len(query.Deployment.Silences))
+	for _, silence := range query.Deployment.Silences {
+		createdAt, err := time.Parse(time.RFC3339, silence.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("while parsing creation time of silence %q: %w", silence.ID, err)
+		}
+		expiresAt, err := time.Parse(time.RFC3339, silence.ExpiresAt)
+		if err != nil {
+			return nil, fmt.Errorf("while parsing expiration time of silence %q: %w", silence.ID, err)
+		}
+		out = append(out, Silence{
+			ID:        silence.ID,
+			Source:    silence.Source,
+			Namespace: silence.Namespace,
+			Reason:    silence.Reason,
+			CreatedBy: silence.CreatedBy,
+			CreatedAt: createdAt,
+			ExpiresAt: expiresAt,
+		})
+	}
+	return out, nil
+}
+
 func (m *RemotePersistenceManager) SetResourceVersion(resourceVersion int) {
 	m.resVerMutex.Lock()
 	defer m.resVerMutex.Unlock()
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"gopkg.in/yaml.v3"
 	v1 "k8s.io/api/core/v1"
@@ -65,6 +66,14 @@ type ChannelRuntimeBindings struct {
 // StartupState represents the startup state.
 type StartupState struct {
 	Communications map[string]CommunicationsStartupState `yaml:"communications,omitempty"`
+
+	// Silences holds notification silences created with the `create silence` command. They are stored in the startup ConfigMap
+	// as it is not watched by the Config Watcher, and updating it doesn't reload the agent.
+	Silences []Silence `yaml:"silences,omitempty"`
+
+	// Digests holds notifications buffered for channels in the digest mode, so they survive agent restarts.
+	// They are persisted frequently, so they are stored in the startup ConfigMap, which is not watched by the Config Watcher.
+	Digests map[string]DigestRuntimeState `yaml:"digests,omitempty"`
 }
 
 // MarshalToMap marshals the startup state to a string map.
@@ -90,6 +99,55 @@ type NotificationStartupState struct {
 	Disabled bool `yaml:"disabled"`
 }
 
+// DigestRuntimeState represents notifications buffered for a given channel in the digest mode.
+type DigestRuntimeState struct {
+	LastSentAt time.Time     `yaml:"lastSentAt"`
+	Dropped    int           `yaml:"dropped,omitempty"`
+	Entries    []DigestEntry `yaml:"entries,omitempty"`
+}
+
+// DigestEntry represents a single notification buffered for the digest.
+type DigestEntry struct {
+	Source    string    `yaml:"source"`
+	Namespace string    `yaml:"namespace,omitempty"`
+	Kind      string    `yaml:"kind,omitempty"`
+	Summary   string    `yaml:"summary"`
+	Timestamp time.Time `yaml:"timestamp"`
+}
+
+// DigestKey returns a key under which the digest for a given channel is persisted.
+func DigestKey(commGroupName string, platform CommPlatformIntegration, channel string) string {
+	return strings.Join([]string{commGroupName, platform.String(), channel}, "/")
+}
+
+// Silence represents a temporary suppression of notifications for a given source and namespace.
+// Empty Source or Namespace matches all sources or namespaces respectively.
+type Silence struct {
+	ID        string    `yaml:"id"`
+	Source    string    `yaml:"source,omitempty"`
+	Namespace string    `yaml:"namespace,omitempty"`
+	Reason    string    `yaml:"reason,omitempty"`
+	CreatedBy string    `yaml:"createdBy,omitempty"`
+	CreatedAt time.Time `yaml:"createdAt"`
+	ExpiresAt time.Time `yaml:"expiresAt"`
+}
+
+// IsActive returns true if a given silence hasn't expired yet.
+func (s Silence) IsActive(now time.Time) bool {
+	return now.Before(s.ExpiresAt)
+}
+
+// Matches returns true if a given silence applies to a given source and namespace.
+func (s Silence) Matches(sourceName, namespace string) bool {
+	if s.Source != "" && s.Source != sourceName {
+		return false
+	}
+	if s.Namespace != "" && s.Namespace != namespace {
+		return false
+	}
+	return true
+}
+
 func marshalToMap(in interface{}, propertyName string) (map[string]string, error) {
 	bytes, err := marshalToYAMLString(&in)
 	if err != nil {
@@ -5,6 +5,7 @@ import (
 	"reflect"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/go-playground/locales/en"
 	ut "github.com/go-playground/universal-translator"
@@ -12,6 +13,7 @@ import (
 	en_translations "github.com/go-playground/validator/v10/translations/en"
 	"github.com/hashicorp/go-multierror"
 
+	"github.com/kubeshop/botkube/internal/cron"
 	"github.com/kubeshop/botkube/pkg/conversation"
 	"github.com/kubeshop/botkube/pkg/execute/command"
 	multierrx "github.com/kubeshop/botkube/pkg/multierror"
@@ -28,6 +30,12 @@ const (
 	invalidAliasCommandTag      = "invalid_alias_command"
 	invalidPluginRBACTag        = "invalid_plugin_rbac"
 	invalidActionRBACTag        = "invalid_action_tag"
+	invalidQuietHoursTag        = "invalid_quiet_hours"
+	invalidRoutingRuleTag       = "invalid_routing_rule"
+	invalidWebhookTag           = "invalid_webhook"
+	invalidApprovalPolicyTag    = "invalid_approval_policy"
+	invalidRateLimitTag         = "invalid_rate_limit"
+	unsupportedChatUserRBACTag  = "unsupported_chat_user_rbac"
 	appTokenPrefix              = "xapp-"
 	botTokenPrefix              = "xoxb-"
 )
@@ -91,6 +99,11 @@ func ValidateStruct(in any) (ValidateResult, error) {
 
 	validate.RegisterStructValidation(sourceStructValidator, Sources{})
 	validate.RegisterStructValidation(executorStructValidator, Executors{})
+	validate.RegisterStructValidation(quietHoursStructValidator, QuietHours{})
+	validate.RegisterStructValidation(routingRuleStructValidator, RoutingRule{})
+	validate.RegisterStructValidation(webhookStructValidator, Webhook{})
+	validate.RegisterStructValidation(approvalPolicyStructValidator, ApprovalPolicy{})
+	validate.RegisterStructValidation(rateLimitStructValidator, RateLimit{})
 
 	err := validate.Struct(in)
 	if err == nil {
@@ -121,8 +134,13 @@ func ValidateStruct(in any) (ValidateResult, error) {
 
 func registerCustomTranslations(validate *validator.Validate, trans ut.Translator) error {
 	return registerTranslation(validate, trans, map[string]string{
-		"invalid_slack_token": "{0} {1}",
-		invalidChannelNameTag: "The channel name '{0}' seems to be invalid. See the documentation to learn more: {1}.",
+		"invalid_slack_token":    "{0} {1}",
+		invalidQuietHoursTag:     "{0} {1}",
+		invalidRoutingRuleTag:    "{0} {1}",
+		invalidWebhookTag:        "{0} {1}",
+		invalidApprovalPolicyTag: "{0} {1}",
+		invalidRateLimitTag:      "{0} {1}",
+		invalidChannelNameTag:    "The channel name '{0}' seems to be invalid. See the documentation to learn more: {1}.",
 	})
 }
 
@@ -148,6 +166,7 @@ func registerBindingsValidator(validate *validator.Validate, trans ut.Translator
 		invalidPluginDefinitionTag:  "{0}{1}",
 		invalidPluginRBACTag:        "Binding is referencing plugins of same kind with different RBAC. '{0}' and '{1}' bindings must be identical when used together.",
 		invalidActionRBACTag:        "Plugin {0} has 'ChannelName' RBAC policy. This is not supported for actions. See https://docs.botkube.io/configuration/action#rbac",
+		unsupportedChatUserRBACTag:  "Plugin {0} has 'ChatUser' RBAC policy. This is not supported for {1}.",
 	})
 }
 
@@ -289,6 +308,12 @@ func sourceStructValidator(sl validator.StructLevel) {
 	}
 
 	validatePlugins(sl, sources.Plugins)
+
+	for pluginKey, plugin := range sources.Plugins {
+		if plugin.Enabled && plugin.Context.RBAC.UsesChatUser() {
+			sl.ReportError(sources.Plugins, pluginKey, pluginKey, unsupportedChatUserRBACTag, "sources")
+		}
+	}
 }
 
 func executorStructValidator(sl validator.StructLevel) {
@@ -300,6 +325,113 @@ func executorStructValidator(sl validator.StructLevel) {
 	validatePlugins(sl, executor.Plugins)
 }
 
+func approvalPolicyStructValidator(sl validator.StructLevel) {
+	policy, ok := sl.Current().Interface().(ApprovalPolicy)
+	if !ok {
+		return
+	}
+
+	for idx, expr := range policy.CommandRegexes {
+		if _, err := regexp.Compile(expr); err != nil {
+			field := fmt.Sprintf("CommandRegexes[%d]", idx)
+			sl.ReportError(expr, field, field, invalidApprovalPolicyTag, fmt.Sprintf("is not a valid regular expression: %s", err))
+		}
+	}
+
+	if policy.IsEnabled() && len(policy.Approvers) == 0 {
+		sl.ReportError(policy.Approvers, "Approvers", "Approvers", invalidApprovalPolicyTag, "must contain at least one user when commands require approval")
+	}
+}
+
+func rateLimitStructValidator(sl validator.StructLevel) {
+	limit, ok := sl.Current().Interface().(RateLimit)
+	if !ok {
+		return
+	}
+
+	if limit.Requests > 0 && limit.Interval <= 0 {
+		sl.ReportError(limit.Interval, "Interval", "Interval", invalidRateLimitTag, "must be greater than 0 when requests are limited")
+	}
+}
+
+func quietHoursStructValidator(sl validator.StructLevel) {
+	quietHours, ok := sl.Current().Interface().(QuietHours)
+	if !ok {
+		return
+	}
+
+	if quietHours.Schedule != "" {
+		if _, err := cron.Parse(quietHours.Schedule); err != nil {
+			sl.ReportError(quietHours.Schedule, "Schedule", "Schedule", invalidQuietHoursTag, fmt.Sprintf("is not a valid cron expression: %s", err))
+		}
+	}
+
+	if quietHours.Timezone != "" {
+		if _, err := time.LoadLocation(quietHours.Timezone); err != nil {
+			sl.ReportError(quietHours.Timezone, "Timezone", "Timezone", invalidQuietHoursTag, fmt.Sprintf("is not a valid time zone: %s", err))
+		}
+	}
+}
+
+func routingRuleStructValidator(sl validator.StructLevel) {
+	rule, ok := sl.Current().Interface().(RoutingRule)
+	if !ok {
+		return
+	}
+
+	if len(rule.Receivers.Channels) == 0 && len(rule.Receivers.Sinks) == 0 {
+		sl.ReportError(rule.Receivers, "Receivers", "Receivers", invalidRoutingRuleTag, "must contain at least one channel or sink")
+	}
+
+	conf, ok := sl.Top().Interface().(Config)
+	if !ok {
+		return
+	}
+	for idx, name := range rule.Receivers.Sinks {
+		if !isKnownSinkReceiver(conf.Communications, name) {
+			fieldName := fmt.Sprintf("Receivers.Sinks[%d]", idx)
+			sl.ReportError(name, fieldName, fieldName, invalidRoutingRuleTag, "must be one of [elasticsearch webhook], optionally prefixed with a communication group name")
+		}
+	}
+	for _, name := range rule.Match.Sources {
+		if _, found := conf.Sources[name]; !found {
+			sl.ReportError(rule.Match.Sources, name, name, invalidBindingTag, "Config.Sources")
+		}
+	}
+}
+
+// isKnownSinkReceiver returns true if a given routing receiver refers to a sink integration, optionally from a given communication group.
+func isKnownSinkReceiver(comms map[string]Communications, name string) bool {
+	for _, sink := range []CommPlatformIntegration{ElasticsearchCommPlatformIntegration, WebhookCommPlatformIntegration} {
+		if name == string(sink) {
+			return true
+		}
+		for commGroupName := range comms {
+			if name == SinkReceiverName(commGroupName, sink) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func webhookStructValidator(sl validator.StructLevel) {
+	webhook, ok := sl.Current().Interface().(Webhook)
+	if !ok || !webhook.Enabled {
+		return
+	}
+
+	if webhook.Auth.BearerToken != "" && webhook.Auth.Basic.Username != "" {
+		sl.ReportError(webhook.Auth, "Auth", "Auth", invalidWebhookTag, "must define only one authentication method")
+	}
+	if webhook.Auth.Basic.Password != "" && webhook.Auth.Basic.Username == "" {
+		sl.ReportError(webhook.Auth.Basic.Username, "Auth.Basic.Username", "Username", invalidWebhookTag, "is required when password is set")
+	}
+	if (webhook.TLS.CertFile == "") != (webhook.TLS.KeyFile == "") {
+		sl.ReportError(webhook.TLS, "TLS", "TLS", invalidWebhookTag, "must define both certFile and keyFile for client authentication")
+	}
+}
+
 func botBindingsStructValidator(sl validator.StructLevel) {
 	bindings, ok := sl.Current().Interface().(BotBindings)
 	if !ok {
@@ -453,6 +585,9 @@ func validateActionExecutors(sl validator.StructLevel, executors map[string]Exec
 			if plugin.Context.RBAC.Group.Type == ChannelNamePolicySubjectType {
 				sl.ReportError(bindings, pluginKey, executor, invalidActionRBACTag, "")
 			}
+			if plugin.Context.RBAC.UsesChatUser() {
+				sl.ReportError(bindings, pluginKey, executor, unsupportedChatUserRBACTag, "actions")
+			}
 		}
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"sync"
 	"text/tabwriter"
 
 	"github.com/sirupsen/logrus"
@@ -35,7 +36,9 @@ type ActionsStorage interface {
 type ActionExecutor struct {
 	log        logrus.FieldLogger
 	cfgManager ActionsStorage
-	actions    map[string]config.Action
+
+	mu      sync.RWMutex
+	actions map[string]config.Action
 }
 
 // NewActionExecutor returns a new ActionExecutor instance.
@@ -47,6 +50,13 @@ func NewActionExecutor(log logrus.FieldLogger, cfgManager ActionsStorage, cfg co
 	}
 }
 
+// SetActions replaces the actions, so the configuration changes are applied without restart.
+func (e *ActionExecutor) SetActions(actions config.Actions) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.actions = actions
+}
+
 // Commands returns slice of commands the executor supports
 func (e *ActionExecutor) Commands() map[command.Verb]CommandFn {
 	return map[command.Verb]CommandFn{
@@ -99,6 +109,8 @@ func (e *ActionExecutor) Disable(ctx context.Context, cmdCtx CommandContext) (in
 
 // ActionsTabularOutput sorts actions by key and returns a printable table
 func (e *ActionExecutor) ActionsTabularOutput() string {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
 	keys := maputil.SortKeys(e.actions)
 
 	buf := new(bytes.Buffer)
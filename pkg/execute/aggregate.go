@@ -0,0 +1,205 @@
+package execute
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+)
+
+const (
+	// fanOutCollectWindow is the time instances wait for replies of other instances before the comparison table is posted.
+	fanOutCollectWindow = 10 * time.Second
+	// fanOutIDLength is the length of the ID which ties the replies to a given command.
+	fanOutIDLength = 8
+
+	fanOutReplyFmt = "Cluster `%s` replied (aggregation %s):\n```\n%s\n```"
+	fanOutTableFmt = "Comparison table (aggregation %s) of %d clusters:\n```\n%s\n```"
+)
+
+var (
+	fanOutReplyRegex = regexp.MustCompile("(?s)^Cluster `([^`]+)` replied \\(aggregation ([0-9a-f]+)\\):\n```\n(.*)\n```\\s*$")
+	fanOutTableRegex = regexp.MustCompile(`^Comparison table \(aggregation ([0-9a-f]+)\)`)
+	tableColumnRegex = regexp.MustCompile(`\s{2,}`)
+)
+
+// FanOutThread posts and reads plain text replies in the thread of a command message. Botkube instances answering the same
+// command which targets multiple clusters coordinate through it: each instance posts its reply, and the elected one
+// collects all replies into a single comparison table.
+// Bots create it for each incoming message. It's set only on platforms which can read thread replies.
+type FanOutThread interface {
+	// PostThreadReply posts a plain text reply in the thread of the command message.
+	PostThreadReply(ctx context.Context, text string) error
+	// ThreadReplies returns plain texts of the replies posted in the thread after the command message.
+	ThreadReplies(ctx context.Context) ([]string, error)
+}
+
+// fanOutAggregator collects replies of Botkube instances to a command targeting multiple clusters.
+// Instances wait for the same time after posting their replies, and the one with the lowest cluster name among the posted
+// replies is elected to post the comparison table. Replies posted after the collect window are not included.
+type fanOutAggregator struct {
+	thread        FanOutThread
+	clusterName   string
+	id            string
+	collectWindow time.Duration
+}
+
+func newFanOutAggregator(thread FanOutThread, clusterName, rawCmd string) *fanOutAggregator {
+	sum := sha256.Sum256([]byte(rawCmd))
+	return &fanOutAggregator{
+		thread:        thread,
+		clusterName:   clusterName,
+		id:            hex.EncodeToString(sum[:])[:fanOutIDLength],
+		collectWindow: fanOutCollectWindow,
+	}
+}
+
+// Aggregate posts the reply of this instance in the thread, and posts the comparison table if this instance is elected.
+// It returns false if the reply can't be aggregated, and it should be sent as usual.
+func (a *fanOutAggregator) Aggregate(ctx context.Context, msg interactive.CoreMessage) (bool, error) {
+	output, ok := fanOutOutput(msg)
+	if !ok {
+		return false, nil
+	}
+	if err := a.thread.PostThreadReply(ctx, fmt.Sprintf(fanOutReplyFmt, a.clusterName, a.id, output)); err != nil {
+		return false, fmt.Errorf("while posting reply for aggregation: %w", err)
+	}
+
+	select {
+	case <-ctx.Done():
+		return true, ctx.Err()
+	case <-time.After(a.collectWindow):
+	}
+
+	replies, err := a.thread.ThreadReplies(ctx)
+	if err != nil {
+		return true, fmt.Errorf("while getting replies for aggregation: %w", err)
+	}
+	outputs, tablePosted := a.parseReplies(replies)
+	if tablePosted {
+		return true, nil
+	}
+	outputs[a.clusterName] = output
+
+	if electedClusterName(outputs) != a.clusterName {
+		return true, nil
+	}
+
+	table := fmt.Sprintf(fanOutTableFmt, a.id, len(outputs), fanOutComparisonTable(outputs))
+	if err := a.thread.PostThreadReply(ctx, table); err != nil {
+		return true, fmt.Errorf("while posting comparison table: %w", err)
+	}
+	return true, nil
+}
+
+// parseReplies returns outputs of the replies to the same command per cluster name, and whether the comparison table is already posted.
+func (a *fanOutAggregator) parseReplies(replies []string) (map[string]string, bool) {
+	outputs := map[string]string{}
+	for _, reply := range replies {
+		if matches := fanOutTableRegex.FindStringSubmatch(reply); matches != nil && matches[1] == a.id {
+			return nil, true
+		}
+
+		matches := fanOutReplyRegex.FindStringSubmatch(reply)
+		if matches == nil || matches[2] != a.id {
+			continue
+		}
+		if _, found := outputs[matches[1]]; !found {
+			outputs[matches[1]] = matches[3]
+		}
+	}
+	return outputs, false
+}
+
+func electedClusterName(outputs map[string]string) string {
+	elected := ""
+	for name := range outputs {
+		if elected == "" || name < elected {
+			elected = name
+		}
+	}
+	return elected
+}
+
+// fanOutOutput returns the command output from a given message. Messages with interactive elements can't be aggregated.
+func fanOutOutput(msg interactive.CoreMessage) (string, bool) {
+	if msg.HasInputs() || msg.HasSections() || len(msg.Attachments) > 0 {
+		return "", false
+	}
+
+	out := msg.BaseBody.CodeBlock
+	if out == "" {
+		out = msg.BaseBody.Plaintext
+	}
+	out = strings.Trim(out, "\n")
+	if out == "" {
+		return "", false
+	}
+	return out, true
+}
+
+// fanOutComparisonTable returns a table with the outputs of all clusters. If all outputs are tables with the same header,
+// such as `kubectl get` outputs, their rows are merged into a single table with the cluster column. Otherwise, the table
+// contains the cluster and output columns.
+func fanOutComparisonTable(outputs map[string]string) string {
+	names := make([]string, 0, len(outputs))
+	for name := range outputs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	buf := new(bytes.Buffer)
+	w := tabwriter.NewWriter(buf, 5, 0, 1, ' ', 0)
+
+	if header, ok := commonTableHeader(names, outputs); ok {
+		fmt.Fprintf(w, "CLUSTER\t%s\n", tableColumns(header))
+		for _, name := range names {
+			for _, line := range strings.Split(outputs[name], "\n")[1:] {
+				fmt.Fprintf(w, "%s\t%s\n", name, tableColumns(line))
+			}
+		}
+	} else {
+		fmt.Fprintln(w, "CLUSTER\tOUTPUT")
+		for _, name := range names {
+			for idx, line := range strings.Split(outputs[name], "\n") {
+				cluster := name
+				if idx > 0 {
+					cluster = ""
+				}
+				fmt.Fprintf(w, "%s\t%s\n", cluster, line)
+			}
+		}
+	}
+
+	w.Flush()
+	return strings.TrimRight(buf.String(), "\n")
+}
+
+// commonTableHeader returns the header shared by all outputs. Headers are compared by columns, as their widths differ.
+func commonTableHeader(names []string, outputs map[string]string) (string, bool) {
+	header := ""
+	for idx, name := range names {
+		first, _, _ := strings.Cut(outputs[name], "\n")
+		if idx == 0 {
+			header = first
+			continue
+		}
+		if tableColumns(first) != tableColumns(header) {
+			return "", false
+		}
+	}
+	return header, strings.Contains(tableColumns(header), "\t")
+}
+
+// tableColumns returns the line with columns separated with tabs, so they are aligned again in the merged table.
+func tableColumns(line string) string {
+	return strings.Join(tableColumnRegex.Split(strings.TrimSpace(line), -1), "\t")
+}
@@ -0,0 +1,165 @@
+package execute
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+)
+
+func TestFanOutAggregatorAggregate(t *testing.T) {
+	const (
+		rawCmd   = "kubectl get deploy --cluster-name='prod-*' --aggregate"
+		euOutput = "NAME    READY   UP-TO-DATE\napi     2/2     2\nworker  1/1     1"
+		usOutput = "NAME         READY   UP-TO-DATE\napi          3/3     3\nlong-worker  0/1     0"
+	)
+	otherCmdReply := fmt.Sprintf(fanOutReplyFmt, "prod-ap", "00000000", "NAME   READY   UP-TO-DATE\napi    1/1     1")
+
+	tests := []struct {
+		name          string
+		clusterName   string
+		givenReplies  []string
+		expPosts      int
+		expTable      string
+		expTableTotal int
+	}{
+		{
+			name:        "Elected instance posts merged comparison table",
+			clusterName: "prod-eu",
+			givenReplies: []string{
+				fmt.Sprintf(fanOutReplyFmt, "prod-us", fixFanOutID(rawCmd), usOutput),
+				otherCmdReply,
+			},
+			expPosts: 2,
+			expTable: "CLUSTER NAME        READY UP-TO-DATE\n" +
+				"prod-eu api         2/2   2\n" +
+				"prod-eu worker      1/1   1\n" +
+				"prod-us api         3/3   3\n" +
+				"prod-us long-worker 0/1   0",
+			expTableTotal: 2,
+		},
+		{
+			name:        "Other instance is elected",
+			clusterName: "prod-us",
+			givenReplies: []string{
+				fmt.Sprintf(fanOutReplyFmt, "prod-eu", fixFanOutID(rawCmd), euOutput),
+			},
+			expPosts: 1,
+		},
+		{
+			name:        "Table is already posted",
+			clusterName: "prod-eu",
+			givenReplies: []string{
+				fmt.Sprintf(fanOutTableFmt, fixFanOutID(rawCmd), 1, "CLUSTER OUTPUT"),
+			},
+			expPosts: 1,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			output := euOutput
+			if tc.clusterName == "prod-us" {
+				output = usOutput
+			}
+			thread := &fakeFanOutThread{replies: tc.givenReplies}
+			aggregator := newFanOutAggregator(thread, tc.clusterName, rawCmd)
+			aggregator.collectWindow = 0
+
+			// when
+			aggregated, err := aggregator.Aggregate(context.Background(), fixFanOutMessage(output))
+
+			// then
+			require.NoError(t, err)
+			assert.True(t, aggregated)
+			require.Len(t, thread.posted, tc.expPosts)
+			assert.Equal(t, fmt.Sprintf(fanOutReplyFmt, tc.clusterName, fixFanOutID(rawCmd), output), thread.posted[0])
+			if tc.expTable != "" {
+				assert.Equal(t, fmt.Sprintf(fanOutTableFmt, fixFanOutID(rawCmd), tc.expTableTotal, tc.expTable), thread.posted[1])
+			}
+		})
+	}
+}
+
+func TestFanOutAggregatorAggregateNotSupportedMessage(t *testing.T) {
+	// given
+	thread := &fakeFanOutThread{}
+	msg := interactive.CoreMessage{
+		Message: api.Message{
+			Sections: []api.Section{{Base: api.Base{Header: "Select"}}},
+		},
+	}
+
+	// when
+	aggregated, err := newFanOutAggregator(thread, "prod-eu", "ping --cluster-name='prod-*' --aggregate").Aggregate(context.Background(), msg)
+
+	// then
+	require.NoError(t, err)
+	assert.False(t, aggregated)
+	assert.Empty(t, thread.posted)
+}
+
+func TestFanOutComparisonTableDifferentOutputs(t *testing.T) {
+	// given
+	outputs := map[string]string{
+		"prod-us": "pong",
+		"prod-eu": "Error: forbidden\nCheck the RBAC",
+	}
+
+	// when
+	table := fanOutComparisonTable(outputs)
+
+	// then
+	assert.Equal(t, "CLUSTER OUTPUT\n"+
+		"prod-eu Error: forbidden\n"+
+		"        Check the RBAC\n"+
+		"prod-us pong", table)
+}
+
+func TestParseFlagsAggregate(t *testing.T) {
+	// when
+	flags, err := ParseFlags("kubectl get po --cluster-name='prod-*' --aggregate -n default")
+
+	// then
+	require.NoError(t, err)
+	assert.True(t, flags.Aggregate)
+	assert.Equal(t, "prod-*", flags.ClusterName)
+	assert.Equal(t, []string{"kubectl", "get", "po", "-n", "default"}, flags.TokenizedCmd)
+}
+
+type fakeFanOutThread struct {
+	mu      sync.Mutex
+	replies []string
+	posted  []string
+}
+
+func (f *fakeFanOutThread) PostThreadReply(_ context.Context, text string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.posted = append(f.posted, text)
+	return nil
+}
+
+func (f *fakeFanOutThread) ThreadReplies(context.Context) ([]string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append(append([]string{}, f.replies...), f.posted...), nil
+}
+
+func fixFanOutID(rawCmd string) string {
+	return newFanOutAggregator(nil, "", rawCmd).id
+}
+
+func fixFanOutMessage(output string) interactive.CoreMessage {
+	return interactive.CoreMessage{
+		Message: api.Message{
+			BaseBody: api.Body{CodeBlock: output},
+		},
+	}
+}
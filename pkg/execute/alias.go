@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"sync"
 	"text/tabwriter"
 
 	"github.com/sirupsen/logrus"
@@ -28,6 +29,8 @@ var featureName = FeatureName{
 // AliasExecutor executes all commands that are related to aliases.
 type AliasExecutor struct {
 	log logrus.FieldLogger
+
+	mu  sync.RWMutex
 	cfg config.Config
 }
 
@@ -36,6 +39,13 @@ func NewAliasExecutor(log logrus.FieldLogger, cfg config.Config) *AliasExecutor
 	return &AliasExecutor{log: log, cfg: cfg}
 }
 
+// SetAliases replaces the aliases, so the configuration changes are applied without restart.
+func (e *AliasExecutor) SetAliases(aliases config.Aliases) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.cfg.Aliases = aliases
+}
+
 // Commands returns slice of commands the executor supports.
 func (e *AliasExecutor) Commands() map[command.Verb]CommandFn {
 	return map[command.Verb]CommandFn{
@@ -74,6 +84,8 @@ func (e *AliasExecutor) FeatureName() FeatureName {
 func (e *AliasExecutor) getTabularOutput(bindings []string) string {
 	aliasesToDisplay := make(map[string]config.Alias)
 
+	e.mu.RLock()
+	defer e.mu.RUnlock()
 	aliasesCfg := e.cfg.Aliases
 	executors := executorsForBindings(e.cfg.Executors, bindings)
 	for exName, enabled := range executors {
@@ -0,0 +1,316 @@
+package execute
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/kubeshop/botkube/internal/audit"
+	remoteapi "github.com/kubeshop/botkube/internal/remote"
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/execute/command"
+)
+
+var _ CommandExecutor = &ApprovalExecutor{}
+
+const (
+	// approvalIDLength is the length of generated approval request IDs. They are short, so they can be easily typed in chat.
+	approvalIDLength = 8
+
+	approvalRequestedMsgFmt = "Command %s requested by %s requires approval of another authorized user. The request %s expires at %s."
+	approvalRejectedMsgFmt  = "Command %s requested by %s was rejected by %s."
+	approvalNotFoundMsgFmt  = "Approval request %q not found. It was already processed or it has expired."
+	noPendingApprovalsMsg   = "There are no pending approval requests."
+	approvalUsageMsgFmt     = `Usage:
+  %[1]s approve approval <id>
+  %[1]s reject approval <id>
+  %[1]s list approvals`
+)
+
+var approvalFeatureName = FeatureName{
+	Name:    "approval",
+	Aliases: []string{"approvals"},
+}
+
+// ApprovalRequest describes a command which waits for approval.
+type ApprovalRequest struct {
+	ID         string
+	PluginName string
+	Policy     config.ApprovalPolicy
+	Bindings   []string
+	ExpiresAt  time.Time
+
+	// CmdCtx is the context of the user who requested the command. It is used to execute the command once approved.
+	CmdCtx CommandContext
+}
+
+// approvedCommandRunner executes approved commands.
+type approvedCommandRunner func(ctx context.Context, req ApprovalRequest) (interactive.CoreMessage, error)
+
+// ApprovalExecutor holds commands which require approval and executes them once an authorized user approves them.
+type ApprovalExecutor struct {
+	log           logrus.FieldLogger
+	cfg           config.Config
+	auditReporter audit.AuditReporter
+	run           approvedCommandRunner
+	now           func() time.Time
+
+	mu      sync.Mutex
+	pending map[string]ApprovalRequest
+}
+
+// NewApprovalExecutor returns a new ApprovalExecutor instance.
+func NewApprovalExecutor(log logrus.FieldLogger, cfg config.Config, pluginExecutor *PluginExecutor, auditReporter audit.AuditReporter) *ApprovalExecutor {
+	return &ApprovalExecutor{
+		log:           log,
+		cfg:           cfg,
+		auditReporter: auditReporter,
+		run: func(ctx context.Context, req ApprovalRequest) (interactive.CoreMessage, error) {
+			return pluginExecutor.Execute(ctx, req.Bindings, nil, req.CmdCtx)
+		},
+		now:     time.Now,
+		pending: map[string]ApprovalRequest{},
+	}
+}
+
+// Commands returns slice of commands the executor supports.
+func (e *ApprovalExecutor) Commands() map[command.Verb]CommandFn {
+	return map[command.Verb]CommandFn{
+		command.ApproveVerb: e.Approve,
+		command.RejectVerb:  e.Reject,
+		command.ListVerb:    e.List,
+	}
+}
+
+// FeatureName returns the name and aliases of the feature provided by this executor.
+func (e *ApprovalExecutor) FeatureName() FeatureName {
+	return approvalFeatureName
+}
+
+// PolicyFor returns the approval policy of the first executor binding which enables a given plugin and requires approval for a given command.
+func (e *ApprovalExecutor) PolicyFor(bindings []string, pluginName string, cmd string) (config.ApprovalPolicy, bool) {
+	for _, bindingName := range bindings {
+		executors, found := e.cfg.Executors[bindingName]
+		if !found || !executors.Approval.IsEnabled() {
+			continue
+		}
+
+		plugin, found := executors.Plugins[pluginName]
+		if !found || !plugin.Enabled {
+			continue
+		}
+
+		if executors.Approval.Matches(cmd) {
+			return executors.Approval, true
+		}
+	}
+	return config.ApprovalPolicy{}, false
+}
+
+// Request stores a given command as pending and returns the interactive approval message.
+func (e *ApprovalExecutor) Request(ctx context.Context, pluginName string, policy config.ApprovalPolicy, bindings []string, cmdCtx CommandContext) interactive.CoreMessage {
+	req := ApprovalRequest{
+		ID:         uuid.New().String()[:approvalIDLength],
+		PluginName: pluginName,
+		Policy:     policy,
+		Bindings:   bindings,
+		ExpiresAt:  e.now().Add(policy.GetTTL()),
+		CmdCtx:     cmdCtx,
+	}
+
+	e.mu.Lock()
+	e.removeExpired()
+	e.pending[req.ID] = req
+	e.mu.Unlock()
+
+	e.log.WithFields(logrus.Fields{
+		"id":      req.ID,
+		"command": cmdCtx.CleanCmd,
+	}).Info("Command requires approval")
+	e.reportDecision(ctx, req, audit.ApprovalRequested, "")
+
+	btnBuilder := api.NewMessageButtonBuilder()
+	return interactive.CoreMessage{
+		Description: header(cmdCtx),
+		Message: api.Message{
+			Sections: []api.Section{
+				{
+					Base: api.Base{
+						Header:      "Approval required",
+						Description: fmt.Sprintf(approvalRequestedMsgFmt, quotedCmd(cmdCtx), userName(cmdCtx.User), req.ID, req.ExpiresAt.Format(time.RFC1123)),
+					},
+					Buttons: []api.Button{
+						btnBuilder.ForCommandWithoutDesc("Approve", fmt.Sprintf("%s %s %s", command.ApproveVerb, approvalFeatureName.Name, req.ID), api.ButtonStylePrimary),
+						btnBuilder.ForCommandWithoutDesc("Reject", fmt.Sprintf("%s %s %s", command.RejectVerb, approvalFeatureName.Name, req.ID), api.ButtonStyleDanger),
+					},
+				},
+			},
+		},
+	}
+}
+
+// Approve executes a pending command if the user is allowed to approve it.
+func (e *ApprovalExecutor) Approve(ctx context.Context, cmdCtx CommandContext) (interactive.CoreMessage, error) {
+	req, err := e.take(cmdCtx, func(req ApprovalRequest) error {
+		if !req.Policy.IsApprover(cmdCtx.User.ID, cmdCtx.User.Email) {
+			return NewExecutionCommandError("You are not allowed to approve this command.")
+		}
+		if isSameUser(req.CmdCtx.User, cmdCtx.User) {
+			return NewExecutionCommandError("A command cannot be approved by the user who requested it.")
+		}
+		return nil
+	})
+	if err != nil {
+		return interactive.CoreMessage{}, err
+	}
+
+	e.log.WithFields(logrus.Fields{
+		"id":         req.ID,
+		"approvedBy": cmdCtx.User.DisplayName,
+	}).Info("Executing approved command...")
+	e.reportDecision(ctx, req, audit.ApprovalApproved, cmdCtx.User.DisplayName)
+
+	out, err := e.run(ctx, req)
+	switch {
+	case err == nil:
+	case IsExecutionCommandError(err):
+		return interactive.CoreMessage{}, err
+	default:
+		return interactive.CoreMessage{}, fmt.Errorf("while executing approved command: %w", err)
+	}
+	out.Description = fmt.Sprintf("%s, approved by %s", header(req.CmdCtx), userName(cmdCtx.User))
+	return out, nil
+}
+
+// Reject removes a pending command if the user is allowed to reject it.
+func (e *ApprovalExecutor) Reject(ctx context.Context, cmdCtx CommandContext) (interactive.CoreMessage, error) {
+	req, err := e.take(cmdCtx, func(req ApprovalRequest) error {
+		// the requester can withdraw their own request
+		if !req.Policy.IsApprover(cmdCtx.User.ID, cmdCtx.User.Email) && !isSameUser(req.CmdCtx.User, cmdCtx.User) {
+			return NewExecutionCommandError("You are not allowed to reject this command.")
+		}
+		return nil
+	})
+	if err != nil {
+		return interactive.CoreMessage{}, err
+	}
+
+	e.log.WithFields(logrus.Fields{
+		"id":         req.ID,
+		"rejectedBy": cmdCtx.User.DisplayName,
+	}).Info("Command was rejected")
+	e.reportDecision(ctx, req, audit.ApprovalRejected, cmdCtx.User.DisplayName)
+
+	return respond(fmt.Sprintf(approvalRejectedMsgFmt, quotedCmd(req.CmdCtx), userName(req.CmdCtx.User), userName(cmdCtx.User)), cmdCtx), nil
+}
+
+// List returns pending approval requests.
+func (e *ApprovalExecutor) List(_ context.Context, cmdCtx CommandContext) (interactive.CoreMessage, error) {
+	e.mu.Lock()
+	e.removeExpired()
+	requests := make([]ApprovalRequest, 0, len(e.pending))
+	for _, req := range e.pending {
+		requests = append(requests, req)
+	}
+	e.mu.Unlock()
+
+	if len(requests) == 0 {
+		return respond(noPendingApprovalsMsg, cmdCtx), nil
+	}
+
+	buf := new(bytes.Buffer)
+	w := tabwriter.NewWriter(buf, 5, 0, 1, ' ', 0)
+	fmt.Fprintf(w, "ID\tCOMMAND\tREQUESTED BY\tEXPIRES")
+	sort.Slice(requests, func(i, j int) bool {
+		return requests[i].ExpiresAt.Before(requests[j].ExpiresAt)
+	})
+	for _, req := range requests {
+		fmt.Fprintf(w, "\n%s\t%s\t%s\t%s", req.ID, req.CmdCtx.CleanCmd, userName(req.CmdCtx.User), req.ExpiresAt.Format(time.RFC1123))
+	}
+	w.Flush()
+	return respond(buf.String(), cmdCtx), nil
+}
+
+// take removes a given pending request if the user is authorized to process it.
+// The request is removed under a lock, so it is never processed twice.
+func (e *ApprovalExecutor) take(cmdCtx CommandContext, authorize func(req ApprovalRequest) error) (ApprovalRequest, error) {
+	if len(cmdCtx.Args) < 3 {
+		return ApprovalRequest{}, NewExecutionCommandError("Approval request ID is required.\n\n%s", fmt.Sprintf(approvalUsageMsgFmt, api.MessageBotNamePlaceholder))
+	}
+	id := cmdCtx.Args[2]
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.removeExpired()
+	req, found := e.pending[id]
+	if !found {
+		return ApprovalRequest{}, NewExecutionCommandError(approvalNotFoundMsgFmt, id)
+	}
+	if err := authorize(req); err != nil {
+		e.log.WithFields(logrus.Fields{
+			"id":   id,
+			"user": cmdCtx.User.DisplayName,
+		}).Warn("Unauthorized approval decision attempt")
+		return ApprovalRequest{}, err
+	}
+
+	delete(e.pending, id)
+	return req, nil
+}
+
+// removeExpired removes expired requests. It must be called with the lock held.
+func (e *ApprovalExecutor) removeExpired() {
+	now := e.now()
+	for id, req := range e.pending {
+		if now.After(req.ExpiresAt) {
+			e.log.WithField("id", id).Info("Approval request expired")
+			delete(e.pending, id)
+		}
+	}
+}
+
+func (e *ApprovalExecutor) reportDecision(ctx context.Context, req ApprovalRequest, decision audit.ApprovalDecision, decidedBy string) {
+	err := e.auditReporter.ReportApprovalAuditEvent(ctx, audit.ApprovalAuditEvent{
+		CreatedAt:   e.now().Format(time.RFC3339),
+		RequestID:   req.ID,
+		Decision:    decision,
+		PluginName:  req.PluginName,
+		Command:     req.CmdCtx.ExpandedRawCmd,
+		Channel:     auditChannelName(req.CmdCtx.Conversation),
+		BotPlatform: remoteapi.NewBotPlatform(req.CmdCtx.Platform.String()),
+		RequestedBy: req.CmdCtx.User.DisplayName,
+		DecidedBy:   decidedBy,
+	})
+	if err != nil {
+		e.log.Errorf("while reporting %s approval audit event for %q: %s", decision, req.ID, err.Error())
+	}
+}
+
+func isSameUser(a, b UserInput) bool {
+	if a.ID != "" || b.ID != "" {
+		return a.ID == b.ID
+	}
+	return a.DisplayName == b.DisplayName
+}
+
+func userName(user UserInput) string {
+	if user.Mention != "" {
+		return user.Mention
+	}
+	return user.DisplayName
+}
+
+func quotedCmd(cmdCtx CommandContext) string {
+	return fmt.Sprintf("`%s`", strings.TrimSpace(cmdCtx.CleanCmd))
+}
@@ -0,0 +1,297 @@
+package execute
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/internal/audit"
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+func TestApprovalExecutorPolicyFor(t *testing.T) {
+	// given
+	cfg := config.Config{
+		Executors: map[string]config.Executors{
+			"read-only": {
+				Plugins: config.Plugins{
+					"botkube/kubectl": {Enabled: true},
+				},
+			},
+			"admin": {
+				Approval: config.ApprovalPolicy{
+					CommandPrefixes: []string{"kubectl delete"},
+					CommandRegexes:  []string{`^helm (rollback|uninstall)\b`},
+					Approvers:       []string{"U1"},
+				},
+				Plugins: config.Plugins{
+					"botkube/kubectl": {Enabled: true},
+					"botkube/helm":    {Enabled: true},
+				},
+			},
+			"disabled": {
+				Approval: config.ApprovalPolicy{
+					CommandPrefixes: []string{"flux reconcile"},
+					Approvers:       []string{"U1"},
+				},
+				Plugins: config.Plugins{
+					"botkube/flux": {Enabled: false},
+				},
+			},
+		},
+	}
+	executor := NewApprovalExecutor(loggerx.NewNoop(), cfg, nil, &fakeAuditReporter{})
+	bindings := []string{"read-only", "admin", "disabled"}
+
+	tests := []struct {
+		name        string
+		pluginName  string
+		cmd         string
+		expRequired bool
+	}{
+		{
+			name:        "matched by prefix",
+			pluginName:  "botkube/kubectl",
+			cmd:         "kubectl delete pod nginx -n default",
+			expRequired: true,
+		},
+		{
+			name:        "prefix must match whole words",
+			pluginName:  "botkube/kubectl",
+			cmd:         "kubectl deletex pod nginx",
+			expRequired: false,
+		},
+		{
+			name:        "flags before the verb",
+			pluginName:  "botkube/kubectl",
+			cmd:         "kubectl -n prod delete pod nginx",
+			expRequired: true,
+		},
+		{
+			name:        "flag with value before the verb",
+			pluginName:  "botkube/kubectl",
+			cmd:         "kubectl --context=prod delete pod nginx",
+			expRequired: true,
+		},
+		{
+			name:        "boolean and value flags before the verb",
+			pluginName:  "botkube/kubectl",
+			cmd:         "kubectl --insecure-skip-tls-verify -n prod delete pod nginx",
+			expRequired: true,
+		},
+		{
+			name:        "flag value equal to the verb",
+			pluginName:  "botkube/kubectl",
+			cmd:         "kubectl get pods -n delete",
+			expRequired: false,
+		},
+		{
+			name:        "flags before the verb with read-only command",
+			pluginName:  "botkube/kubectl",
+			cmd:         "kubectl -n prod get pods",
+			expRequired: false,
+		},
+		{
+			name:        "read-only command",
+			pluginName:  "botkube/kubectl",
+			cmd:         "kubectl get pods",
+			expRequired: false,
+		},
+		{
+			name:        "matched by regex",
+			pluginName:  "botkube/helm",
+			cmd:         "helm rollback my-release 2",
+			expRequired: true,
+		},
+		{
+			name:        "matched by regex with flags before the verb",
+			pluginName:  "botkube/helm",
+			cmd:         "helm --kube-context prod rollback my-release 2",
+			expRequired: true,
+		},
+		{
+			name:        "plugin is disabled in binding with policy",
+			pluginName:  "botkube/flux",
+			cmd:         "flux reconcile source git podinfo",
+			expRequired: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			_, required := executor.PolicyFor(bindings, tc.pluginName, tc.cmd)
+
+			// then
+			assert.Equal(t, tc.expRequired, required)
+		})
+	}
+}
+
+func TestApprovalExecutorApprove(t *testing.T) {
+	// given
+	ctx := context.Background()
+	auditReporter := &fakeAuditReporter{}
+	executor := NewApprovalExecutor(loggerx.NewNoop(), config.Config{}, nil, auditReporter)
+
+	var executed []ApprovalRequest
+	executor.run = func(_ context.Context, req ApprovalRequest) (interactive.CoreMessage, error) {
+		executed = append(executed, req)
+		return respond("pod \"nginx\" deleted", req.CmdCtx), nil
+	}
+
+	requester := fixApprovalCmdCtx(UserInput{ID: "U0", DisplayName: "Joe"}, "kubectl", "delete", "pod", "nginx")
+	policy := fixApprovalPolicy()
+	policy.Approvers = append(policy.Approvers, "U0")
+
+	// when
+	msg := executor.Request(ctx, "botkube/kubectl", policy, []string{"admin"}, requester)
+
+	// then
+	require.Len(t, msg.Sections, 1)
+	id := onlyPendingID(t, executor)
+	assert.Equal(t, api.Buttons{
+		{Name: "Approve", Command: "{{BotName}} approve approval " + id, Style: api.ButtonStylePrimary},
+		{Name: "Reject", Command: "{{BotName}} reject approval " + id, Style: api.ButtonStyleDanger},
+	}, msg.Sections[0].Buttons)
+
+	// when not an approver
+	_, err := executor.Approve(ctx, fixApprovalCmdCtx(UserInput{ID: "U9", DisplayName: "Eve"}, "approve", "approval", id))
+
+	// then
+	require.Error(t, err)
+	assert.EqualError(t, err, "You are not allowed to approve this command.")
+
+	// when approved by requester
+	_, err = executor.Approve(ctx, fixApprovalCmdCtx(UserInput{ID: "U0", DisplayName: "Joe"}, "approve", "approval", id))
+
+	// then
+	require.Error(t, err)
+	assert.EqualError(t, err, "A command cannot be approved by the user who requested it.")
+	assert.Empty(t, executed)
+
+	// when approved by approver
+	msg, err = executor.Approve(ctx, fixApprovalCmdCtx(UserInput{ID: "U1", DisplayName: "Jane", Mention: "@Jane"}, "approve", "approval", id))
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, executed, 1)
+	assert.Equal(t, requester, executed[0].CmdCtx)
+	assert.Equal(t, "`kubectl delete pod nginx` on `cluster-name`, approved by @Jane", msg.Description)
+	assert.Equal(t, []audit.ApprovalDecision{audit.ApprovalRequested, audit.ApprovalApproved}, auditReporter.decisions())
+	assert.Equal(t, "Jane", auditReporter.approvals[1].DecidedBy)
+	assert.Equal(t, "Joe", auditReporter.approvals[1].RequestedBy)
+
+	// when approved again
+	_, err = executor.Approve(ctx, fixApprovalCmdCtx(UserInput{ID: "U1", DisplayName: "Jane"}, "approve", "approval", id))
+
+	// then
+	require.Error(t, err)
+	assert.True(t, IsExecutionCommandError(err))
+	assert.Len(t, executed, 1)
+}
+
+func TestApprovalExecutorReject(t *testing.T) {
+	// given
+	ctx := context.Background()
+	auditReporter := &fakeAuditReporter{}
+	executor := NewApprovalExecutor(loggerx.NewNoop(), config.Config{}, nil, auditReporter)
+	executor.run = func(context.Context, ApprovalRequest) (interactive.CoreMessage, error) {
+		t.Fatal("rejected command must not be executed")
+		return interactive.CoreMessage{}, nil
+	}
+
+	requester := fixApprovalCmdCtx(UserInput{ID: "U0", DisplayName: "Joe", Mention: "@Joe"}, "helm", "uninstall", "my-release")
+	executor.Request(ctx, "botkube/helm", fixApprovalPolicy(), []string{"admin"}, requester)
+	id := onlyPendingID(t, executor)
+
+	// when
+	msg, err := executor.Reject(ctx, fixApprovalCmdCtx(UserInput{ID: "U1", DisplayName: "Jane", Mention: "@Jane"}, "reject", "approval", id))
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "Command `helm uninstall my-release` requested by @Joe was rejected by @Jane.", msg.BaseBody.CodeBlock)
+	assert.Empty(t, executor.pending)
+	assert.Equal(t, []audit.ApprovalDecision{audit.ApprovalRequested, audit.ApprovalRejected}, auditReporter.decisions())
+}
+
+func TestApprovalExecutorExpiredRequest(t *testing.T) {
+	// given
+	ctx := context.Background()
+	now := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+	executor := NewApprovalExecutor(loggerx.NewNoop(), config.Config{}, nil, &fakeAuditReporter{})
+	executor.now = func() time.Time { return now }
+
+	executor.Request(ctx, "botkube/kubectl", fixApprovalPolicy(), []string{"admin"}, fixApprovalCmdCtx(UserInput{ID: "U0"}, "kubectl", "delete", "ns", "foo"))
+	id := onlyPendingID(t, executor)
+
+	// when
+	now = now.Add(config.DefaultApprovalTTL + time.Second)
+	_, err := executor.Approve(ctx, fixApprovalCmdCtx(UserInput{ID: "U1"}, "approve", "approval", id))
+
+	// then
+	require.Error(t, err)
+	assert.EqualError(t, err, `Approval request "`+id+`" not found. It was already processed or it has expired.`)
+	assert.Empty(t, executor.pending)
+}
+
+func fixApprovalPolicy() config.ApprovalPolicy {
+	return config.ApprovalPolicy{
+		CommandPrefixes: []string{"kubectl delete", "helm uninstall"},
+		Approvers:       []string{"U1", "jane@example.com"},
+	}
+}
+
+func fixApprovalCmdCtx(user UserInput, args ...string) CommandContext {
+	cmd := strings.Join(args, " ")
+	return CommandContext{
+		Args:           args,
+		CleanCmd:       cmd,
+		ExpandedRawCmd: cmd,
+		ClusterName:    clusterName,
+		User:           user,
+		ExecutorFilter: newExecutorTextFilter(""),
+	}
+}
+
+func onlyPendingID(t *testing.T, executor *ApprovalExecutor) string {
+	t.Helper()
+	require.Len(t, executor.pending, 1)
+	for id := range executor.pending {
+		return id
+	}
+	return ""
+}
+
+type fakeAuditReporter struct {
+	approvals  []audit.ApprovalAuditEvent
+	executions []audit.ExecutorAuditEvent
+}
+
+func (f *fakeAuditReporter) ReportExecutorAuditEvent(_ context.Context, e audit.ExecutorAuditEvent) error {
+	f.executions = append(f.executions, e)
+	return nil
+}
+
+func (f *fakeAuditReporter) ReportSourceAuditEvent(context.Context, audit.SourceAuditEvent) error {
+	return nil
+}
+
+func (f *fakeAuditReporter) ReportApprovalAuditEvent(_ context.Context, e audit.ApprovalAuditEvent) error {
+	f.approvals = append(f.approvals, e)
+	return nil
+}
+
+func (f *fakeAuditReporter) decisions() []audit.ApprovalDecision {
+	var out []audit.ApprovalDecision
+	for _, e := range f.approvals {
+		out = append(out, e.Decision)
+	}
+	return out
+}
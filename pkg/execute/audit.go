@@ -0,0 +1,142 @@
+package execute
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"text/tabwriter"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/pflag"
+
+	"github.com/kubeshop/botkube/internal/audit"
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/execute/command"
+)
+
+var _ CommandExecutor = &AuditExecutor{}
+
+const (
+	defaultAuditQueryLimit = 20
+
+	auditStoreDisabledMsg = "Local audit log is not enabled. To query audit entries, enable the `settings.audit.file` configuration."
+	noAuditEntriesMsg     = "There are no matching audit entries."
+	auditNotAllowedMsg    = "You are not allowed to query audit entries outside of a channel."
+	auditUsageMsgFmt      = "Usage:\n  %s show audit [--user <name>] [--since <duration>] [--limit <number>]"
+)
+
+var auditFeatureName = FeatureName{Name: "audit"}
+
+// AuditExecutor queries locally stored audit entries.
+type AuditExecutor struct {
+	log   logrus.FieldLogger
+	cfg   config.Config
+	store audit.LocalStore
+	now   func() time.Time
+}
+
+// NewAuditExecutor returns a new AuditExecutor instance. The store can be nil if the local audit log is disabled.
+func NewAuditExecutor(log logrus.FieldLogger, cfg config.Config, store audit.LocalStore) *AuditExecutor {
+	return &AuditExecutor{
+		log:   log,
+		cfg:   cfg,
+		store: store,
+		now:   time.Now,
+	}
+}
+
+// Commands returns slice of commands the executor supports.
+func (e *AuditExecutor) Commands() map[command.Verb]CommandFn {
+	return map[command.Verb]CommandFn{
+		command.ShowVerb: e.Show,
+	}
+}
+
+// FeatureName returns the name and aliases of the feature provided by this executor.
+func (e *AuditExecutor) FeatureName() FeatureName {
+	return auditFeatureName
+}
+
+// Show returns the latest audit entries matching given filters.
+// Users who are not configured as audit viewers see only entries from the current channel.
+func (e *AuditExecutor) Show(ctx context.Context, cmdCtx CommandContext) (interactive.CoreMessage, error) {
+	if e.store == nil {
+		return respond(auditStoreDisabledMsg, cmdCtx), nil
+	}
+
+	var (
+		user  string
+		since time.Duration
+		limit int
+	)
+	f := pflag.NewFlagSet("show-audit", pflag.ContinueOnError)
+	f.StringVar(&user, "user", "", "Platform user name")
+	f.DurationVar(&since, "since", 0, "Only entries newer than a relative duration, e.g. 1h")
+	f.IntVar(&limit, "limit", defaultAuditQueryLimit, "Maximum number of the latest entries")
+	if err := f.Parse(cmdCtx.Args[2:]); err != nil {
+		return interactive.CoreMessage{}, NewExecutionCommandError("while parsing flags: %s\n\n%s", err.Error(), fmt.Sprintf(auditUsageMsgFmt, api.MessageBotNamePlaceholder))
+	}
+
+	query := audit.Query{
+		User:  user,
+		Limit: limit,
+	}
+	if since > 0 {
+		query.Since = e.now().Add(-since)
+	}
+	if !e.cfg.Settings.Audit.File.IsViewer(cmdCtx.User.ID, cmdCtx.User.Email) {
+		query.Channel = auditChannelName(cmdCtx.Conversation)
+		if query.Channel == "" {
+			return interactive.CoreMessage{}, NewExecutionCommandError(auditNotAllowedMsg)
+		}
+	}
+
+	e.log.WithFields(logrus.Fields{
+		"user":    user,
+		"since":   since,
+		"channel": query.Channel,
+	}).Debug("Querying audit entries...")
+	entries, err := e.store.Query(ctx, query)
+	if err != nil {
+		return interactive.CoreMessage{}, fmt.Errorf("while querying audit entries: %w", err)
+	}
+	if len(entries) == 0 {
+		return respond(noAuditEntriesMsg, cmdCtx), nil
+	}
+
+	buf := new(bytes.Buffer)
+	w := tabwriter.NewWriter(buf, 5, 0, 1, ' ', 0)
+	fmt.Fprintf(w, "TIME\tUSER\tCHANNEL\tSTATUS\tDURATION\tCOMMAND")
+	for _, entry := range entries {
+		fmt.Fprintf(w, "\n%s\t%s\t%s\t%s\t%s\t%s", entry.CreatedAt, entry.PlatformUser, entry.Channel, auditEntryStatus(entry), auditEntryDuration(entry), entry.Command)
+	}
+	w.Flush()
+	return respond(buf.String(), cmdCtx), nil
+}
+
+func auditEntryStatus(entry audit.Entry) string {
+	if entry.Type == audit.ApprovalEntryType {
+		if entry.DecidedBy == "" {
+			return fmt.Sprintf("approval %s", entry.ApprovalDecision)
+		}
+		return fmt.Sprintf("%s by %s", entry.ApprovalDecision, entry.DecidedBy)
+	}
+	return valueOrDash(string(entry.Status))
+}
+
+func auditEntryDuration(entry audit.Entry) string {
+	if entry.Type != audit.ExecutorEntryType {
+		return "-"
+	}
+	return (time.Duration(entry.DurationMs) * time.Millisecond).String()
+}
+
+func valueOrDash(in string) string {
+	if in == "" {
+		return "-"
+	}
+	return in
+}
@@ -0,0 +1,120 @@
+package execute
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/internal/audit"
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+func TestAuditExecutorShow(t *testing.T) {
+	// given
+	now := time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+	store := &fakeAuditStore{
+		entries: []audit.Entry{
+			{
+				Type:         audit.ExecutorEntryType,
+				CreatedAt:    "2023-01-01T11:40:00Z",
+				PlatformUser: "Joe",
+				Channel:      "ops",
+				Command:      "kubectl get pods",
+				Status:       audit.CommandSucceeded,
+				DurationMs:   1500,
+			},
+			{
+				Type:              audit.ApprovalEntryType,
+				CreatedAt:         "2023-01-01T11:50:00Z",
+				PlatformUser:      "Joe",
+				Channel:           "ops",
+				Command:           "kubectl delete pod nginx",
+				ApprovalDecision:  audit.ApprovalApproved,
+				ApprovalRequestID: "abc123",
+				DecidedBy:         "Jane",
+			},
+		},
+	}
+	executor := NewAuditExecutor(loggerx.NewNoop(), fixAuditConfig(), store)
+	executor.now = func() time.Time { return now }
+
+	cmdCtx := fixSilenceCmdCtx("show", "audit", "--user", "Joe", "--since", "1h")
+	cmdCtx.User.ID = "U1"
+
+	// when
+	msg, err := executor.Show(context.Background(), cmdCtx)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, audit.Query{User: "Joe", Since: now.Add(-time.Hour), Limit: defaultAuditQueryLimit}, store.query)
+	assert.Equal(t, heredoc.Doc(`
+		TIME                 USER CHANNEL STATUS           DURATION COMMAND
+		2023-01-01T11:40:00Z Joe  ops     succeeded        1.5s     kubectl get pods
+		2023-01-01T11:50:00Z Joe  ops     approved by Jane -        kubectl delete pod nginx`), msg.BaseBody.CodeBlock)
+}
+
+func TestAuditExecutorShowWithoutStore(t *testing.T) {
+	// given
+	executor := NewAuditExecutor(loggerx.NewNoop(), fixAuditConfig(), nil)
+
+	// when
+	msg, err := executor.Show(context.Background(), fixSilenceCmdCtx("show", "audit"))
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, auditStoreDisabledMsg, msg.BaseBody.CodeBlock)
+}
+
+func TestAuditExecutorShowLimitedToChannel(t *testing.T) {
+	// given
+	store := &fakeAuditStore{}
+	executor := NewAuditExecutor(loggerx.NewNoop(), fixAuditConfig(), store)
+
+	cmdCtx := fixSilenceCmdCtx("show", "audit")
+	cmdCtx.User.ID = "U2"
+	cmdCtx.Conversation = Conversation{ID: "C1", DisplayName: "dev"}
+
+	// when
+	msg, err := executor.Show(context.Background(), cmdCtx)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, audit.Query{Channel: "dev", Limit: defaultAuditQueryLimit}, store.query)
+	assert.Equal(t, noAuditEntriesMsg, msg.BaseBody.CodeBlock)
+
+	// when outside of a channel
+	cmdCtx.Conversation = Conversation{}
+	_, err = executor.Show(context.Background(), cmdCtx)
+
+	// then
+	require.Error(t, err)
+	assert.EqualError(t, err, auditNotAllowedMsg)
+}
+
+func fixAuditConfig() config.Config {
+	return config.Config{
+		Settings: config.Settings{
+			Audit: config.Audit{
+				File: config.AuditFile{
+					Enabled: true,
+					Viewers: []string{"U1"},
+				},
+			},
+		},
+	}
+}
+
+type fakeAuditStore struct {
+	entries []audit.Entry
+	query   audit.Query
+}
+
+func (f *fakeAuditStore) Query(_ context.Context, q audit.Query) ([]audit.Entry, error) {
+	f.query = q
+	return f.entries, nil
+}
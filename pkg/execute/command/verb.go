@@ -14,6 +14,13 @@ const (
 	EditVerb     Verb = "edit"
 	StatusVerb   Verb = "status"
 	ShowVerb     Verb = "show"
+	CreateVerb   Verb = "create"
+	DeleteVerb   Verb = "delete"
+	ApproveVerb  Verb = "approve"
+	RejectVerb   Verb = "reject"
+	StopVerb     Verb = "stop"
+	RunVerb      Verb = "run"
+	CancelVerb   Verb = "cancel"
 )
 
 func AllVerbs() []Verb {
@@ -28,5 +35,12 @@ func AllVerbs() []Verb {
 		EditVerb,
 		StatusVerb,
 		ShowVerb,
+		CreateVerb,
+		DeleteVerb,
+		ApproveVerb,
+		RejectVerb,
+		StopVerb,
+		RunVerb,
+		CancelVerb,
 	}
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"gopkg.in/yaml.v3"
 
+	"github.com/kubeshop/botkube/internal/loggerx"
 	"github.com/kubeshop/botkube/pkg/bot/interactive"
 	"github.com/kubeshop/botkube/pkg/config"
 	"github.com/kubeshop/botkube/pkg/execute/command"
@@ -62,6 +63,8 @@ func (e *ConfigExecutor) renderBotkubeConfiguration() (string, error) {
 
 	// hide sensitive info
 	// TODO: avoid printing sensitive data without need to resetting them manually (which is an error-prone approach)
+	// the maps are shared with the running configuration, so new ones are created instead of modifying them
+	comms := make(map[string]config.Communications, len(cfg.Communications))
 	for key, old := range cfg.Communications {
 		old.Slack.Token = redactedSecretStr
 		old.SocketSlack.AppToken = redactedSecretStr
@@ -70,15 +73,76 @@ func (e *ConfigExecutor) renderBotkubeConfiguration() (string, error) {
 		old.Discord.Token = redactedSecretStr
 		old.Mattermost.Token = redactedSecretStr
 		old.Teams.AppPassword = redactedSecretStr
+		old.Webhook.Headers = redactMapValues(old.Webhook.Headers)
+		old.Webhook.Auth.BearerToken = redactIfSet(old.Webhook.Auth.BearerToken)
+		old.Webhook.Auth.Basic.Password = redactIfSet(old.Webhook.Auth.Basic.Password)
+		old.Webhook.Signing.Secret = redactIfSet(old.Webhook.Signing.Secret)
 
-		// maps are not addressable: https://stackoverflow.com/questions/42605337/cannot-assign-to-struct-field-in-a-map
-		cfg.Communications[key] = old
+		comms[key] = old
 	}
+	cfg.Communications = comms
+	cfg.ChatUsers.ClaimsLookup.BearerToken = redactIfSet(cfg.ChatUsers.ClaimsLookup.BearerToken)
 
 	b, err := yaml.Marshal(cfg)
 	if err != nil {
 		return "", err
 	}
 
-	return string(b), nil
+	return redactResolvedValues(b)
+}
+
+// redactResolvedValues masks values resolved from the `valueFrom` references, such as Secrets, in a given YAML document.
+// Values are compared after decoding, so the YAML quoting and escaping don't affect the matching.
+func redactResolvedValues(in []byte) (string, error) {
+	var root yaml.Node
+	if err := yaml.Unmarshal(in, &root); err != nil {
+		return "", err
+	}
+	if !redactScalars(&root) {
+		return string(in), nil
+	}
+
+	out, err := yaml.Marshal(&root)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+func redactScalars(node *yaml.Node) bool {
+	if node.Kind == yaml.ScalarNode {
+		redacted := loggerx.Redact(node.Value)
+		if redacted == node.Value {
+			return false
+		}
+		node.Value, node.Tag, node.Style = redacted, "!!str", 0
+		return true
+	}
+
+	redacted := false
+	for _, child := range node.Content {
+		if redactScalars(child) {
+			redacted = true
+		}
+	}
+	return redacted
+}
+
+// redactIfSet redacts a given value unless it's empty, so optional settings which are not used aren't printed.
+func redactIfSet(val string) string {
+	if val == "" {
+		return ""
+	}
+	return redactedSecretStr
+}
+
+func redactMapValues(in map[string]string) map[string]string {
+	if in == nil {
+		return nil
+	}
+	out := make(map[string]string, len(in))
+	for key, val := range in {
+		out[key] = redactIfSet(val)
+	}
+	return out
 }
@@ -68,6 +68,17 @@ func TestConfigExecutorShowConfig(t *testing.T) {
 						    informersResyncPeriod: 0s
 						    kubeconfig: ""
 						    saCredentialsPathPrefix: ""
+						    sinkDelivery:
+						        queueSize: 0
+						        queueDir: ""
+						        concurrency: 0
+						        maxRetries: 0
+						        initialBackoff: 0s
+						        maxBackoff: 0s
+						        deadLetter:
+						            fileName: ""
+						            configMap: {}
+						            maxEntries: 0
 						configWatcher:
 						    enabled: false
 						    remote:
@@ -98,3 +109,71 @@ func TestConfigExecutorShowConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestConfigExecutorShowConfigRedactsSecrets(t *testing.T) {
+	// given
+	cfg := config.Config{
+		Communications: map[string]config.Communications{
+			"default-group": {
+				Elasticsearch: config.Elasticsearch{Password: "es-password"},
+				Webhook: config.Webhook{
+					Headers: map[string]string{"X-Api-Key": "header-secret"},
+					Auth: config.WebhookAuth{
+						BearerToken: "bearer-secret",
+						Basic:       config.WebhookBasicAuth{Username: "botkube", Password: "basic-secret"},
+					},
+					Signing: config.WebhookSigning{Secret: "signing-secret"},
+				},
+			},
+		},
+		ChatUsers: config.ChatUsers{
+			ClaimsLookup: config.ClaimsLookup{BearerToken: "claims-secret"},
+		},
+	}
+	cmdCtx := CommandContext{
+		Args:           []string{"config"},
+		ExecutorFilter: newExecutorTextFilter(""),
+	}
+
+	// when
+	msg, err := NewConfigExecutor(loggerx.NewNoop(), cfg).Show(context.Background(), cmdCtx)
+
+	// then
+	require.NoError(t, err)
+	out := msg.BaseBody.CodeBlock
+	for _, secret := range []string{"es-password", "header-secret", "bearer-secret", "basic-secret", "signing-secret", "claims-secret"} {
+		assert.NotContains(t, out, secret)
+	}
+	assert.Contains(t, out, "X-Api-Key: '*** REDACTED ***'")
+	assert.Contains(t, out, "username: botkube")
+	assert.Equal(t, "header-secret", cfg.Communications["default-group"].Webhook.Headers["X-Api-Key"])
+}
+
+func TestConfigExecutorShowConfigRedactsResolvedValueRefs(t *testing.T) {
+	// given
+	loggerx.AddRedactedValues("resolved-value-ref\nsecond line")
+	cfg := config.Config{
+		Executors: map[string]config.Executors{
+			"gh": {
+				Plugins: config.Plugins{
+					"botkube/gh": {
+						Enabled: true,
+						Config:  map[string]any{"token": "resolved-value-ref\nsecond line"},
+					},
+				},
+			},
+		},
+	}
+	cmdCtx := CommandContext{
+		Args:           []string{"config"},
+		ExecutorFilter: newExecutorTextFilter(""),
+	}
+
+	// when
+	msg, err := NewConfigExecutor(loggerx.NewNoop(), cfg).Show(context.Background(), cmdCtx)
+
+	// then
+	require.NoError(t, err)
+	assert.NotContains(t, msg.BaseBody.CodeBlock, "resolved-value-ref")
+	assert.Contains(t, msg.BaseBody.CodeBlock, "token: '***'")
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
 	"text/tabwriter"
 
 	"github.com/sirupsen/logrus"
@@ -27,6 +28,8 @@ var (
 // ExecExecutor executes all commands that are related to executors.
 type ExecExecutor struct {
 	log logrus.FieldLogger
+
+	mu  sync.RWMutex
 	cfg config.Config
 }
 
@@ -38,6 +41,13 @@ func NewExecExecutor(log logrus.FieldLogger, cfg config.Config) *ExecExecutor {
 	}
 }
 
+// SetAliases replaces the aliases, so the configuration changes are applied without restart.
+func (e *ExecExecutor) SetAliases(aliases config.Aliases) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.cfg.Aliases = aliases
+}
+
 // Commands returns slice of commands the executor supports
 func (e *ExecExecutor) Commands() map[command.Verb]CommandFn {
 	return map[command.Verb]CommandFn{
@@ -58,6 +68,8 @@ func (e *ExecExecutor) List(_ context.Context, cmdCtx CommandContext) (interacti
 
 // TabularOutput sorts executor groups by key and returns a printable table
 func (e *ExecExecutor) TabularOutput(bindings []string, stats *plugin.HealthStats) string {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
 	executors := executorsForBindings(e.cfg.Executors, bindings)
 
 	buf := new(bytes.Buffer)
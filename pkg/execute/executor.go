@@ -41,6 +41,8 @@ type DefaultExecutor struct {
 	log                   logrus.FieldLogger
 	analyticsReporter     AnalyticsReporter
 	pluginExecutor        *PluginExecutor
+	approvalExecutor      *ApprovalExecutor
+	rateLimiter           *RateLimiter
 	sourceBindingExecutor *SourceBindingExecutor
 	actionExecutor        *ActionExecutor
 	pingExecutor          *PingExecutor
@@ -52,6 +54,9 @@ type DefaultExecutor struct {
 	execExecutor          *ExecExecutor
 	sourceExecutor        *SourceExecutor
 	notifierHandler       NotifierHandler
+	messageStreamer       MessageStreamer
+	fanOutThread          FanOutThread
+	bot                   ConversationBot
 	message               string
 	platform              config.CommPlatformIntegration
 	conversation          Conversation
@@ -60,10 +65,61 @@ type DefaultExecutor struct {
 	cmdsMapping           *CommandMapping
 	auditReporter         audit.AuditReporter
 	pluginHealthStats     *plugin.HealthStats
+
+	// executedCmd is set once the command is recognized, so it can be reported with its result.
+	executedCmd *executedCommand
+	// fanOut is set when the command targets multiple clusters, so each Botkube instance replies in a thread.
+	fanOut bool
+	// aggregate is set when the replies to a command targeting multiple clusters are requested in a single comparison table.
+	aggregate bool
+}
+
+type executedCommand struct {
+	pluginName string
+	cmdCtx     CommandContext
+	status     audit.CommandStatus
 }
 
 // Execute executes commands and returns output
 func (e *DefaultExecutor) Execute(ctx context.Context) interactive.CoreMessage {
+	started := time.Now()
+	out := e.execute(ctx)
+	if e.fanOut && !out.IsEmpty() {
+		out = e.replyToFanOut(ctx, out)
+	}
+
+	if e.executedCmd != nil {
+		if err := e.reportAuditEvent(ctx, *e.executedCmd, time.Since(started)); err != nil {
+			e.log.Errorf("while reporting executor audit event for %q: %s", e.executedCmd.cmdCtx.CleanCmd, err.Error())
+		}
+	}
+	return out
+}
+
+// replyToFanOut returns the reply to a command targeting multiple clusters, which is posted in a thread. If the aggregation
+// is requested, the reply is collected into the comparison table posted by the elected Botkube instance, and an empty
+// message is returned.
+func (e *DefaultExecutor) replyToFanOut(ctx context.Context, out interactive.CoreMessage) interactive.CoreMessage {
+	out.ReplyInThread = true
+	if !e.aggregate {
+		return out
+	}
+	if e.fanOutThread == nil {
+		e.log.Debug("Aggregating replies is not supported on this platform. Replying in thread...")
+		return out
+	}
+
+	aggregated, err := newFanOutAggregator(e.fanOutThread, e.cfg.Settings.ClusterName, e.message).Aggregate(ctx, out)
+	if err != nil {
+		e.log.Errorf("while aggregating replies: %s", err.Error())
+	}
+	if !aggregated {
+		return out
+	}
+	return interactive.CoreMessage{}
+}
+
+func (e *DefaultExecutor) execute(ctx context.Context) interactive.CoreMessage {
 	empty := interactive.CoreMessage{}
 	rawCmd := sanitizeCommand(e.message)
 
@@ -79,6 +135,8 @@ func (e *DefaultExecutor) Execute(ctx context.Context) interactive.CoreMessage {
 		Conversation:      e.conversation,
 		Platform:          e.platform,
 		NotifierHandler:   e.notifierHandler,
+		MessageStreamer:   e.messageStreamer,
+		Bot:               e.bot,
 		Mapping:           e.cmdsMapping,
 		PluginHealthStats: e.pluginHealthStats,
 	}
@@ -101,6 +159,7 @@ func (e *DefaultExecutor) Execute(ctx context.Context) interactive.CoreMessage {
 	cmdCtx.CmdHeader = flags.CmdHeader
 	cmdCtx.Args = flags.TokenizedCmd
 	cmdCtx.ExecutorFilter = newExecutorTextFilter(flags.Filter)
+	cmdCtx.OutputConversion = outputConversion{Format: flags.OutputFormat, JQ: flags.JQ}
 
 	if len(cmdCtx.Args) == 0 {
 		if e.conversation.IsKnown {
@@ -121,6 +180,8 @@ func (e *DefaultExecutor) Execute(ctx context.Context) interactive.CoreMessage {
 		}).Debugf("Specified cluster name doesn't match ours. Ignoring further execution...")
 		return empty // user specified different target cluster
 	}
+	e.fanOut = cmdCtx.IsClusterFanOut()
+	e.aggregate = flags.Aggregate
 
 	// commands below are executed only if the channel is configured
 	if !e.conversation.IsKnown {
@@ -137,7 +198,27 @@ func (e *DefaultExecutor) Execute(ctx context.Context) interactive.CoreMessage {
 			return e.ExecuteHelp(ctx, cmdCtx)
 		}
 
+		if err := e.rateLimiter.Allow(fullPluginName, cmdCtx); err != nil {
+			var limitErr *RateLimitExceededError
+			if errors.As(err, &limitErr) {
+				e.log.WithFields(logrus.Fields{
+					"scope":      limitErr.Scope,
+					"retryAfter": limitErr.RetryAfter,
+				}).Infof("Rate limit exceeded for command %q", cmdCtx.CleanCmd)
+				e.setCommandStatus(audit.CommandRateLimited)
+				return rateLimitExceededMessage(limitErr, cmdCtx)
+			}
+		}
+
+		if policy, found := e.approvalExecutor.PolicyFor(e.conversation.ExecutorBindings, fullPluginName, cmdCtx.CleanCmd); found {
+			e.setCommandStatus(audit.CommandPendingApproval)
+			return e.approvalExecutor.Request(ctx, fullPluginName, policy, e.conversation.ExecutorBindings, cmdCtx)
+		}
+
 		out, err := e.pluginExecutor.Execute(ctx, e.conversation.ExecutorBindings, e.conversation.SlackState, cmdCtx)
+		if err != nil {
+			e.setCommandStatus(audit.CommandFailed)
+		}
 		switch {
 		case err == nil:
 		case IsExecutionCommandError(err):
@@ -164,6 +245,7 @@ func (e *DefaultExecutor) Execute(ctx context.Context) interactive.CoreMessage {
 	fn, foundRes, foundFn := e.cmdsMapping.FindFn(cmdVerb, cmdRes)
 	if !foundRes {
 		e.reportCommand(ctx, "", anonymizedInvalidVerb, false, cmdCtx)
+		e.setCommandStatus(audit.CommandFailed)
 		e.log.Infof("received unsupported command: %q", cmdCtx.CleanCmd)
 		return respond(unsupportedCmdMsg, cmdCtx)
 	}
@@ -175,6 +257,7 @@ func (e *DefaultExecutor) Execute(ctx context.Context) interactive.CoreMessage {
 			reportedCmd = fmt.Sprintf("%s {invalid feature}", reportedCmd)
 		}
 		e.reportCommand(ctx, "", reportedCmd, false, cmdCtx)
+		e.setCommandStatus(audit.CommandFailed)
 		helpMsg := e.cmdsMapping.HelpMessageForVerb(cmdVerb)
 		responseMsg := fmt.Sprintf(invalidCmdWithUsage, cmdRes, helpMsg)
 		return respond(responseMsg, cmdCtx)
@@ -187,6 +270,9 @@ func (e *DefaultExecutor) Execute(ctx context.Context) interactive.CoreMessage {
 	}
 
 	msg, err := fn(ctx, cmdCtx)
+	if err != nil {
+		e.setCommandStatus(audit.CommandFailed)
+	}
 	switch {
 	case err == nil:
 	case errors.Is(err, errInvalidCommand):
@@ -258,34 +344,50 @@ func removeMultipleSpaces(s string) string {
 	return strings.Join(strings.Fields(s), " ")
 }
 
-func (e *DefaultExecutor) reportCommand(ctx context.Context, pluginName, verb string, withFilter bool, cmdCtx CommandContext) {
+// reportCommand reports the analytics event. The audit event is reported once the command is executed.
+func (e *DefaultExecutor) reportCommand(_ context.Context, pluginName, verb string, withFilter bool, cmdCtx CommandContext) {
 	if err := e.analyticsReporter.ReportCommand(e.platform, verb, e.conversation.CommandOrigin, withFilter); err != nil {
 		e.log.Errorf("while reporting %s command: %s", verb, err.Error())
 	}
-	if err := e.reportAuditEvent(ctx, pluginName, cmdCtx); err != nil {
-		e.log.Errorf("while reporting executor audit event for %s: %s", verb, err.Error())
+	e.executedCmd = &executedCommand{
+		pluginName: pluginName,
+		cmdCtx:     cmdCtx,
+		status:     audit.CommandSucceeded,
 	}
 }
 
-func (e *DefaultExecutor) reportAuditEvent(ctx context.Context, pluginName string, cmdCtx CommandContext) error {
-	platform := remoteapi.NewBotPlatform(cmdCtx.Platform.String())
-
-	channelName := cmdCtx.Conversation.ID
-	if cmdCtx.Conversation.DisplayName != "" {
-		channelName = cmdCtx.Conversation.DisplayName
+func (e *DefaultExecutor) setCommandStatus(status audit.CommandStatus) {
+	if e.executedCmd == nil {
+		return
 	}
+	e.executedCmd.status = status
+}
+
+func (e *DefaultExecutor) reportAuditEvent(ctx context.Context, cmd executedCommand, duration time.Duration) error {
+	cmdCtx := cmd.cmdCtx
+	platform := remoteapi.NewBotPlatform(cmdCtx.Platform.String())
 
 	event := audit.ExecutorAuditEvent{
 		PlatformUser: cmdCtx.User.DisplayName,
 		CreatedAt:    time.Now().Format(time.RFC3339),
-		PluginName:   pluginName,
-		Channel:      channelName,
+		PluginName:   cmd.pluginName,
+		Channel:      auditChannelName(cmdCtx.Conversation),
 		Command:      cmdCtx.ExpandedRawCmd,
 		BotPlatform:  platform,
+		Status:       cmd.status,
+		Duration:     duration,
 	}
 	return e.auditReporter.ReportExecutorAuditEvent(ctx, event)
 }
 
+// auditChannelName returns the channel name stored in audit entries.
+func auditChannelName(conversation Conversation) string {
+	if conversation.DisplayName != "" {
+		return conversation.DisplayName
+	}
+	return conversation.ID
+}
+
 // appendByUserOnlyIfNeeded returns the "by Foo" only if the command was executed via button.
 func appendByUserOnlyIfNeeded(cmd, user string, origin command.Origin) string {
 	if user == "" || origin == command.TypedOrigin {
@@ -2,6 +2,9 @@ package execute
 
 import (
 	"context"
+	"fmt"
+	"reflect"
+	"sync"
 
 	"github.com/sirupsen/logrus"
 	"github.com/slack-go/slack"
@@ -9,6 +12,7 @@ import (
 
 	"github.com/kubeshop/botkube/internal/audit"
 	guard "github.com/kubeshop/botkube/internal/command"
+	"github.com/kubeshop/botkube/internal/config/reloader"
 	"github.com/kubeshop/botkube/internal/plugin"
 	"github.com/kubeshop/botkube/pkg/bot/interactive"
 	"github.com/kubeshop/botkube/pkg/config"
@@ -18,10 +22,11 @@ import (
 // DefaultExecutorFactory facilitates creation of the Executor instances.
 type DefaultExecutorFactory struct {
 	log                   logrus.FieldLogger
-	cfg                   config.Config
 	analyticsReporter     AnalyticsReporter
 	notifierExecutor      *NotifierExecutor
 	pluginExecutor        *PluginExecutor
+	approvalExecutor      *ApprovalExecutor
+	rateLimiter           *RateLimiter
 	sourceBindingExecutor *SourceBindingExecutor
 	actionExecutor        *ActionExecutor
 	pingExecutor          *PingExecutor
@@ -31,9 +36,14 @@ type DefaultExecutorFactory struct {
 	configExecutor        *ConfigExecutor
 	execExecutor          *ExecExecutor
 	sourceExecutor        *SourceExecutor
+	aliasExecutor         *AliasExecutor
+	jobExecutor           *JobExecutor
 	cmdsMapping           *CommandMapping
 	auditReporter         audit.AuditReporter
 	pluginHealthStats     *plugin.HealthStats
+
+	cfgMu sync.RWMutex
+	cfg   config.Config
 }
 
 // DefaultExecutorFactoryParams contains input parameters for DefaultExecutorFactory.
@@ -47,7 +57,9 @@ type DefaultExecutorFactoryParams struct {
 	RestCfg           *rest.Config
 	BotKubeVersion    string
 	AuditReporter     audit.AuditReporter
+	AuditStore        audit.LocalStore
 	PluginHealthStats *plugin.HealthStats
+	SilenceStore      SilenceStore
 }
 
 // Executor is an interface for processes to execute commands
@@ -116,6 +128,56 @@ func NewExecutorFactory(params DefaultExecutorFactoryParams) (*DefaultExecutorFa
 		params.Cfg,
 	)
 
+	silenceExecutor := NewSilenceExecutor(
+		params.Log.WithField("component", "Silence Executor"),
+		params.Cfg,
+		params.SilenceStore,
+	)
+
+	auditExecutor := NewAuditExecutor(
+		params.Log.WithField("component", "Audit Executor"),
+		params.Cfg,
+		params.AuditStore,
+	)
+
+	chatUsers, err := plugin.NewChatUserResolver(params.Cfg.ChatUsers)
+	if err != nil {
+		return nil, fmt.Errorf("while creating chat user resolver: %w", err)
+	}
+	streamExecutor := NewStreamExecutor(
+		params.Log.WithField("component", "Stream Executor"),
+		params.Cfg.Settings.Streaming,
+	)
+	outputPager := NewOutputPager(
+		params.Log.WithField("component", "Output Pager"),
+		params.Cfg.Settings.Pagination,
+		params.Cfg.Settings.LargeOutput,
+	)
+	pluginExecutor := NewPluginExecutor(
+		params.Log.WithField("component", "Botkube Plugin Executor"),
+		params.Cfg,
+		params.PluginManager,
+		params.RestCfg,
+		chatUsers,
+		streamExecutor,
+		outputPager,
+	)
+	approvalExecutor := NewApprovalExecutor(
+		params.Log.WithField("component", "Approval Executor"),
+		params.Cfg,
+		pluginExecutor,
+		params.AuditReporter,
+	)
+	rateLimiter := NewRateLimiter(params.Cfg.RateLimits)
+	jobExecutor := NewJobExecutor(
+		params.Log.WithField("component", "Job Executor"),
+		params.Cfg,
+		pluginExecutor,
+		approvalExecutor,
+		rateLimiter,
+		params.AuditReporter,
+	)
+
 	executors := []CommandExecutor{
 		actionExecutor,
 		sourceBindingExecutor,
@@ -128,22 +190,26 @@ func NewExecutorFactory(params DefaultExecutorFactoryParams) (*DefaultExecutorFa
 		execExecutor,
 		sourceExecutor,
 		aliasExecutor,
+		silenceExecutor,
+		approvalExecutor,
+		auditExecutor,
+		streamExecutor,
+		jobExecutor,
+		jobExecutor.RunExecutor(),
+		outputPager,
 	}
 	mappings, err := NewCmdsMapping(executors)
 	if err != nil {
 		return nil, err
 	}
 	return &DefaultExecutorFactory{
-		log:               params.Log,
-		cfg:               params.Cfg,
-		analyticsReporter: params.AnalyticsReporter,
-		notifierExecutor:  notifierExecutor,
-		pluginExecutor: NewPluginExecutor(
-			params.Log.WithField("component", "Botkube Plugin Executor"),
-			params.Cfg,
-			params.PluginManager,
-			params.RestCfg,
-		),
+		log:                   params.Log,
+		cfg:                   params.Cfg,
+		analyticsReporter:     params.AnalyticsReporter,
+		notifierExecutor:      notifierExecutor,
+		pluginExecutor:        pluginExecutor,
+		approvalExecutor:      approvalExecutor,
+		rateLimiter:           rateLimiter,
 		sourceBindingExecutor: sourceBindingExecutor,
 		actionExecutor:        actionExecutor,
 		pingExecutor:          pingExecutor,
@@ -153,12 +219,63 @@ func NewExecutorFactory(params DefaultExecutorFactoryParams) (*DefaultExecutorFa
 		configExecutor:        configExecutor,
 		execExecutor:          execExecutor,
 		sourceExecutor:        sourceExecutor,
+		aliasExecutor:         aliasExecutor,
+		jobExecutor:           jobExecutor,
 		cmdsMapping:           mappings,
 		auditReporter:         params.AuditReporter,
 		pluginHealthStats:     params.PluginHealthStats,
 	}, nil
 }
 
+// SetAliases replaces the aliases used by executors, so the configuration changes are applied without restart.
+func (f *DefaultExecutorFactory) SetAliases(aliases config.Aliases) {
+	f.cfgMu.Lock()
+	f.cfg.Aliases = aliases
+	f.cfgMu.Unlock()
+
+	f.aliasExecutor.SetAliases(aliases)
+	f.execExecutor.SetAliases(aliases)
+	f.jobExecutor.SetAliases(aliases)
+}
+
+// SetExecutors replaces the configuration of executor plugins, so rotated credentials are applied without restart.
+// Other changes, such as enabling a plugin, require a restart, so an error is returned for them.
+func (f *DefaultExecutorFactory) SetExecutors(executors map[string]config.Executors) error {
+	f.cfgMu.Lock()
+	defer f.cfgMu.Unlock()
+
+	if !reflect.DeepEqual(withoutPluginConfigs(f.cfg.Executors), withoutPluginConfigs(executors)) {
+		return fmt.Errorf("%w: only the plugin configuration can be changed without restart", reloader.ErrRestartRequired)
+	}
+
+	f.cfg.Executors = executors
+	f.pluginExecutor.SetExecutors(executors)
+	return nil
+}
+
+func withoutPluginConfigs(in map[string]config.Executors) map[string]config.Executors {
+	out := make(map[string]config.Executors, len(in))
+	for name, executors := range in {
+		plugins := make(config.Plugins, len(executors.Plugins))
+		for key, details := range executors.Plugins {
+			details.Config = nil
+			plugins[key] = details
+		}
+		executors.Plugins = plugins
+		out[name] = executors
+	}
+	return out
+}
+
+// SetActions replaces the actions listed by the `list actions` command.
+func (f *DefaultExecutorFactory) SetActions(actions config.Actions) {
+	f.cfgMu.Lock()
+	f.cfg.Actions = actions
+	f.cfgMu.Unlock()
+
+	f.actionExecutor.SetActions(actions)
+}
+
 // Conversation contains details about the conversation.
 type Conversation struct {
 	Alias            string
@@ -176,24 +293,39 @@ type NewDefaultInput struct {
 	CommGroupName   string
 	Platform        config.CommPlatformIntegration
 	NotifierHandler NotifierHandler
-	Conversation    Conversation
-	Message         string
-	User            UserInput
+	// MessageStreamer is optional. If it's not set, the output of long-running commands is sent once they finish.
+	MessageStreamer MessageStreamer
+	// Bot is optional. It's used to post results of asynchronous commands if MessageStreamer is not set.
+	Bot ConversationBot
+	// FanOutThread is optional. If it's not set, replies to commands targeting multiple clusters can't be aggregated.
+	FanOutThread FanOutThread
+	Conversation Conversation
+	Message      string
+	User         UserInput
 }
 
 // UserInput contains details about the user.
 type UserInput struct {
 	Mention     string
 	DisplayName string
+	// ID is the user identifier on a given communication platform.
+	ID string
+	// Email is empty if a given platform doesn't expose it, e.g. when the Slack app lacks the `users:read.email` scope.
+	Email string
 }
 
 // NewDefault creates new Default Executor.
 func (f *DefaultExecutorFactory) NewDefault(cfg NewDefaultInput) Executor {
+	f.cfgMu.RLock()
+	defer f.cfgMu.RUnlock()
+
 	return &DefaultExecutor{
 		log:                   f.log,
 		cfg:                   f.cfg,
 		analyticsReporter:     f.analyticsReporter,
 		pluginExecutor:        f.pluginExecutor,
+		approvalExecutor:      f.approvalExecutor,
+		rateLimiter:           f.rateLimiter,
 		notifierExecutor:      f.notifierExecutor,
 		sourceBindingExecutor: f.sourceBindingExecutor,
 		actionExecutor:        f.actionExecutor,
@@ -209,6 +341,9 @@ func (f *DefaultExecutorFactory) NewDefault(cfg NewDefaultInput) Executor {
 		pluginHealthStats:     f.pluginHealthStats,
 		user:                  cfg.User,
 		notifierHandler:       cfg.NotifierHandler,
+		messageStreamer:       cfg.MessageStreamer,
+		fanOutThread:          cfg.FanOutThread,
+		bot:                   cfg.Bot,
 		conversation:          cfg.Conversation,
 		message:               cfg.Message,
 		platform:              cfg.Platform,
@@ -0,0 +1,69 @@
+package execute
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/internal/config/reloader"
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+func TestDefaultExecutorFactorySetExecutors(t *testing.T) {
+	fixExecutors := func(enabled bool, token string) map[string]config.Executors {
+		return map[string]config.Executors{
+			"gh": {
+				Plugins: config.Plugins{
+					"botkube/gh": {
+						Enabled: enabled,
+						Config:  map[string]any{"token": token},
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		givenUpdate map[string]config.Executors
+		expErr      bool
+		expToken    string
+	}{
+		{
+			name:        "Plugin configuration changed",
+			givenUpdate: fixExecutors(true, "rotated"),
+			expToken:    "rotated",
+		},
+		{
+			name:        "Plugin disabled",
+			givenUpdate: fixExecutors(false, "rotated"),
+			expErr:      true,
+			expToken:    "token",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			cfg := config.Config{Executors: fixExecutors(true, "token")}
+			factory := &DefaultExecutorFactory{
+				cfg:            cfg,
+				pluginExecutor: NewPluginExecutor(loggerx.NewNoop(), cfg, nil, nil, nil, nil, nil),
+			}
+
+			// when
+			err := factory.SetExecutors(tc.givenUpdate)
+
+			// then
+			if tc.expErr {
+				require.ErrorIs(t, err, reloader.ErrRestartRequired)
+			} else {
+				require.NoError(t, err)
+			}
+			plugins, _ := factory.pluginExecutor.getEnabledPlugins([]string{"gh"}, "gh")
+			require.Len(t, plugins, 1)
+			assert.Equal(t, map[string]any{"token": tc.expToken}, plugins[0].Config)
+		})
+	}
+}
@@ -0,0 +1,506 @@
+package execute
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/kubeshop/botkube/internal/audit"
+	remoteapi "github.com/kubeshop/botkube/internal/remote"
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/execute/alias"
+	"github.com/kubeshop/botkube/pkg/execute/command"
+)
+
+var (
+	_ CommandExecutor = &JobExecutor{}
+	_ CommandExecutor = &asyncRunExecutor{}
+)
+
+const (
+	asyncRunFlag = "--async"
+
+	// jobIDLength is the length of generated job IDs. They are short, so they can be easily typed in chat.
+	jobIDLength = 8
+	// maxFinishedJobs is the number of finished jobs kept, so their results can be still shown.
+	maxFinishedJobs = 50
+
+	jobSubmittedMsgFmt      = "Job %[1]s was started. The result will be posted here once it finishes. Check its status with `%[2]s show job %[1]s`."
+	jobsLimitReachedMsgFmt  = "There are already %d running jobs. Wait until some of them finish, or cancel them with `%s cancel job <id>`."
+	jobTimeoutMsgFmt        = "Canceled after reaching the max duration of %s."
+	jobCancelingMsgFmt      = "Canceling job %q..."
+	jobNotFoundMsgFmt       = "Job %q not found."
+	jobAlreadyFinishedFmt   = "Job %q has already finished."
+	noJobsMsg               = "There are no jobs in this channel."
+	asyncNotSupportedMsg    = "Asynchronous commands are not supported on this platform."
+	asyncOnlyPluginCmdsMsg  = "Only executor plugin commands can be run asynchronously."
+	asyncApprovalCmdsMsgFmt = "Command %s requires approval, so it cannot be run asynchronously."
+	asyncRunUsageMsgFmt     = "Usage:\n  %s run --async <command>"
+	jobsUsageMsgFmt         = `Usage:
+  %[1]s list jobs
+  %[1]s show job <id>
+  %[1]s cancel job <id>`
+)
+
+var (
+	jobsFeatureName = FeatureName{
+		Name:    "job",
+		Aliases: []string{"jobs"},
+	}
+
+	asyncRunPrefixPattern = regexp.MustCompile(`(?i)^\s*run\s+--async\s*`)
+)
+
+// JobStatus describes the state of an asynchronous job.
+type JobStatus string
+
+const (
+	// JobRunning means that the command is still executed.
+	JobRunning JobStatus = "running"
+	// JobSucceeded means that the command finished successfully.
+	JobSucceeded JobStatus = "succeeded"
+	// JobFailed means that the command returned an error.
+	JobFailed JobStatus = "failed"
+	// JobCanceled means that the job was canceled by a user.
+	JobCanceled JobStatus = "canceled"
+)
+
+// ConversationBot posts messages in a given conversation.
+// It's used to post job results on platforms which don't support message streaming.
+type ConversationBot interface {
+	PostMessage(ctx context.Context, conversationID string, msg interactive.CoreMessage) error
+}
+
+// Job describes a plugin command executed in the background.
+type Job struct {
+	ID         string
+	Status     JobStatus
+	PluginName string
+	CreatedBy  string
+	CanceledBy string
+	StartedAt  time.Time
+	FinishedAt time.Time
+
+	// CmdCtx is the context of the submitted command. Its conversation and message streamer, or bot, are used to post the result.
+	CmdCtx CommandContext
+	Output interactive.CoreMessage
+
+	cancel context.CancelFunc
+}
+
+// asyncJobRunner executes a job command.
+type asyncJobRunner func(ctx context.Context, cmdCtx CommandContext) (interactive.CoreMessage, error)
+
+// JobExecutor runs plugin commands in the background and manages their jobs.
+type JobExecutor struct {
+	log              logrus.FieldLogger
+	cfg              config.Config
+	pluginExecutor   *PluginExecutor
+	approvalExecutor *ApprovalExecutor
+	rateLimiter      *RateLimiter
+	auditReporter    audit.AuditReporter
+	run              asyncJobRunner
+	now              func() time.Time
+
+	mu   sync.Mutex
+	jobs map[string]*Job
+}
+
+// NewJobExecutor returns a new JobExecutor instance.
+func NewJobExecutor(log logrus.FieldLogger, cfg config.Config, pluginExecutor *PluginExecutor, approvalExecutor *ApprovalExecutor, rateLimiter *RateLimiter, auditReporter audit.AuditReporter) *JobExecutor {
+	return &JobExecutor{
+		log:              log,
+		cfg:              cfg,
+		pluginExecutor:   pluginExecutor,
+		approvalExecutor: approvalExecutor,
+		rateLimiter:      rateLimiter,
+		auditReporter:    auditReporter,
+		run: func(ctx context.Context, cmdCtx CommandContext) (interactive.CoreMessage, error) {
+			return pluginExecutor.Execute(ctx, cmdCtx.Conversation.ExecutorBindings, nil, cmdCtx)
+		},
+		now:  time.Now,
+		jobs: map[string]*Job{},
+	}
+}
+
+// SetAliases replaces the aliases, so the configuration changes are applied without restart.
+func (e *JobExecutor) SetAliases(aliases config.Aliases) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.cfg.Aliases = aliases
+}
+
+// Commands returns slice of commands the executor supports.
+func (e *JobExecutor) Commands() map[command.Verb]CommandFn {
+	return map[command.Verb]CommandFn{
+		command.ListVerb:   e.List,
+		command.ShowVerb:   e.Show,
+		command.CancelVerb: e.Cancel,
+	}
+}
+
+// FeatureName returns the name and aliases of the feature provided by this executor.
+func (e *JobExecutor) FeatureName() FeatureName {
+	return jobsFeatureName
+}
+
+// RunExecutor returns the executor of the `run --async` command, which submits new jobs.
+func (e *JobExecutor) RunExecutor() CommandExecutor {
+	return &asyncRunExecutor{jobs: e}
+}
+
+// Submit starts a given `run --async` command in the background and returns the job ID immediately.
+// The result is posted in the originating conversation once the command finishes.
+func (e *JobExecutor) Submit(_ context.Context, cmdCtx CommandContext) (interactive.CoreMessage, error) {
+	if cmdCtx.MessageStreamer == nil && cmdCtx.Bot == nil {
+		return interactive.CoreMessage{}, NewExecutionCommandError(asyncNotSupportedMsg)
+	}
+
+	jobCmdCtx, err := e.jobCmdCtx(cmdCtx)
+	if err != nil {
+		return interactive.CoreMessage{}, err
+	}
+
+	bindings := cmdCtx.Conversation.ExecutorBindings
+	if !e.pluginExecutor.CanHandle(bindings, jobCmdCtx.Args) || isHelpCmd(jobCmdCtx.Args) {
+		return interactive.CoreMessage{}, NewExecutionCommandError(asyncOnlyPluginCmdsMsg)
+	}
+
+	_, fullPluginName := e.pluginExecutor.getEnabledPlugins(bindings, jobCmdCtx.Args[0])
+	if err := e.rateLimiter.Allow(fullPluginName, jobCmdCtx); err != nil {
+		var limitErr *RateLimitExceededError
+		if errors.As(err, &limitErr) {
+			return rateLimitExceededMessage(limitErr, jobCmdCtx), nil
+		}
+	}
+	if _, found := e.approvalExecutor.PolicyFor(bindings, fullPluginName, jobCmdCtx.CleanCmd); found {
+		return interactive.CoreMessage{}, NewExecutionCommandError(asyncApprovalCmdsMsgFmt, quotedCmd(jobCmdCtx))
+	}
+
+	job, err := e.start(jobCmdCtx, fullPluginName)
+	if err != nil {
+		return interactive.CoreMessage{}, err
+	}
+	return respond(fmt.Sprintf(jobSubmittedMsgFmt, job.ID, api.MessageBotNamePlaceholder), cmdCtx), nil
+}
+
+// jobCmdCtx returns the context of the command wrapped by `run --async`.
+func (e *JobExecutor) jobCmdCtx(cmdCtx CommandContext) (CommandContext, error) {
+	rawCmd := asyncRunPrefixPattern.ReplaceAllString(cmdCtx.ExpandedRawCmd, "")
+	e.mu.Lock()
+	aliases := e.cfg.Aliases
+	e.mu.Unlock()
+	expandedRawCmd := alias.ExpandPrefix(rawCmd, aliases)
+	flags, err := ParseFlags(expandedRawCmd)
+	if err != nil {
+		return CommandContext{}, NewExecutionCommandError(err.Error())
+	}
+	if len(flags.TokenizedCmd) == 0 {
+		return CommandContext{}, NewExecutionCommandError(asyncRunUsageMsgFmt, api.MessageBotNamePlaceholder)
+	}
+
+	jobCmdCtx := cmdCtx
+	jobCmdCtx.ExpandedRawCmd = expandedRawCmd
+	jobCmdCtx.CleanCmd = flags.CleanCmd
+	jobCmdCtx.Args = flags.TokenizedCmd
+	jobCmdCtx.ExecutorFilter = newExecutorTextFilter(flags.Filter)
+	jobCmdCtx.OutputConversion = outputConversion{Format: flags.OutputFormat, JQ: flags.JQ}
+	if flags.CmdHeader != "" {
+		jobCmdCtx.CmdHeader = flags.CmdHeader
+	}
+	return jobCmdCtx, nil
+}
+
+// start runs a given command in the background. It returns an error if the max number of running jobs is reached.
+func (e *JobExecutor) start(cmdCtx CommandContext, pluginName string) (*Job, error) {
+	e.mu.Lock()
+	maxRunning := e.cfg.Settings.Jobs.GetMaxRunning()
+	if e.runningCount() >= maxRunning {
+		e.mu.Unlock()
+		return nil, NewExecutionCommandError(jobsLimitReachedMsgFmt, maxRunning, api.MessageBotNamePlaceholder)
+	}
+
+	// jobs are not bound to the request context, as they outlive it
+	ctx, cancel := context.WithTimeout(context.Background(), e.cfg.Settings.Jobs.GetMaxDuration())
+	job := &Job{
+		ID:         uuid.New().String()[:jobIDLength],
+		Status:     JobRunning,
+		PluginName: pluginName,
+		CreatedBy:  userName(cmdCtx.User),
+		StartedAt:  e.now(),
+		CmdCtx:     cmdCtx,
+		cancel:     cancel,
+	}
+	e.removeOldest()
+	e.jobs[job.ID] = job
+	e.mu.Unlock()
+
+	log := e.log.WithFields(logrus.Fields{
+		"id":      job.ID,
+		"command": cmdCtx.CleanCmd,
+	})
+	log.Info("Starting job...")
+
+	// the result is posted once, so the output isn't streamed
+	runCmdCtx := cmdCtx
+	runCmdCtx.MessageStreamer = nil
+
+	go func() {
+		defer cancel()
+		out, err := e.run(ctx, runCmdCtx)
+		timedOut := errors.Is(ctx.Err(), context.DeadlineExceeded)
+		msg := e.finish(job, out, err, timedOut)
+		log.WithField("status", msg.status).Info("Job finished")
+
+		if err := e.reportAuditEvent(context.Background(), msg); err != nil {
+			log.Errorf("while reporting job audit event: %s", err.Error())
+		}
+		if err := postJobResult(context.Background(), cmdCtx, msg.CoreMessage); err != nil {
+			log.Errorf("while posting job result: %s", err.Error())
+		}
+	}()
+
+	return job, nil
+}
+
+// postJobResult posts the job result in the originating conversation. The conversation's bot is used if the platform
+// doesn't support message streaming.
+func postJobResult(ctx context.Context, cmdCtx CommandContext, msg interactive.CoreMessage) error {
+	if cmdCtx.MessageStreamer == nil {
+		return cmdCtx.Bot.PostMessage(ctx, cmdCtx.Conversation.ID, msg)
+	}
+	_, err := cmdCtx.MessageStreamer.PostStreamMessage(ctx, msg)
+	return err
+}
+
+// reportAuditEvent reports the final status and duration of a finished job.
+func (e *JobExecutor) reportAuditEvent(ctx context.Context, result jobResult) error {
+	if e.auditReporter == nil {
+		return nil
+	}
+
+	status := audit.CommandFailed
+	if result.status == JobSucceeded {
+		status = audit.CommandSucceeded
+	}
+	cmdCtx := result.cmdCtx
+	return e.auditReporter.ReportExecutorAuditEvent(ctx, audit.ExecutorAuditEvent{
+		PlatformUser: cmdCtx.User.DisplayName,
+		CreatedAt:    result.finishedAt.Format(time.RFC3339),
+		PluginName:   result.pluginName,
+		Channel:      auditChannelName(cmdCtx.Conversation),
+		Command:      cmdCtx.ExpandedRawCmd,
+		BotPlatform:  remoteapi.NewBotPlatform(cmdCtx.Platform.String()),
+		Status:       status,
+		Duration:     result.duration,
+	})
+}
+
+type jobResult struct {
+	interactive.CoreMessage
+	status     JobStatus
+	pluginName string
+	cmdCtx     CommandContext
+	finishedAt time.Time
+	duration   time.Duration
+}
+
+// finish stores the job result and returns the message posted in the originating conversation.
+func (e *JobExecutor) finish(job *Job, out interactive.CoreMessage, err error, timedOut bool) jobResult {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	job.FinishedAt = e.now()
+	switch {
+	case job.CanceledBy != "":
+		job.Status = JobCanceled
+		out = respond(fmt.Sprintf("Canceled by %s.", job.CanceledBy), job.CmdCtx)
+	case timedOut:
+		job.Status = JobCanceled
+		out = respond(fmt.Sprintf(jobTimeoutMsgFmt, e.cfg.Settings.Jobs.GetMaxDuration()), job.CmdCtx)
+	case err != nil:
+		job.Status = JobFailed
+		msg := err.Error()
+		if !IsExecutionCommandError(err) {
+			e.log.WithField("id", job.ID).Errorf("while executing job: %s", msg)
+			msg = fmt.Sprintf(internalErrorMsgFmt, job.CmdCtx.ClusterName)
+		}
+		out = respond(msg, job.CmdCtx)
+	default:
+		job.Status = JobSucceeded
+	}
+
+	out.Description = fmt.Sprintf("Job %s %s: %s", job.ID, job.Status, header(job.CmdCtx))
+	job.Output = out
+	return jobResult{
+		CoreMessage: out,
+		status:      job.Status,
+		pluginName:  job.PluginName,
+		cmdCtx:      job.CmdCtx,
+		finishedAt:  job.FinishedAt,
+		duration:    job.FinishedAt.Sub(job.StartedAt),
+	}
+}
+
+// List returns jobs submitted in the current conversation.
+func (e *JobExecutor) List(_ context.Context, cmdCtx CommandContext) (interactive.CoreMessage, error) {
+	jobs := e.conversationJobs(cmdCtx.Conversation.ID)
+	if len(jobs) == 0 {
+		return respond(noJobsMsg, cmdCtx), nil
+	}
+
+	buf := new(bytes.Buffer)
+	w := tabwriter.NewWriter(buf, 5, 0, 1, ' ', 0)
+	fmt.Fprintf(w, "ID\tSTATUS\tCREATED BY\tSTARTED\tCOMMAND")
+	for _, job := range jobs {
+		fmt.Fprintf(w, "\n%s\t%s\t%s\t%s\t%s", job.ID, job.Status, job.CreatedBy, job.StartedAt.Format(time.RFC1123), job.CmdCtx.CleanCmd)
+	}
+	w.Flush()
+	return respond(buf.String(), cmdCtx), nil
+}
+
+// Show returns the job status, or its result if the job has already finished.
+func (e *JobExecutor) Show(_ context.Context, cmdCtx CommandContext) (interactive.CoreMessage, error) {
+	job, err := e.get(cmdCtx)
+	if err != nil {
+		return interactive.CoreMessage{}, err
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if job.Status != JobRunning {
+		return job.Output, nil
+	}
+
+	running := fmt.Sprintf("Job %s started by %s is running for %s.", job.ID, job.CreatedBy, e.now().Sub(job.StartedAt).Round(time.Second))
+	btnBuilder := api.NewMessageButtonBuilder()
+	return interactive.CoreMessage{
+		Description: header(job.CmdCtx),
+		Message: api.Message{
+			Sections: []api.Section{
+				{
+					Base: api.Base{
+						Description: running,
+					},
+					Buttons: []api.Button{
+						btnBuilder.ForCommandWithoutDesc("Cancel", fmt.Sprintf("%s %s %s", command.CancelVerb, jobsFeatureName.Name, job.ID), api.ButtonStyleDanger),
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+// Cancel cancels a running job.
+func (e *JobExecutor) Cancel(_ context.Context, cmdCtx CommandContext) (interactive.CoreMessage, error) {
+	job, err := e.get(cmdCtx)
+	if err != nil {
+		return interactive.CoreMessage{}, err
+	}
+
+	e.mu.Lock()
+	if job.Status != JobRunning || job.CanceledBy != "" {
+		e.mu.Unlock()
+		return interactive.CoreMessage{}, NewExecutionCommandError(jobAlreadyFinishedFmt, job.ID)
+	}
+	job.CanceledBy = userName(cmdCtx.User)
+	e.mu.Unlock()
+
+	e.log.WithFields(logrus.Fields{
+		"id":         job.ID,
+		"canceledBy": cmdCtx.User.DisplayName,
+	}).Info("Canceling job on user request...")
+	job.cancel()
+	return respond(fmt.Sprintf(jobCancelingMsgFmt, job.ID), cmdCtx), nil
+}
+
+// get returns a job with a given ID. Only jobs submitted in the same conversation are returned.
+func (e *JobExecutor) get(cmdCtx CommandContext) (*Job, error) {
+	if len(cmdCtx.Args) < 3 {
+		return nil, NewExecutionCommandError("Job ID is required.\n\n%s", fmt.Sprintf(jobsUsageMsgFmt, api.MessageBotNamePlaceholder))
+	}
+	id := cmdCtx.Args[2]
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	job, found := e.jobs[id]
+	if !found || job.CmdCtx.Conversation.ID != cmdCtx.Conversation.ID {
+		return nil, NewExecutionCommandError(jobNotFoundMsgFmt, id)
+	}
+	return job, nil
+}
+
+func (e *JobExecutor) conversationJobs(conversationID string) []Job {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	var jobs []Job
+	for _, job := range e.jobs {
+		if job.CmdCtx.Conversation.ID == conversationID {
+			jobs = append(jobs, *job)
+		}
+	}
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].StartedAt.Before(jobs[j].StartedAt)
+	})
+	return jobs
+}
+
+// runningCount returns the number of running jobs. It must be called with the lock held.
+func (e *JobExecutor) runningCount() int {
+	count := 0
+	for _, job := range e.jobs {
+		if job.Status == JobRunning {
+			count++
+		}
+	}
+	return count
+}
+
+// removeOldest removes the oldest finished jobs above the limit. It must be called with the lock held.
+func (e *JobExecutor) removeOldest() {
+	var finished []*Job
+	for _, job := range e.jobs {
+		if job.Status != JobRunning {
+			finished = append(finished, job)
+		}
+	}
+	if len(finished) < maxFinishedJobs {
+		return
+	}
+
+	sort.Slice(finished, func(i, j int) bool {
+		return finished[i].FinishedAt.Before(finished[j].FinishedAt)
+	})
+	for _, job := range finished[:len(finished)-maxFinishedJobs+1] {
+		delete(e.jobs, job.ID)
+	}
+}
+
+// asyncRunExecutor handles the `run --async` command. It's a separate executor, as the command has a different verb.
+type asyncRunExecutor struct {
+	jobs *JobExecutor
+}
+
+// Commands returns slice of commands the executor supports.
+func (e *asyncRunExecutor) Commands() map[command.Verb]CommandFn {
+	return map[command.Verb]CommandFn{
+		command.RunVerb: e.jobs.Submit,
+	}
+}
+
+// FeatureName returns the name and aliases of the feature provided by this executor.
+func (e *asyncRunExecutor) FeatureName() FeatureName {
+	return FeatureName{Name: asyncRunFlag}
+}
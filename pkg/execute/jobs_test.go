@@ -0,0 +1,287 @@
+package execute
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/internal/audit"
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+func TestJobExecutorSubmit(t *testing.T) {
+	// given
+	streamer := &fakeMessageStreamer{notify: make(chan struct{}, 1)}
+	jobs := fixJobExecutor(func(_ context.Context, cmdCtx CommandContext) (interactive.CoreMessage, error) {
+		assert.Equal(t, "kubectl get pods -A", cmdCtx.CleanCmd)
+		assert.Equal(t, []string{"kubectl", "get", "pods", "-A"}, cmdCtx.Args)
+		assert.Nil(t, cmdCtx.MessageStreamer)
+		return interactive.CoreMessage{
+			Message: api.NewCodeBlockMessage("NAME   READY\nnginx  1/1", true),
+		}, nil
+	})
+	cmdCtx := fixJobCmdCtx(streamer, "run", "--async", "k", "get", "pods", "-A")
+
+	// when
+	msg, err := jobs.Submit(context.Background(), cmdCtx)
+
+	// then
+	require.NoError(t, err)
+	id := onlyJobID(t, jobs)
+	assert.Contains(t, msg.Message.BaseBody.CodeBlock, "Job "+id+" was started.")
+
+	<-streamer.notify
+	posted := streamer.posted()
+	require.Len(t, posted, 1)
+	assert.Equal(t, "Job "+id+" succeeded: `kubectl get pods -A` on `cluster-name` by @Joe", posted[0].Description)
+	assert.Equal(t, "NAME   READY\nnginx  1/1", posted[0].Message.BaseBody.CodeBlock)
+
+	executions := jobs.auditReporter.(*fakeAuditReporter).executions
+	require.Len(t, executions, 1)
+	assert.Equal(t, "botkube/kubectl", executions[0].PluginName)
+	assert.Equal(t, "kubectl get pods -A", executions[0].Command)
+	assert.Equal(t, audit.CommandSucceeded, executions[0].Status)
+
+	// when
+	showMsg, err := jobs.Show(context.Background(), fixJobCmdCtx(nil, "show", "job", id))
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, posted[0], showMsg)
+}
+
+func TestJobExecutorSubmitWithoutMessageStreamer(t *testing.T) {
+	// given
+	bot := &fakeConversationBot{notify: make(chan struct{}, 1)}
+	jobs := fixJobExecutor(func(context.Context, CommandContext) (interactive.CoreMessage, error) {
+		return interactive.CoreMessage{}, NewExecutionCommandError("pods is forbidden")
+	})
+	cmdCtx := fixJobCmdCtx(nil, "run", "--async", "kubectl", "get", "pods")
+	cmdCtx.Conversation.ID = "alerts"
+	cmdCtx.Bot = bot
+
+	// when
+	_, err := jobs.Submit(context.Background(), cmdCtx)
+
+	// then
+	require.NoError(t, err)
+	<-bot.notify
+	require.Len(t, bot.posts, 1)
+	assert.Equal(t, "alerts", bot.conversationIDs[0])
+	assert.Equal(t, "pods is forbidden", bot.posts[0].Message.BaseBody.CodeBlock)
+
+	executions := jobs.auditReporter.(*fakeAuditReporter).executions
+	require.Len(t, executions, 1)
+	assert.Equal(t, audit.CommandFailed, executions[0].Status)
+}
+
+func TestJobExecutorSubmitErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		streamer MessageStreamer
+		args     []string
+		expErr   string
+	}{
+		{
+			name:     "platform without message streamer and bot",
+			streamer: nil,
+			args:     []string{"run", "--async", "kubectl", "get", "pods"},
+			expErr:   "Asynchronous commands are not supported on this platform.",
+		},
+		{
+			name:     "built-in command",
+			streamer: &fakeMessageStreamer{},
+			args:     []string{"run", "--async", "list", "executors"},
+			expErr:   "Only executor plugin commands can be run asynchronously.",
+		},
+		{
+			name:     "missing command",
+			streamer: &fakeMessageStreamer{},
+			args:     []string{"run", "--async"},
+			expErr:   "Usage:\n  {{BotName}} run --async <command>",
+		},
+		{
+			name:     "command which requires approval",
+			streamer: &fakeMessageStreamer{},
+			args:     []string{"run", "--async", "kubectl", "delete", "pod", "nginx"},
+			expErr:   "Command `kubectl delete pod nginx` requires approval, so it cannot be run asynchronously.",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			jobs := fixJobExecutor(nil)
+
+			// when
+			_, err := jobs.Submit(context.Background(), fixJobCmdCtx(tc.streamer, tc.args...))
+
+			// then
+			require.Error(t, err)
+			assert.True(t, IsExecutionCommandError(err))
+			assert.EqualError(t, err, tc.expErr)
+			assert.Empty(t, jobs.jobs)
+		})
+	}
+}
+
+func TestJobExecutorCancel(t *testing.T) {
+	// given
+	streamer := &fakeMessageStreamer{notify: make(chan struct{}, 1)}
+	jobs := fixJobExecutor(func(ctx context.Context, _ CommandContext) (interactive.CoreMessage, error) {
+		<-ctx.Done()
+		return interactive.CoreMessage{}, NewExecutionCommandError(ctx.Err().Error())
+	})
+	_, err := jobs.Submit(context.Background(), fixJobCmdCtx(streamer, "run", "--async", "kubectl", "logs", "-f", "nginx"))
+	require.NoError(t, err)
+	id := onlyJobID(t, jobs)
+
+	// when listed
+	listMsg, err := jobs.List(context.Background(), fixJobCmdCtx(nil, "list", "jobs"))
+
+	// then
+	require.NoError(t, err)
+	assert.Contains(t, listMsg.Message.BaseBody.CodeBlock, id+" running @Joe")
+
+	// when canceled from another conversation
+	otherCmdCtx := fixJobCmdCtx(nil, "cancel", "job", id)
+	otherCmdCtx.Conversation.ID = "other"
+	_, err = jobs.Cancel(context.Background(), otherCmdCtx)
+
+	// then
+	require.Error(t, err)
+	assert.EqualError(t, err, `Job "`+id+`" not found.`)
+
+	// when
+	cancelCmdCtx := fixJobCmdCtx(nil, "cancel", "job", id)
+	cancelCmdCtx.User = UserInput{Mention: "@Jane"}
+	_, err = jobs.Cancel(context.Background(), cancelCmdCtx)
+
+	// then
+	require.NoError(t, err)
+	<-streamer.notify
+	posted := streamer.posted()
+	require.Len(t, posted, 1)
+	assert.Equal(t, "Job "+id+" canceled: `kubectl logs -f nginx` on `cluster-name` by @Joe", posted[0].Description)
+	assert.Equal(t, "Canceled by @Jane.", posted[0].Message.BaseBody.CodeBlock)
+
+	// when canceled again
+	_, err = jobs.Cancel(context.Background(), cancelCmdCtx)
+
+	// then
+	require.Error(t, err)
+	assert.EqualError(t, err, `Job "`+id+`" has already finished.`)
+}
+
+func TestJobExecutorMaxDuration(t *testing.T) {
+	// given
+	streamer := &fakeMessageStreamer{notify: make(chan struct{}, 1)}
+	jobs := fixJobExecutor(func(ctx context.Context, _ CommandContext) (interactive.CoreMessage, error) {
+		<-ctx.Done()
+		return interactive.CoreMessage{}, NewExecutionCommandError(ctx.Err().Error())
+	})
+	jobs.cfg.Settings.Jobs.MaxDuration = 10 * time.Millisecond
+
+	// when
+	_, err := jobs.Submit(context.Background(), fixJobCmdCtx(streamer, "run", "--async", "kubectl", "logs", "-f", "nginx"))
+
+	// then
+	require.NoError(t, err)
+	<-streamer.notify
+	posted := streamer.posted()
+	require.Len(t, posted, 1)
+	assert.Equal(t, "Canceled after reaching the max duration of 10ms.", posted[0].Message.BaseBody.CodeBlock)
+	assert.Equal(t, JobCanceled, jobs.jobs[onlyJobID(t, jobs)].Status)
+}
+
+func TestJobExecutorMaxRunning(t *testing.T) {
+	// given
+	release := make(chan struct{})
+	streamer := &fakeMessageStreamer{notify: make(chan struct{}, 2)}
+	jobs := fixJobExecutor(func(context.Context, CommandContext) (interactive.CoreMessage, error) {
+		<-release
+		return interactive.CoreMessage{Message: api.NewCodeBlockMessage("done", false)}, nil
+	})
+	jobs.cfg.Settings.Jobs.MaxRunning = 1
+	_, err := jobs.Submit(context.Background(), fixJobCmdCtx(streamer, "run", "--async", "kubectl", "logs", "-f", "nginx"))
+	require.NoError(t, err)
+
+	// when
+	_, err = jobs.Submit(context.Background(), fixJobCmdCtx(streamer, "run", "--async", "kubectl", "get", "pods"))
+
+	// then
+	require.Error(t, err)
+	assert.EqualError(t, err, "There are already 1 running jobs. Wait until some of them finish, or cancel them with `{{BotName}} cancel job <id>`.")
+
+	// when the running job finishes
+	close(release)
+	<-streamer.notify
+	_, err = jobs.Submit(context.Background(), fixJobCmdCtx(streamer, "run", "--async", "kubectl", "get", "pods"))
+
+	// then
+	require.NoError(t, err)
+	<-streamer.notify
+}
+
+func fixJobExecutor(run asyncJobRunner) *JobExecutor {
+	cfg := config.Config{
+		Aliases: config.Aliases{
+			"k": {Command: "kubectl"},
+		},
+		Executors: map[string]config.Executors{
+			"k8s": {
+				Approval: config.ApprovalPolicy{
+					CommandPrefixes: []string{"kubectl delete"},
+					Approvers:       []string{"U1"},
+				},
+				Plugins: config.Plugins{
+					"botkube/kubectl": {Enabled: true},
+				},
+			},
+		},
+	}
+	log := loggerx.NewNoop()
+	pluginExecutor := NewPluginExecutor(log, cfg, nil, nil, nil, nil, nil)
+	jobs := NewJobExecutor(log, cfg, pluginExecutor, NewApprovalExecutor(log, cfg, pluginExecutor, &fakeAuditReporter{}), nil, &fakeAuditReporter{})
+	if run != nil {
+		jobs.run = run
+	}
+	return jobs
+}
+
+func fixJobCmdCtx(streamer MessageStreamer, args ...string) CommandContext {
+	cmdCtx := fixStreamCmdCtx(streamer, args...)
+	cmdCtx.User = UserInput{ID: "U0", Mention: "@Joe"}
+	cmdCtx.Conversation.ExecutorBindings = []string{"k8s"}
+	return cmdCtx
+}
+
+func onlyJobID(t *testing.T, jobs *JobExecutor) string {
+	t.Helper()
+	jobs.mu.Lock()
+	defer jobs.mu.Unlock()
+	require.Len(t, jobs.jobs, 1)
+	for id := range jobs.jobs {
+		return id
+	}
+	return ""
+}
+
+type fakeConversationBot struct {
+	notify chan struct{}
+
+	conversationIDs []string
+	posts           []interactive.CoreMessage
+}
+
+func (f *fakeConversationBot) PostMessage(_ context.Context, conversationID string, msg interactive.CoreMessage) error {
+	f.conversationIDs = append(f.conversationIDs, conversationID)
+	f.posts = append(f.posts, msg)
+	f.notify <- struct{}{}
+	return nil
+}
@@ -0,0 +1,53 @@
+package execute
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+const (
+	largeOutputFileName      = "output.txt"
+	largeOutputContentType   = "text/plain"
+	largeOutputPreviewMsgFmt = "Only a preview of the output is shown. The full output is attached as %s."
+)
+
+// attachLargeOutput moves the message body above the configured size to a file attachment, so it's not truncated
+// by communication platforms. Only a short preview of the output is left inline.
+func attachLargeOutput(msg api.Message, cfg config.LargeOutput) api.Message {
+	body := &msg.BaseBody.CodeBlock
+	if *body == "" {
+		body = &msg.BaseBody.Plaintext
+	}
+	maxSize := cfg.GetMaxInlineSize()
+	if len(*body) <= maxSize {
+		return msg
+	}
+
+	msg.Attachments = append(msg.Attachments, api.Attachment{
+		Name:        largeOutputFileName,
+		ContentType: largeOutputContentType,
+		Data:        []byte(*body),
+	})
+
+	lines := strings.Split(strings.TrimSuffix(*body, "\n"), "\n")
+	previewLines := lines
+	if len(previewLines) > cfg.GetPreviewLines() {
+		previewLines = previewLines[:cfg.GetPreviewLines()]
+	}
+	preview := strings.Join(previewLines, "\n")
+	if len(preview) > maxSize {
+		// long lines are cut, so the preview still fits the platform limits
+		preview = strings.ToValidUTF8(preview[:maxSize], "") + "..."
+	}
+	*body = preview
+
+	msg.Sections = append(msg.Sections, api.Section{
+		Context: api.ContextItems{
+			{Text: fmt.Sprintf(largeOutputPreviewMsgFmt, largeOutputFileName)},
+		},
+	})
+	return msg
+}
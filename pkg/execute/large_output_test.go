@@ -0,0 +1,77 @@
+package execute
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+func TestAttachLargeOutput(t *testing.T) {
+	var lines []string
+	for i := 1; i <= 100; i++ {
+		lines = append(lines, fmt.Sprintf("pod-%d  Running", i))
+	}
+	largeOutput := strings.Join(lines, "\n") + "\n"
+
+	tests := []struct {
+		name string
+		cfg  config.LargeOutput
+		in   api.Message
+
+		expBody        api.Body
+		expAttachments []api.Attachment
+		expContext     string
+	}{
+		{
+			name:    "small output",
+			in:      api.NewCodeBlockMessage("pod-1  Running", false),
+			expBody: api.Body{CodeBlock: "pod-1  Running"},
+		},
+		{
+			name: "large code block",
+			cfg:  config.LargeOutput{MaxInlineSize: 100, PreviewLines: 2},
+			in:   api.NewCodeBlockMessage(largeOutput, false),
+			expBody: api.Body{
+				CodeBlock: "pod-1  Running\npod-2  Running",
+			},
+			expAttachments: []api.Attachment{
+				{Name: "output.txt", ContentType: "text/plain", Data: []byte(largeOutput)},
+			},
+			expContext: "Only a preview of the output is shown. The full output is attached as output.txt.",
+		},
+		{
+			name: "large plaintext with a long line",
+			cfg:  config.LargeOutput{MaxInlineSize: 10},
+			in:   api.NewPlaintextMessage(strings.Repeat("ą", 10), false),
+			expBody: api.Body{
+				Plaintext: "ąąąąą...",
+			},
+			expAttachments: []api.Attachment{
+				{Name: "output.txt", ContentType: "text/plain", Data: []byte(strings.Repeat("ą", 10))},
+			},
+			expContext: "Only a preview of the output is shown. The full output is attached as output.txt.",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			out := attachLargeOutput(tc.in, tc.cfg)
+
+			// then
+			assert.Equal(t, tc.expBody, out.BaseBody)
+			assert.Equal(t, tc.expAttachments, out.Attachments)
+			if tc.expContext == "" {
+				assert.Empty(t, out.Sections)
+				return
+			}
+			require.Len(t, out.Sections, 1)
+			assert.Equal(t, tc.expContext, out.Sections[0].Context[0].Text)
+		})
+	}
+}
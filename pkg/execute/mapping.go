@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"path"
+	"strings"
 	"text/tabwriter"
 
 	"github.com/kubeshop/botkube/internal/plugin"
@@ -52,16 +54,49 @@ type CommandContext struct {
 	Conversation        Conversation
 	Platform            config.CommPlatformIntegration
 	ExecutorFilter      executorFilter
+	OutputConversion    outputConversion
 	NotifierHandler     NotifierHandler
+	MessageStreamer     MessageStreamer
+	Bot                 ConversationBot
 	Mapping             *CommandMapping
 	CmdHeader           string
 	PluginHealthStats   *plugin.HealthStats
 }
 
 // ProvidedClusterNameEqualOrEmpty returns true when provided cluster name is empty
-// or when provided cluster name is equal to cluster name
+// or when provided cluster name matches cluster name. The provided cluster name can be
+// a comma-separated list of names or glob patterns, e.g. "prod-*,stage".
 func (cmdCtx CommandContext) ProvidedClusterNameEqualOrEmpty() bool {
-	return cmdCtx.ProvidedClusterName == "" || cmdCtx.ProvidedClusterName == cmdCtx.ClusterName
+	if cmdCtx.ProvidedClusterName == "" {
+		return true
+	}
+	for _, pattern := range providedClusterNames(cmdCtx.ProvidedClusterName) {
+		if pattern == cmdCtx.ClusterName {
+			return true
+		}
+		if matched, err := path.Match(pattern, cmdCtx.ClusterName); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
+
+// IsClusterFanOut returns true when the provided cluster name can match multiple clusters,
+// so the command is answered by all matching Botkube instances.
+func (cmdCtx CommandContext) IsClusterFanOut() bool {
+	names := providedClusterNames(cmdCtx.ProvidedClusterName)
+	return len(names) > 1 || (len(names) == 1 && strings.ContainsAny(names[0], "*?["))
+}
+
+func providedClusterNames(in string) []string {
+	var out []string
+	for _, name := range strings.Split(in, ",") {
+		name = strings.TrimSpace(name)
+		if name != "" {
+			out = append(out, name)
+		}
+	}
+	return out
 }
 
 // FeatureName defines the name and aliases for a feature
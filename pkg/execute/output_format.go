@@ -0,0 +1,365 @@
+package execute
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/itchyny/gojq"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kubeshop/botkube/pkg/api"
+)
+
+const (
+	tableOutputFormat         = "table"
+	jsonOutputFormat          = "json"
+	yamlOutputFormat          = "yaml"
+	csvOutputFormat           = "csv"
+	markdownTableOutputFormat = "markdown-table"
+
+	// scalarColumnName is the column name used when tabular output is rendered for values other than objects.
+	scalarColumnName = "value"
+
+	// jqTimeout limits the time of evaluating a jq expression, so expressions such as `repeat(.)` don't block the executor.
+	jqTimeout = time.Second
+	// jqMaxResults limits the number of results produced by a jq expression.
+	jqMaxResults = 10000
+	// jqMaxOutputSize limits the size of JSON-encoded results produced by a jq expression.
+	jqMaxOutputSize = 1 << 20
+
+	invalidJQExprMsgFmt     = "Invalid --jq expression: %s"
+	jqTimeoutMsgFmt         = "The --jq expression didn't finish within %s."
+	jqTooManyResultsMsgFmt  = "The --jq expression produced more than %d results."
+	jqOutputTooLargeMsgFmt  = "The --jq expression produced more than %d bytes of output."
+	outputConversionMsgFmt  = "Cannot convert the command output: %s"
+	unsupportedOutputFmtMsg = "unsupported output format %q. Supported formats: %s"
+)
+
+var (
+	supportedOutputFormats = []string{tableOutputFormat, jsonOutputFormat, yamlOutputFormat, csvOutputFormat, markdownTableOutputFormat}
+
+	// textTableColumnSeparator matches the column padding used by tabular CLI outputs, such as `kubectl get`.
+	textTableColumnSeparator = regexp.MustCompile(`\s{2,}`)
+)
+
+// outputConversion post-processes executor output with a jq expression and renders it in a given format.
+type outputConversion struct {
+	Format string
+	JQ     string
+}
+
+// IsActive whether the conversion will actually mutate the output or not.
+func (c outputConversion) IsActive() bool {
+	return c.Format != "" || c.JQ != ""
+}
+
+// validateOutputFormat returns an error if a given output format is not supported.
+func validateOutputFormat(format string) error {
+	if format == "" {
+		return nil
+	}
+	for _, supported := range supportedOutputFormats {
+		if format == supported {
+			return nil
+		}
+	}
+	return fmt.Errorf(unsupportedOutputFmtMsg, format, strings.Join(supportedOutputFormats, ", "))
+}
+
+// Apply converts the message body. JSON and YAML outputs are decoded directly, while plaintext outputs are parsed
+// as tables with a header row. Markdown tables are returned as plaintext, so they are rendered by communication platforms.
+// The jq evaluation is limited in time, number of results and output size.
+func (c outputConversion) Apply(ctx context.Context, msg api.Message) (api.Message, error) {
+	body := messageBody(msg)
+	if !c.IsActive() || strings.TrimSpace(body) == "" {
+		return msg, nil
+	}
+
+	in, err := decodeOutput(body)
+	if err != nil {
+		return api.Message{}, NewExecutionCommandError(outputConversionMsgFmt, err.Error())
+	}
+
+	values := []any{in}
+	if c.JQ != "" {
+		values, err = runJQ(ctx, c.JQ, in)
+		if err != nil {
+			return api.Message{}, err
+		}
+	}
+
+	out, err := renderOutput(c.Format, values)
+	if err != nil {
+		return api.Message{}, NewExecutionCommandError(outputConversionMsgFmt, err.Error())
+	}
+
+	switch {
+	case out == "":
+		msg.BaseBody = api.Body{Plaintext: emptyResponseMsg}
+	case c.Format == markdownTableOutputFormat:
+		msg.BaseBody = api.Body{Plaintext: out}
+	default:
+		msg.BaseBody = api.Body{CodeBlock: out}
+	}
+	return msg, nil
+}
+
+// decodeOutput decodes JSON and YAML documents. Other outputs are parsed as text tables.
+func decodeOutput(body string) (any, error) {
+	var out any
+	if err := json.Unmarshal([]byte(body), &out); err == nil {
+		return out, nil
+	}
+
+	// YAML is a superset of JSON, so the data is normalized to the JSON types supported by jq
+	raw, err := yaml.YAMLToJSON([]byte(body))
+	if err == nil && json.Unmarshal(raw, &out) == nil {
+		switch out.(type) {
+		case map[string]any, []any:
+			return out, nil
+		}
+	}
+
+	return decodeTextTable(body)
+}
+
+// decodeTextTable parses a table with a header row, such as `kubectl get pods` output, into a list of objects.
+func decodeTextTable(body string) (any, error) {
+	var lines []string
+	for _, line := range strings.Split(body, "\n") {
+		if strings.TrimSpace(line) != "" {
+			lines = append(lines, strings.TrimSpace(line))
+		}
+	}
+	if len(lines) < 2 {
+		return nil, errors.New("output is neither JSON, YAML, nor a table with a header row")
+	}
+
+	header := textTableColumnSeparator.Split(lines[0], -1)
+	rows := make([]any, 0, len(lines)-1)
+	for _, line := range lines[1:] {
+		cells := textTableColumnSeparator.Split(line, len(header))
+		row := make(map[string]any, len(header))
+		for i, column := range header {
+			row[column] = ""
+			if i < len(cells) {
+				row[column] = cells[i]
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func runJQ(ctx context.Context, expr string, in any) ([]any, error) {
+	query, err := gojq.Parse(expr)
+	if err != nil {
+		return nil, NewExecutionCommandError(invalidJQExprMsgFmt, err.Error())
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, jqTimeout)
+	defer cancel()
+
+	var (
+		out  []any
+		size int
+	)
+	iter := query.RunWithContext(ctx, in)
+	for {
+		v, ok := iter.Next()
+		if !ok {
+			break
+		}
+		if err, ok := v.(error); ok {
+			if errors.Is(err, context.DeadlineExceeded) {
+				return nil, NewExecutionCommandError(jqTimeoutMsgFmt, jqTimeout)
+			}
+			return nil, NewExecutionCommandError(outputConversionMsgFmt, err.Error())
+		}
+		if len(out) >= jqMaxResults {
+			return nil, NewExecutionCommandError(jqTooManyResultsMsgFmt, jqMaxResults)
+		}
+
+		raw, err := json.Marshal(v)
+		if err != nil {
+			return nil, NewExecutionCommandError(outputConversionMsgFmt, err.Error())
+		}
+		size += len(raw)
+		if size > jqMaxOutputSize {
+			return nil, NewExecutionCommandError(jqOutputTooLargeMsgFmt, jqMaxOutputSize)
+		}
+		out = append(out, v)
+	}
+	return out, nil
+}
+
+// renderOutput renders the jq results. Without the format, string results are printed as they are,
+// and other ones as JSON, which is the same as `jq --raw-output` does.
+func renderOutput(format string, values []any) (string, error) {
+	switch format {
+	case "":
+		var out []string
+		for _, v := range values {
+			if s, ok := v.(string); ok {
+				out = append(out, s)
+				continue
+			}
+			raw, err := json.MarshalIndent(v, "", "  ")
+			if err != nil {
+				return "", fmt.Errorf("while marshaling to JSON: %w", err)
+			}
+			out = append(out, string(raw))
+		}
+		return strings.Join(out, "\n"), nil
+	case jsonOutputFormat:
+		raw, err := json.MarshalIndent(singleOrAll(values), "", "  ")
+		if err != nil {
+			return "", fmt.Errorf("while marshaling to JSON: %w", err)
+		}
+		return string(raw), nil
+	case yamlOutputFormat:
+		raw, err := yaml.Marshal(singleOrAll(values))
+		if err != nil {
+			return "", fmt.Errorf("while marshaling to YAML: %w", err)
+		}
+		return strings.TrimSuffix(string(raw), "\n"), nil
+	}
+
+	columns, rows, err := tabulate(values)
+	if err != nil {
+		return "", err
+	}
+	switch format {
+	case tableOutputFormat:
+		return renderTable(columns, rows)
+	case csvOutputFormat:
+		return renderCSV(columns, rows)
+	case markdownTableOutputFormat:
+		return renderMarkdownTable(columns, rows), nil
+	}
+	return "", fmt.Errorf(unsupportedOutputFmtMsg, format, strings.Join(supportedOutputFormats, ", "))
+}
+
+// tabulate converts values into table rows. A single list is expanded into rows, objects keys become columns,
+// and nested values are rendered as compact JSON.
+func tabulate(values []any) ([]string, [][]string, error) {
+	items := values
+	if len(values) == 1 {
+		if list, ok := values[0].([]any); ok {
+			items = list
+		}
+	}
+
+	columnSet := map[string]struct{}{}
+	for _, item := range items {
+		obj, ok := item.(map[string]any)
+		if !ok {
+			columnSet[scalarColumnName] = struct{}{}
+			continue
+		}
+		for key := range obj {
+			columnSet[key] = struct{}{}
+		}
+	}
+	columns := make([]string, 0, len(columnSet))
+	for column := range columnSet {
+		columns = append(columns, column)
+	}
+	sort.Strings(columns)
+
+	rows := make([][]string, 0, len(items))
+	for _, item := range items {
+		obj, ok := item.(map[string]any)
+		if !ok {
+			obj = map[string]any{scalarColumnName: item}
+		}
+
+		row := make([]string, 0, len(columns))
+		for _, column := range columns {
+			cell, err := cellValue(obj[column])
+			if err != nil {
+				return nil, nil, err
+			}
+			row = append(row, cell)
+		}
+		rows = append(rows, row)
+	}
+	return columns, rows, nil
+}
+
+func cellValue(v any) (string, error) {
+	switch val := v.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return val, nil
+	}
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return "", fmt.Errorf("while marshaling table cell: %w", err)
+	}
+	return string(raw), nil
+}
+
+func renderTable(columns []string, rows [][]string) (string, error) {
+	var buff bytes.Buffer
+	w := tabwriter.NewWriter(&buff, 0, 8, 3, ' ', 0)
+	fmt.Fprintln(w, strings.ToUpper(strings.Join(columns, "\t")))
+	for _, row := range rows {
+		fmt.Fprintln(w, strings.Join(row, "\t"))
+	}
+	if err := w.Flush(); err != nil {
+		return "", fmt.Errorf("while writing table: %w", err)
+	}
+	return strings.TrimSuffix(buff.String(), "\n"), nil
+}
+
+func renderCSV(columns []string, rows [][]string) (string, error) {
+	var buff bytes.Buffer
+	w := csv.NewWriter(&buff)
+	if err := w.Write(columns); err != nil {
+		return "", fmt.Errorf("while writing CSV header: %w", err)
+	}
+	if err := w.WriteAll(rows); err != nil {
+		return "", fmt.Errorf("while writing CSV rows: %w", err)
+	}
+	return strings.TrimSuffix(buff.String(), "\n"), nil
+}
+
+func renderMarkdownTable(columns []string, rows [][]string) string {
+	escape := strings.NewReplacer("|", `\|`, "\n", " ")
+	line := func(cells []string) string {
+		escaped := make([]string, 0, len(cells))
+		for _, cell := range cells {
+			escaped = append(escaped, escape.Replace(cell))
+		}
+		return "| " + strings.Join(escaped, " | ") + " |"
+	}
+
+	separators := make([]string, 0, len(columns))
+	for range columns {
+		separators = append(separators, "---")
+	}
+
+	out := []string{line(columns), line(separators)}
+	for _, row := range rows {
+		out = append(out, line(row))
+	}
+	return strings.Join(out, "\n")
+}
+
+func singleOrAll(values []any) any {
+	if len(values) == 1 {
+		return values[0]
+	}
+	return values
+}
@@ -0,0 +1,152 @@
+package execute
+
+import (
+	"context"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/pkg/api"
+)
+
+func TestOutputConversionApply(t *testing.T) {
+	podsJSON := `{"items": [{"metadata": {"name": "nginx", "namespace": "default"}}, {"metadata": {"name": "redis", "namespace": "cache"}}]}`
+	podsYAML := heredoc.Doc(`
+		items:
+		- metadata:
+		    name: nginx
+		    namespace: default
+		- metadata:
+		    name: redis
+		    namespace: cache`)
+	podsTable := heredoc.Doc(`
+		NAME    READY   STATUS
+		nginx   1/1     Running
+		redis   0/1     CrashLoopBackOff`)
+
+	tests := []struct {
+		name       string
+		conversion outputConversion
+		in         api.Message
+		expBody    api.Body
+	}{
+		{
+			name:       "jq on JSON output",
+			conversion: outputConversion{JQ: ".items[].metadata.name"},
+			in:         api.NewCodeBlockMessage(podsJSON, true),
+			expBody:    api.Body{CodeBlock: "nginx\nredis"},
+		},
+		{
+			name:       "jq on YAML output to table",
+			conversion: outputConversion{Format: "table", JQ: "[.items[].metadata]"},
+			in:         api.NewCodeBlockMessage(podsYAML, true),
+			expBody: api.Body{CodeBlock: heredoc.Doc(`
+				NAME    NAMESPACE
+				nginx   default
+				redis   cache`)},
+		},
+		{
+			name:       "plaintext table to CSV",
+			conversion: outputConversion{Format: "csv", JQ: `map(select(.STATUS != "Running"))`},
+			in:         api.NewPlaintextMessage(podsTable, true),
+			expBody:    api.Body{CodeBlock: "NAME,READY,STATUS\nredis,0/1,CrashLoopBackOff"},
+		},
+		{
+			name:       "JSON to markdown table",
+			conversion: outputConversion{Format: "markdown-table", JQ: `.items[] | {name: .metadata.name, labels: {app: "a|b"}}`},
+			in:         api.NewCodeBlockMessage(podsJSON, true),
+			expBody: api.Body{Plaintext: heredoc.Doc(`
+				| labels | name |
+				| --- | --- |
+				| {"app":"a\|b"} | nginx |
+				| {"app":"a\|b"} | redis |`)},
+		},
+		{
+			name:       "JSON to YAML",
+			conversion: outputConversion{Format: "yaml", JQ: ".items[0].metadata"},
+			in:         api.NewCodeBlockMessage(podsJSON, true),
+			expBody:    api.Body{CodeBlock: "name: nginx\nnamespace: default"},
+		},
+		{
+			name:       "no results",
+			conversion: outputConversion{JQ: ".items[] | select(.metadata.name == \"mysql\")"},
+			in:         api.NewCodeBlockMessage(podsJSON, true),
+			expBody:    api.Body{Plaintext: emptyResponseMsg},
+		},
+		{
+			name:    "inactive conversion",
+			in:      api.NewCodeBlockMessage(podsTable, true),
+			expBody: api.Body{CodeBlock: podsTable},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			out, err := tc.conversion.Apply(context.Background(), tc.in)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.expBody, out.BaseBody)
+			assert.Equal(t, tc.in.Type, out.Type)
+		})
+	}
+}
+
+func TestOutputConversionApplyErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		conversion outputConversion
+		in         string
+		expErr     string
+	}{
+		{
+			name:       "invalid jq expression",
+			conversion: outputConversion{JQ: ".items["},
+			in:         `{"items": []}`,
+			expErr:     "Invalid --jq expression: unexpected EOF",
+		},
+		{
+			name:       "jq runtime error",
+			conversion: outputConversion{JQ: ".items.name"},
+			in:         `{"items": []}`,
+			expErr:     "Cannot convert the command output: expected an object but got: array ([])",
+		},
+		{
+			name:       "unstructured output",
+			conversion: outputConversion{Format: "json"},
+			in:         "pod/nginx deleted",
+			expErr:     "Cannot convert the command output: output is neither JSON, YAML, nor a table with a header row",
+		},
+		{
+			name:       "jq expression with infinite results",
+			conversion: outputConversion{JQ: "repeat(.)"},
+			in:         `{"items": []}`,
+			expErr:     "The --jq expression produced more than 10000 results.",
+		},
+		{
+			name:       "jq expression which never finishes",
+			conversion: outputConversion{JQ: "[range(infinite)]"},
+			in:         `{"items": []}`,
+			expErr:     "The --jq expression didn't finish within 1s.",
+		},
+		{
+			name:       "jq expression with too large output",
+			conversion: outputConversion{JQ: "[range(200000)]"},
+			in:         `{"items": []}`,
+			expErr:     "The --jq expression produced more than 1048576 bytes of output.",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			_, err := tc.conversion.Apply(context.Background(), api.NewCodeBlockMessage(tc.in, false))
+
+			// then
+			require.Error(t, err)
+			assert.True(t, IsExecutionCommandError(err))
+			assert.EqualError(t, err, tc.expErr)
+		})
+	}
+}
@@ -0,0 +1,265 @@
+package execute
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/execute/command"
+)
+
+var _ CommandExecutor = &OutputPager{}
+
+const (
+	// pagedOutputIDLength is the length of generated paged output IDs. They are short, so they can be easily typed in chat.
+	pagedOutputIDLength = 8
+
+	pageDownloadArg       = "download"
+	pageFooterMsgFmt      = "Page %d of %d"
+	pageDownloadedMsg     = "The full output is attached as " + largeOutputFileName + "."
+	pagedOutputExpiredFmt = "Output %q has expired. Run the command again to browse it."
+	pageOutOfRangeMsgFmt  = "Page %d doesn't exist. The output has %d pages."
+	pageUsageMsgFmt       = `Usage:
+  %[1]s show page <id> <number>
+  %[1]s show page <id> download`
+)
+
+var pageFeatureName = FeatureName{Name: "page"}
+
+type pagedOutput struct {
+	conversationID string
+	// msg is the original message. Its body is replaced with a given page.
+	msg interactive.CoreMessage
+	// body is the full output. Pages are its substrings, so they don't take additional memory.
+	body      string
+	pages     []string
+	expiresAt time.Time
+}
+
+// OutputPager splits large command outputs into pages, which are browsed with buttons on interactive platforms.
+// Outputs are kept in memory until they expire or the cache size limit is reached.
+type OutputPager struct {
+	log      logrus.FieldLogger
+	cfg      config.Pagination
+	pageSize int
+	now      func() time.Time
+
+	mu      sync.Mutex
+	outputs map[string]*pagedOutput
+	size    int
+}
+
+// NewOutputPager returns a new OutputPager instance. Pages have the max size of the output posted inline.
+func NewOutputPager(log logrus.FieldLogger, cfg config.Pagination, largeOutput config.LargeOutput) *OutputPager {
+	return &OutputPager{
+		log:      log,
+		cfg:      cfg,
+		pageSize: largeOutput.GetMaxInlineSize(),
+		now:      time.Now,
+		outputs:  map[string]*pagedOutput{},
+	}
+}
+
+// Commands returns slice of commands the executor supports.
+func (p *OutputPager) Commands() map[command.Verb]CommandFn {
+	return map[command.Verb]CommandFn{
+		command.ShowVerb: p.Show,
+	}
+}
+
+// FeatureName returns the name and aliases of the feature provided by this executor.
+func (p *OutputPager) FeatureName() FeatureName {
+	return pageFeatureName
+}
+
+// Paginate splits the message body above the page size into pages and returns the first one with navigation buttons.
+// It returns false if the message doesn't need pagination, or it's too large to be kept in the cache.
+func (p *OutputPager) Paginate(msg interactive.CoreMessage, cmdCtx CommandContext) (interactive.CoreMessage, bool) {
+	if p == nil {
+		return msg, false
+	}
+
+	body := strings.TrimSuffix(messageBody(msg.Message), "\n")
+	if len(body) <= p.pageSize || len(body) > p.cfg.GetMaxCacheSize() {
+		return msg, false
+	}
+
+	id := uuid.New().String()[:pagedOutputIDLength]
+	out := &pagedOutput{
+		conversationID: cmdCtx.Conversation.ID,
+		msg:            msg,
+		body:           body,
+		pages:          splitPages(body, p.pageSize),
+		expiresAt:      p.now().Add(p.cfg.GetCacheTTL()),
+	}
+	p.store(id, out)
+
+	p.log.WithFields(logrus.Fields{
+		"id":    id,
+		"pages": len(out.pages),
+	}).Debug("Paginated command output")
+	return out.render(id, 1), true
+}
+
+// Show renders a given page of the paginated output in place of the original message, or returns the full output as a file.
+func (p *OutputPager) Show(_ context.Context, cmdCtx CommandContext) (interactive.CoreMessage, error) {
+	if len(cmdCtx.Args) != 4 {
+		return interactive.CoreMessage{}, NewExecutionCommandError(pageUsageMsgFmt, api.MessageBotNamePlaceholder)
+	}
+	id, pageArg := cmdCtx.Args[2], strings.ToLower(cmdCtx.Args[3])
+
+	out, found := p.get(id, cmdCtx.Conversation.ID)
+	if !found {
+		return interactive.CoreMessage{}, NewExecutionCommandError(pagedOutputExpiredFmt, id)
+	}
+
+	if pageArg == pageDownloadArg {
+		return interactive.CoreMessage{
+			Description: out.msg.Description,
+			Message: api.Message{
+				BaseBody: api.Body{
+					Plaintext: pageDownloadedMsg,
+				},
+				Attachments: []api.Attachment{
+					{Name: largeOutputFileName, ContentType: largeOutputContentType, Data: []byte(out.body)},
+				},
+			},
+		}, nil
+	}
+
+	page, err := strconv.Atoi(pageArg)
+	if err != nil {
+		return interactive.CoreMessage{}, NewExecutionCommandError(pageUsageMsgFmt, api.MessageBotNamePlaceholder)
+	}
+	if page < 1 || page > len(out.pages) {
+		return interactive.CoreMessage{}, NewExecutionCommandError(pageOutOfRangeMsgFmt, page, len(out.pages))
+	}
+
+	msg := out.render(id, page)
+	msg.ReplaceOriginal = true
+	return msg, nil
+}
+
+func (p *OutputPager) store(id string, out *pagedOutput) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.removeExpired()
+	for p.size+len(out.body) > p.cfg.GetMaxCacheSize() {
+		p.removeOldest()
+	}
+	p.outputs[id] = out
+	p.size += len(out.body)
+}
+
+func (p *OutputPager) get(id, conversationID string) (*pagedOutput, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.removeExpired()
+	out, found := p.outputs[id]
+	if !found || out.conversationID != conversationID {
+		return nil, false
+	}
+	return out, true
+}
+
+// removeExpired removes expired outputs. It must be called with the lock held.
+func (p *OutputPager) removeExpired() {
+	now := p.now()
+	for id, out := range p.outputs {
+		if now.After(out.expiresAt) {
+			p.remove(id)
+		}
+	}
+}
+
+// removeOldest removes the output which expires first. It must be called with the lock held.
+func (p *OutputPager) removeOldest() {
+	var oldestID string
+	for id, out := range p.outputs {
+		if oldestID == "" || out.expiresAt.Before(p.outputs[oldestID].expiresAt) {
+			oldestID = id
+		}
+	}
+	p.remove(oldestID)
+}
+
+func (p *OutputPager) remove(id string) {
+	out, found := p.outputs[id]
+	if !found {
+		return
+	}
+	p.size -= len(out.body)
+	delete(p.outputs, id)
+}
+
+// render returns the original message with a given page and navigation buttons. Pages are numbered from 1.
+func (o *pagedOutput) render(id string, page int) interactive.CoreMessage {
+	msg := o.msg
+	if msg.BaseBody.CodeBlock != "" {
+		msg.BaseBody.CodeBlock = o.pages[page-1]
+	} else {
+		msg.BaseBody.Plaintext = o.pages[page-1]
+	}
+
+	pageCmd := func(arg string) string {
+		return fmt.Sprintf("%s %s %s %s", command.ShowVerb, pageFeatureName.Name, id, arg)
+	}
+	btnBuilder := api.NewMessageButtonBuilder()
+	var buttons api.Buttons
+	if page > 1 {
+		buttons = append(buttons, btnBuilder.ForCommandWithoutDesc("Prev", pageCmd(strconv.Itoa(page-1))))
+	}
+	if page < len(o.pages) {
+		buttons = append(buttons, btnBuilder.ForCommandWithoutDesc("Next", pageCmd(strconv.Itoa(page+1)), api.ButtonStylePrimary))
+	}
+	buttons = append(buttons, btnBuilder.ForCommandWithoutDesc("Download", pageCmd(pageDownloadArg)))
+
+	// the sections are copied, so the cached message is not modified
+	msg.Sections = append(append([]api.Section{}, o.msg.Sections...), api.Section{
+		Buttons: buttons,
+		Context: api.ContextItems{
+			{Text: fmt.Sprintf(pageFooterMsgFmt, page, len(o.pages))},
+		},
+	})
+	return msg
+}
+
+// splitPages splits a given output into pages of a given size. Pages are split at line breaks, unless a single line is longer than the page.
+func splitPages(body string, size int) []string {
+	var pages []string
+	for len(body) > size {
+		cut := strings.LastIndex(body[:size], "\n")
+		if cut <= 0 {
+			cut = size
+			for cut > 0 && !utf8.RuneStart(body[cut]) {
+				cut--
+			}
+		}
+		pages = append(pages, body[:cut])
+		body = strings.TrimPrefix(body[cut:], "\n")
+	}
+	if body != "" {
+		pages = append(pages, body)
+	}
+	return pages
+}
+
+// messageBody returns the code block of a given message, or its plaintext if the code block is empty.
+func messageBody(msg api.Message) string {
+	if msg.BaseBody.CodeBlock != "" {
+		return msg.BaseBody.CodeBlock
+	}
+	return msg.BaseBody.Plaintext
+}
@@ -0,0 +1,208 @@
+package execute
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+func TestSplitPages(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		size     int
+		expPages []string
+	}{
+		{
+			name:     "split at line breaks",
+			body:     "line 1\nline 2\nline 3\nline 4",
+			size:     14,
+			expPages: []string{"line 1\nline 2", "line 3\nline 4"},
+		},
+		{
+			name:     "split long line",
+			body:     "ąąąąą\nline 2",
+			size:     5,
+			expPages: []string{"ąą", "ąą", "ą", "line ", "2"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			pages := splitPages(tc.body, tc.size)
+
+			// then
+			assert.Equal(t, tc.expPages, pages)
+		})
+	}
+}
+
+func TestOutputPagerNavigation(t *testing.T) {
+	// given
+	pager := NewOutputPager(loggerx.NewNoop(), config.Pagination{}, config.LargeOutput{MaxInlineSize: 14})
+	cmdCtx := fixStreamCmdCtx(nil, "kubectl", "get", "pods")
+	in := interactive.CoreMessage{
+		Description: "`kubectl get pods` on `cluster-name`",
+		Message:     api.NewCodeBlockMessage("line 1\nline 2\nline 3\nline 4\nline 5\n", false),
+	}
+
+	// when
+	msg, ok := pager.Paginate(in, cmdCtx)
+
+	// then
+	require.True(t, ok)
+	id := onlyPagedOutputID(t, pager)
+	assert.Equal(t, "line 1\nline 2", msg.BaseBody.CodeBlock)
+	assert.False(t, msg.ReplaceOriginal)
+	assert.Equal(t, "Page 1 of 3", msg.Sections[0].Context[0].Text)
+	assert.Equal(t, api.Buttons{
+		{Name: "Next", Command: "{{BotName}} show page " + id + " 2", Style: api.ButtonStylePrimary},
+		{Name: "Download", Command: "{{BotName}} show page " + id + " download"},
+	}, msg.Sections[0].Buttons)
+
+	// when
+	msg, err := pager.Show(context.Background(), fixStreamCmdCtx(nil, "show", "page", id, "3"))
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, in.Description, msg.Description)
+	assert.Equal(t, "line 5", msg.BaseBody.CodeBlock)
+	assert.True(t, msg.ReplaceOriginal)
+	require.Len(t, msg.Sections, 1)
+	assert.Equal(t, "Page 3 of 3", msg.Sections[0].Context[0].Text)
+	assert.Equal(t, api.Buttons{
+		{Name: "Prev", Command: "{{BotName}} show page " + id + " 2"},
+		{Name: "Download", Command: "{{BotName}} show page " + id + " download"},
+	}, msg.Sections[0].Buttons)
+
+	// when
+	msg, err = pager.Show(context.Background(), fixStreamCmdCtx(nil, "show", "page", id, "download"))
+
+	// then
+	require.NoError(t, err)
+	assert.False(t, msg.ReplaceOriginal)
+	assert.Equal(t, []api.Attachment{
+		{Name: "output.txt", ContentType: "text/plain", Data: []byte("line 1\nline 2\nline 3\nline 4\nline 5")},
+	}, msg.Attachments)
+
+	// when
+	_, err = pager.Show(context.Background(), fixStreamCmdCtx(nil, "show", "page", id, "4"))
+
+	// then
+	require.Error(t, err)
+	assert.EqualError(t, err, "Page 4 doesn't exist. The output has 3 pages.")
+
+	// when browsed from another conversation
+	otherCmdCtx := fixStreamCmdCtx(nil, "show", "page", id, "2")
+	otherCmdCtx.Conversation.ID = "other"
+	_, err = pager.Show(context.Background(), otherCmdCtx)
+
+	// then
+	require.Error(t, err)
+	assert.EqualError(t, err, `Output "`+id+`" has expired. Run the command again to browse it.`)
+}
+
+func TestOutputPagerEviction(t *testing.T) {
+	// given
+	now := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+	pager := NewOutputPager(loggerx.NewNoop(), config.Pagination{CacheTTL: time.Minute, MaxCacheSizeMB: 1}, config.LargeOutput{})
+	pager.now = func() time.Time { return now }
+	cmdCtx := fixStreamCmdCtx(nil, "kubectl", "get", "pods")
+	fixMsg := func() interactive.CoreMessage {
+		return interactive.CoreMessage{Message: api.NewCodeBlockMessage(strings.Repeat("x", 400*1024), false)}
+	}
+
+	// when
+	_, ok := pager.Paginate(fixMsg(), cmdCtx)
+	require.True(t, ok)
+	first := onlyPagedOutputID(t, pager)
+	now = now.Add(time.Second)
+	_, ok = pager.Paginate(fixMsg(), cmdCtx)
+	require.True(t, ok)
+	now = now.Add(time.Second)
+	_, ok = pager.Paginate(fixMsg(), cmdCtx)
+	require.True(t, ok)
+
+	// then the oldest output is removed, so the cache size is not exceeded
+	assert.Len(t, pager.outputs, 2)
+	assert.NotContains(t, pager.outputs, first)
+	assert.Equal(t, 800*1024, pager.size)
+
+	// when
+	now = now.Add(2 * time.Minute)
+	_, ok = pager.Paginate(interactive.CoreMessage{Message: api.NewCodeBlockMessage(strings.Repeat("x", 2*1024*1024), false)}, cmdCtx)
+
+	// then expired outputs are removed and outputs larger than the cache are not paginated
+	assert.False(t, ok)
+	_, found := pager.get(first, cmdCtx.Conversation.ID)
+	assert.False(t, found)
+	pager.mu.Lock()
+	pager.removeExpired()
+	pager.mu.Unlock()
+	assert.Empty(t, pager.outputs)
+	assert.Zero(t, pager.size)
+}
+
+func TestPluginExecutorFitOutput(t *testing.T) {
+	// given
+	var lines []string
+	for i := 1; i <= 20; i++ {
+		lines = append(lines, fmt.Sprintf("pod-%d  Running", i))
+	}
+	largeOutput := api.NewCodeBlockMessage(strings.Join(lines, "\n"), true)
+	cfg := config.Config{
+		Settings: config.Settings{
+			LargeOutput: config.LargeOutput{MaxInlineSize: 40},
+		},
+	}
+	pager := NewOutputPager(loggerx.NewNoop(), cfg.Settings.Pagination, cfg.Settings.LargeOutput)
+	pluginExecutor := NewPluginExecutor(loggerx.NewNoop(), cfg, nil, nil, nil, nil, pager)
+
+	t.Run("paginate filtered output on interactive platforms", func(t *testing.T) {
+		cmdCtx := fixStreamCmdCtx(nil, "kubectl", "get", "pods")
+		cmdCtx.Platform = config.SocketSlackCommPlatformIntegration
+		cmdCtx.ExecutorFilter = newExecutorTextFilter("pod-1")
+
+		// when
+		out := pluginExecutor.fitOutput(pluginExecutor.filterMessage(largeOutput, cmdCtx), cmdCtx)
+
+		// then
+		assert.Equal(t, "pod-1  Running\npod-10  Running", out.BaseBody.CodeBlock)
+		require.NotEmpty(t, out.Sections)
+		assert.Equal(t, "Page 1 of 6", out.Sections[len(out.Sections)-1].Context[0].Text)
+		assert.Empty(t, out.Attachments)
+	})
+
+	t.Run("attach output on other platforms", func(t *testing.T) {
+		cmdCtx := fixStreamCmdCtx(nil, "kubectl", "get", "pods")
+		cmdCtx.Platform = config.DiscordCommPlatformIntegration
+
+		// when
+		out := pluginExecutor.fitOutput(pluginExecutor.filterMessage(largeOutput, cmdCtx), cmdCtx)
+
+		// then
+		require.Len(t, out.Attachments, 1)
+		assert.Equal(t, "output.txt", out.Attachments[0].Name)
+	})
+}
+
+func onlyPagedOutputID(t *testing.T, pager *OutputPager) string {
+	t.Helper()
+	pager.mu.Lock()
+	defer pager.mu.Unlock()
+	require.Len(t, pager.outputs, 1)
+	for id := range pager.outputs {
+		return id
+	}
+	return ""
+}
@@ -27,6 +27,9 @@ type Flags struct {
 	ClusterName  string
 	TokenizedCmd []string
 	CmdHeader    string
+	OutputFormat string
+	JQ           string
+	Aggregate    bool
 }
 
 // ParseFlags parses raw cmd and removes optional params with flags.
@@ -52,6 +55,24 @@ func ParseFlags(cmd string) (Flags, error) {
 		return Flags{}, err
 	}
 
+	cmd, outputFormat, err := extractParam(cmd, "output-format")
+	if err != nil {
+		return Flags{}, err
+	}
+	if err := validateOutputFormat(outputFormat); err != nil {
+		return Flags{}, fmt.Errorf(incorrectParamFlag, "--output-format", err)
+	}
+
+	cmd, jq, err := extractParam(cmd, "jq")
+	if err != nil {
+		return Flags{}, err
+	}
+
+	cmd, aggregate, err := extractBoolParam(cmd, "aggregate")
+	if err != nil {
+		return Flags{}, fmt.Errorf("while extracting aggregate flag: %w", err)
+	}
+
 	tokenized, err := shellwords.Parse(cmd)
 	if err != nil {
 		return Flags{}, errors.New(cantParseCmd)
@@ -62,6 +83,9 @@ func ParseFlags(cmd string) (Flags, error) {
 		ClusterName:  clusterName,
 		TokenizedCmd: tokenized,
 		CmdHeader:    cmdHeaderName,
+		OutputFormat: outputFormat,
+		JQ:           jq,
+		Aggregate:    aggregate,
 	}, nil
 }
 
@@ -138,3 +138,51 @@ func TestExtractExecutorFilter_WithErrors(t *testing.T) {
 		})
 	}
 }
+
+func TestCommandContextProvidedClusterName(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Provided    string
+		ExpMatch    bool
+		ExpIsFanOut bool
+	}{
+		{Name: "Empty", Provided: "", ExpMatch: true},
+		{Name: "Equal", Provided: "prod-eu", ExpMatch: true},
+		{Name: "Different", Provided: "stage", ExpMatch: false},
+		{Name: "Glob", Provided: "prod-*", ExpMatch: true, ExpIsFanOut: true},
+		{Name: "Not matching glob", Provided: "stage-?", ExpMatch: false, ExpIsFanOut: true},
+		{Name: "List", Provided: "stage, prod-eu", ExpMatch: true, ExpIsFanOut: true},
+		{Name: "List with glob", Provided: "stage,prod-[ab]*", ExpMatch: false, ExpIsFanOut: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			// given
+			cmdCtx := CommandContext{ClusterName: "prod-eu", ProvidedClusterName: tc.Provided}
+
+			// when
+			match := cmdCtx.ProvidedClusterNameEqualOrEmpty()
+			isFanOut := cmdCtx.IsClusterFanOut()
+
+			// then
+			assert.Equal(t, tc.ExpMatch, match)
+			assert.Equal(t, tc.ExpIsFanOut, isFanOut)
+		})
+	}
+}
+
+func TestParseFlags_OutputConversion(t *testing.T) {
+	// when
+	p, err := ParseFlags(`kubectl get pods -o json --output-format=table --jq '.items[] | {name: .metadata.name}'`)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "kubectl get pods -o json", p.CleanCmd)
+	assert.Equal(t, "table", p.OutputFormat)
+	assert.Equal(t, ".items[] | {name: .metadata.name}", p.JQ)
+
+	// when
+	_, err = ParseFlags("kubectl get pods --output-format=xml")
+
+	// then
+	assert.EqualError(t, err, `incorrect use of --output-format flag: unsupported output format "xml". Supported formats: table, json, yaml, csv, markdown-table`)
+}
@@ -2,8 +2,10 @@ package execute
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/sirupsen/logrus"
 	"github.com/slack-go/slack"
@@ -21,21 +23,35 @@ import (
 // PluginExecutor provides functionality to run registered Botkube plugins.
 type PluginExecutor struct {
 	log           logrus.FieldLogger
+	mu            sync.RWMutex
 	cfg           config.Config
 	pluginManager *plugin.Manager
 	restCfg       *rest.Config
+	chatUsers     *plugin.ChatUserResolver
+	streams       *StreamExecutor
+	pager         *OutputPager
 }
 
 // NewPluginExecutor creates a new instance of PluginExecutor.
-func NewPluginExecutor(log logrus.FieldLogger, cfg config.Config, manager *plugin.Manager, restCfg *rest.Config) *PluginExecutor {
+func NewPluginExecutor(log logrus.FieldLogger, cfg config.Config, manager *plugin.Manager, restCfg *rest.Config, chatUsers *plugin.ChatUserResolver, streams *StreamExecutor, pager *OutputPager) *PluginExecutor {
 	return &PluginExecutor{
 		log:           log,
 		cfg:           cfg,
 		pluginManager: manager,
 		restCfg:       restCfg,
+		chatUsers:     chatUsers,
+		streams:       streams,
+		pager:         pager,
 	}
 }
 
+// SetExecutors replaces the executors configuration, so the changes of plugin configuration are applied without restart.
+func (e *PluginExecutor) SetExecutors(executors map[string]config.Executors) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.cfg.Executors = executors
+}
+
 // CanHandle returns true if it's a known plugin executor.
 func (e *PluginExecutor) CanHandle(bindings []string, args []string) bool {
 	if len(args) == 0 {
@@ -84,7 +100,21 @@ func (e *PluginExecutor) Execute(ctx context.Context, bindings []string, slackSt
 	input := plugin.KubeConfigInput{
 		Channel: cmdCtx.Conversation.DisplayName,
 	}
+	if plugins[0].Context.RBAC.UsesChatUser() {
+		input.ChatUser, err = e.chatUsers.Resolve(ctx, plugin.ChatUser{
+			Platform:    cmdCtx.Platform,
+			ID:          cmdCtx.User.ID,
+			Email:       cmdCtx.User.Email,
+			DisplayName: cmdCtx.User.DisplayName,
+		})
+		if err != nil {
+			return interactive.CoreMessage{}, fmt.Errorf("while resolving chat user: %w", err)
+		}
+	}
 	kubeconfig, err := plugin.GenerateKubeConfig(e.restCfg, e.cfg.Settings.ClusterName, plugins[0].Context, input)
+	if errors.Is(err, plugin.ErrChatUserNotMapped) {
+		return interactive.CoreMessage{}, NewExecutionCommandError("You are not allowed to run this command, as your user is not mapped to any Kubernetes user. Ask your administrator to add you to the 'chatUsers' configuration.")
+	}
 	if err != nil {
 		return interactive.CoreMessage{}, fmt.Errorf("while generating kube config: %w", err)
 	}
@@ -98,7 +128,7 @@ func (e *PluginExecutor) Execute(ctx context.Context, bindings []string, slackSt
 		e.sanitizeSlackStateIDs(slackState)
 	}
 
-	resp, err := cli.Execute(ctx, executor.ExecuteInput{
+	execInput := executor.ExecuteInput{
 		Command: cmdCtx.CleanCmd,
 		Configs: configs,
 		Context: executor.ExecuteInputContext{
@@ -106,21 +136,28 @@ func (e *PluginExecutor) Execute(ctx context.Context, bindings []string, slackSt
 			SlackState:               slackState,
 			KubeConfig:               kubeconfig,
 		},
-	})
+	}
+
+	if streamer, ok := cli.(executor.StreamExecutor); ok && plugins[0].Streaming && cmdCtx.MessageStreamer != nil && e.streams != nil {
+		return e.executeStream(ctx, streamer, execInput, cmdCtx)
+	}
+
+	resp, err := cli.Execute(ctx, execInput)
 	if err != nil {
-		s, ok := status.FromError(err)
-		if !ok {
-			return interactive.CoreMessage{}, NewExecutionCommandError(err.Error())
-		}
-		return interactive.CoreMessage{}, NewExecutionCommandError(s.Message())
+		return interactive.CoreMessage{}, commandError(err)
 	}
 
 	if resp.Message.IsEmpty() {
 		return emptyMsg(cmdCtx), nil
 	}
 
+	resp.Message, err = cmdCtx.OutputConversion.Apply(ctx, resp.Message)
+	if err != nil {
+		return interactive.CoreMessage{}, err
+	}
+
 	if resp.Message.Type == api.BaseBodyWithFilterMessage {
-		return e.filterMessage(resp.Message, cmdCtx), nil
+		return e.fitOutput(e.filterMessage(resp.Message, cmdCtx), cmdCtx), nil
 	}
 
 	out := interactive.CoreMessage{
@@ -130,7 +167,46 @@ func (e *PluginExecutor) Execute(ctx context.Context, bindings []string, slackSt
 		out.Description = header(cmdCtx)
 	}
 
-	return out, nil
+	return e.fitOutput(out, cmdCtx), nil
+}
+
+// executeStream executes a command whose output is streamed. The output filter and conversion are already applied to the streamed chunks.
+func (e *PluginExecutor) executeStream(ctx context.Context, streamer executor.StreamExecutor, in executor.ExecuteInput, cmdCtx CommandContext) (interactive.CoreMessage, error) {
+	msg, err := e.streams.Run(ctx, streamer, in, cmdCtx)
+	if err != nil {
+		return interactive.CoreMessage{}, commandError(err)
+	}
+	if msg.IsEmpty() {
+		return emptyMsg(cmdCtx), nil
+	}
+
+	return e.fitOutput(interactive.CoreMessage{
+		Description: header(cmdCtx),
+		Message:     msg,
+	}, cmdCtx), nil
+}
+
+func commandError(err error) error {
+	if IsExecutionCommandError(err) {
+		return err
+	}
+	s, ok := status.FromError(err)
+	if !ok {
+		return NewExecutionCommandError(err.Error())
+	}
+	return NewExecutionCommandError(s.Message())
+}
+
+// fitOutput makes sure that large outputs are not truncated by communication platforms. Interactive platforms get paginated output,
+// while other ones get the output attached as a file.
+func (e *PluginExecutor) fitOutput(out interactive.CoreMessage, cmdCtx CommandContext) interactive.CoreMessage {
+	if cmdCtx.Platform.IsInteractive() {
+		if paged, ok := e.pager.Paginate(out, cmdCtx); ok {
+			return paged
+		}
+	}
+	out.Message = attachLargeOutput(out.Message, e.cfg.Settings.LargeOutput)
+	return out
 }
 
 // sanitizeSlackStateIDs makes sure that the slack state doesn't contain the --cluster-name
@@ -258,6 +334,9 @@ func (e *PluginExecutor) getEnabledPlugins(bindings []string, cmdName string) ([
 		fullPluginName string
 	)
 
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
 	for _, bindingName := range bindings {
 		bindExecutors, found := e.cfg.Executors[bindingName]
 		if !found {
@@ -0,0 +1,191 @@
+package execute
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"golang.org/x/time/rate"
+
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+// RateLimitScope describes which limit was exceeded.
+type RateLimitScope string
+
+const (
+	// UserRateLimitScope is the per-user limit.
+	UserRateLimitScope RateLimitScope = "user"
+	// ChannelRateLimitScope is the per-channel limit.
+	ChannelRateLimitScope RateLimitScope = "channel"
+	// PluginRateLimitScope is the per-plugin limit.
+	PluginRateLimitScope RateLimitScope = "plugin"
+
+	// maxTrackedRateLimitKeys is the number of tracked users or channels after which idle buckets are removed.
+	maxTrackedRateLimitKeys = 10000
+
+	rateLimitExceededMsgFmt = "You have reached the %s. Try again in %s."
+)
+
+var rateLimitedCommandsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "botkube_rate_limited_commands_total",
+	Help: "Total number of commands which were not executed, as a given rate limit was exceeded.",
+}, []string{"scope", "plugin"})
+
+// RateLimitExceededError is returned when a command exceeds a rate limit.
+type RateLimitExceededError struct {
+	Scope      RateLimitScope
+	RetryAfter time.Duration
+}
+
+// Error returns error message.
+func (e *RateLimitExceededError) Error() string {
+	return fmt.Sprintf("%s rate limit exceeded, retry after %s", e.Scope, e.RetryAfter)
+}
+
+// RateLimiter enforces token-bucket limits for executing plugin commands per user, channel and plugin.
+// It is safe for concurrent use, as it's shared between all executors.
+type RateLimiter struct {
+	cfg config.RateLimits
+	now func() time.Time
+
+	mu       sync.Mutex
+	users    map[string]*rate.Limiter
+	channels map[string]*rate.Limiter
+	plugins  map[string]*rate.Limiter
+}
+
+// NewRateLimiter returns a new RateLimiter instance.
+func NewRateLimiter(cfg config.RateLimits) *RateLimiter {
+	return &RateLimiter{
+		cfg:      cfg,
+		now:      time.Now,
+		users:    map[string]*rate.Limiter{},
+		channels: map[string]*rate.Limiter{},
+		plugins:  map[string]*rate.Limiter{},
+	}
+}
+
+// Allow consumes a token from all buckets matching a given command. Tokens are consumed only if all buckets allow the command,
+// otherwise RateLimitExceededError for the limit with the longest retry-after time is returned.
+func (r *RateLimiter) Allow(pluginName string, cmdCtx CommandContext) error {
+	if r == nil {
+		return nil
+	}
+
+	type bucket struct {
+		scope   RateLimitScope
+		limiter *rate.Limiter
+	}
+
+	now := r.now()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var buckets []bucket
+	if r.cfg.User.IsEnabled() {
+		key := fmt.Sprintf("%s/%s", cmdCtx.Platform, valueOrDefault(cmdCtx.User.ID, cmdCtx.User.DisplayName))
+		buckets = append(buckets, bucket{scope: UserRateLimitScope, limiter: r.limiterFor(r.users, key, r.cfg.User, now)})
+	}
+	if r.cfg.Channel.IsEnabled() {
+		key := fmt.Sprintf("%s/%s", cmdCtx.Platform, cmdCtx.Conversation.ID)
+		buckets = append(buckets, bucket{scope: ChannelRateLimitScope, limiter: r.limiterFor(r.channels, key, r.cfg.Channel, now)})
+	}
+	if limit, found := r.cfg.Plugins[pluginName]; found && limit.IsEnabled() {
+		buckets = append(buckets, bucket{scope: PluginRateLimitScope, limiter: r.limiterFor(r.plugins, pluginName, limit, now)})
+	}
+
+	var (
+		reservations []*rate.Reservation
+		exceeded     *RateLimitExceededError
+	)
+	for _, b := range buckets {
+		res := b.limiter.ReserveN(now, 1)
+		reservations = append(reservations, res)
+
+		delay := res.DelayFrom(now)
+		if !res.OK() || delay > 0 {
+			if exceeded == nil || delay > exceeded.RetryAfter {
+				exceeded = &RateLimitExceededError{Scope: b.scope, RetryAfter: delay}
+			}
+		}
+	}
+
+	if exceeded == nil {
+		return nil
+	}
+
+	// give back the tokens, as the command is not executed
+	for _, res := range reservations {
+		res.CancelAt(now)
+	}
+	rateLimitedCommandsTotal.WithLabelValues(string(exceeded.Scope), pluginName).Inc()
+	return exceeded
+}
+
+// limiterFor returns the bucket for a given key. It must be called with the lock held.
+func (r *RateLimiter) limiterFor(limiters map[string]*rate.Limiter, key string, cfg config.RateLimit, now time.Time) *rate.Limiter {
+	if limiter, found := limiters[key]; found {
+		return limiter
+	}
+
+	if len(limiters) >= maxTrackedRateLimitKeys {
+		// full buckets behave the same as new ones, so they can be safely removed
+		for k, limiter := range limiters {
+			if limiter.TokensAt(now) >= float64(limiter.Burst()) {
+				delete(limiters, k)
+			}
+		}
+	}
+
+	burst := cfg.Burst
+	if burst <= 0 {
+		burst = cfg.Requests
+	}
+	limiter := rate.NewLimiter(rate.Every(cfg.Interval/time.Duration(cfg.Requests)), burst)
+	limiters[key] = limiter
+	return limiter
+}
+
+func rateLimitExceededMessage(err *RateLimitExceededError, cmdCtx CommandContext) interactive.CoreMessage {
+	var limitName string
+	switch err.Scope {
+	case UserRateLimitScope:
+		limitName = "limit of commands per user"
+	case ChannelRateLimitScope:
+		limitName = "limit of commands per channel"
+	default:
+		limitName = "limit of commands for this plugin"
+	}
+
+	retryAfter := time.Duration(math.Ceil(err.RetryAfter.Seconds())) * time.Second
+	btnBuilder := api.NewMessageButtonBuilder()
+	return interactive.CoreMessage{
+		Description: header(cmdCtx),
+		Message: api.Message{
+			Sections: []api.Section{
+				{
+					Base: api.Base{
+						Header:      "Rate limit exceeded",
+						Description: fmt.Sprintf(rateLimitExceededMsgFmt, limitName, retryAfter),
+					},
+					Buttons: []api.Button{
+						btnBuilder.ForCommandWithoutDesc("Retry", cmdCtx.CleanCmd),
+					},
+				},
+			},
+		},
+	}
+}
+
+func valueOrDefault(in, def string) string {
+	if in == "" {
+		return def
+	}
+	return in
+}
@@ -0,0 +1,96 @@
+package execute
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+func TestRateLimiterUserLimit(t *testing.T) {
+	// given
+	now := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+	limiter := NewRateLimiter(config.RateLimits{
+		User: config.RateLimit{Requests: 2, Interval: time.Minute},
+	})
+	limiter.now = func() time.Time { return now }
+
+	joe := fixApprovalCmdCtx(UserInput{ID: "U0"}, "kubectl", "get", "pods")
+	jane := fixApprovalCmdCtx(UserInput{ID: "U1"}, "kubectl", "get", "pods")
+
+	// when
+	require.NoError(t, limiter.Allow("botkube/kubectl", joe))
+	require.NoError(t, limiter.Allow("botkube/kubectl", joe))
+	err := limiter.Allow("botkube/kubectl", joe)
+
+	// then
+	var limitErr *RateLimitExceededError
+	require.ErrorAs(t, err, &limitErr)
+	assert.Equal(t, UserRateLimitScope, limitErr.Scope)
+	assert.Equal(t, 30*time.Second, limitErr.RetryAfter)
+
+	// other users are not affected
+	assert.NoError(t, limiter.Allow("botkube/kubectl", jane))
+
+	// when
+	now = now.Add(30 * time.Second)
+
+	// then
+	assert.NoError(t, limiter.Allow("botkube/kubectl", joe))
+}
+
+func TestRateLimiterDoesNotConsumeTokensWhenRejected(t *testing.T) {
+	// given
+	now := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+	limiter := NewRateLimiter(config.RateLimits{
+		User:    config.RateLimit{Requests: 2, Interval: time.Minute},
+		Plugins: map[string]config.RateLimit{"botkube/helm": {Requests: 1, Interval: time.Hour}},
+	})
+	limiter.now = func() time.Time { return now }
+	cmdCtx := fixApprovalCmdCtx(UserInput{ID: "U0"}, "helm", "list")
+
+	// when
+	require.NoError(t, limiter.Allow("botkube/helm", cmdCtx))
+	err := limiter.Allow("botkube/helm", cmdCtx)
+
+	// then
+	var limitErr *RateLimitExceededError
+	require.ErrorAs(t, err, &limitErr)
+	assert.Equal(t, PluginRateLimitScope, limitErr.Scope)
+	assert.Equal(t, time.Hour, limitErr.RetryAfter)
+
+	// the user bucket still has one token left
+	assert.NoError(t, limiter.Allow("botkube/kubectl", cmdCtx))
+}
+
+func TestRateLimiterDisabled(t *testing.T) {
+	// given
+	limiter := NewRateLimiter(config.RateLimits{})
+	cmdCtx := fixApprovalCmdCtx(UserInput{ID: "U0"}, "kubectl", "get", "pods")
+
+	// when
+	for i := 0; i < 100; i++ {
+		// then
+		require.NoError(t, limiter.Allow("botkube/kubectl", cmdCtx))
+	}
+}
+
+func TestRateLimitExceededMessage(t *testing.T) {
+	// given
+	cmdCtx := fixApprovalCmdCtx(UserInput{ID: "U0"}, "kubectl", "get", "pods")
+	err := &RateLimitExceededError{Scope: ChannelRateLimitScope, RetryAfter: 1500 * time.Millisecond}
+
+	// when
+	msg := rateLimitExceededMessage(err, cmdCtx)
+
+	// then
+	require.Len(t, msg.Sections, 1)
+	assert.Equal(t, "You have reached the limit of commands per channel. Try again in 2s.", msg.Sections[0].Description)
+	assert.Equal(t, api.Buttons{
+		{Name: "Retry", Command: "{{BotName}} kubectl get pods", Style: api.ButtonStyleDefault},
+	}, msg.Sections[0].Buttons)
+}
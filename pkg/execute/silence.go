@@ -0,0 +1,177 @@
+package execute
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/pflag"
+
+	"github.com/kubeshop/botkube/internal/silence"
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/execute/command"
+)
+
+var _ CommandExecutor = &SilenceExecutor{}
+
+const (
+	silenceCreatedMsgFmt     = "Notifications%s are silenced until %s. Silence ID: %s"
+	silenceDeletedMsgFmt     = "Silence %s was deleted."
+	noActiveSilencesMsg      = "There are no active silences."
+	silenceCreateUsageMsgFmt = "Usage: %s create silence --for <duration> [--source <name>] [--namespace <name>] [--reason <text>]"
+)
+
+var silenceFeatureName = FeatureName{
+	Name:    "silence",
+	Aliases: []string{"silences"},
+}
+
+// SilenceStore manages notification silences.
+type SilenceStore interface {
+	Create(ctx context.Context, in config.Silence) (config.Silence, error)
+	Delete(ctx context.Context, id string) error
+	List() []config.Silence
+}
+
+// SilenceExecutor executes all commands that are related to notification silences.
+type SilenceExecutor struct {
+	log   logrus.FieldLogger
+	cfg   config.Config
+	store SilenceStore
+	now   func() time.Time
+}
+
+// NewSilenceExecutor returns a new SilenceExecutor instance.
+func NewSilenceExecutor(log logrus.FieldLogger, cfg config.Config, store SilenceStore) *SilenceExecutor {
+	return &SilenceExecutor{
+		log:   log,
+		cfg:   cfg,
+		store: store,
+		now:   time.Now,
+	}
+}
+
+// Commands returns slice of commands the executor supports.
+func (e *SilenceExecutor) Commands() map[command.Verb]CommandFn {
+	return map[command.Verb]CommandFn{
+		command.CreateVerb: e.Create,
+		command.ListVerb:   e.List,
+		command.DeleteVerb: e.Delete,
+	}
+}
+
+// FeatureName returns the name and aliases of the feature provided by this executor.
+func (e *SilenceExecutor) FeatureName() FeatureName {
+	return silenceFeatureName
+}
+
+// Create creates a new notification silence.
+func (e *SilenceExecutor) Create(ctx context.Context, cmdCtx CommandContext) (interactive.CoreMessage, error) {
+	var (
+		sourceName, namespace, reason string
+		duration                      time.Duration
+	)
+	f := pflag.NewFlagSet("silence-create", pflag.ContinueOnError)
+	f.StringVar(&sourceName, "source", "", "Source binding name")
+	f.StringVar(&namespace, "namespace", "", "Namespace name")
+	f.StringVar(&reason, "reason", "", "Reason for the silence")
+	f.DurationVar(&duration, "for", 0, "Silence duration")
+	if err := f.Parse(cmdCtx.Args[2:]); err != nil {
+		return interactive.CoreMessage{}, NewExecutionCommandError("while parsing flags: %s\n\n%s", err.Error(), fmt.Sprintf(silenceCreateUsageMsgFmt, api.MessageBotNamePlaceholder))
+	}
+
+	if duration <= 0 {
+		return interactive.CoreMessage{}, NewExecutionCommandError("The --for flag is required and must be a positive duration, e.g. --for 2h")
+	}
+	if sourceName != "" {
+		if _, found := e.cfg.Sources[sourceName]; !found {
+			return interactive.CoreMessage{}, NewExecutionCommandError("Source %q is not defined in the configuration", sourceName)
+		}
+	}
+
+	e.log.WithFields(logrus.Fields{
+		"source":    sourceName,
+		"namespace": namespace,
+		"duration":  duration,
+	}).Info("Creating silence...")
+
+	created, err := e.store.Create(ctx, config.Silence{
+		Source:    sourceName,
+		Namespace: namespace,
+		Reason:    reason,
+		CreatedBy: cmdCtx.User.DisplayName,
+		ExpiresAt: e.now().Add(duration),
+	})
+	if err != nil {
+		return interactive.CoreMessage{}, fmt.Errorf("while creating silence: %w", err)
+	}
+
+	msg := fmt.Sprintf(silenceCreatedMsgFmt, silenceScope(created), created.ExpiresAt.Format(time.RFC1123), created.ID)
+	return respond(msg, cmdCtx), nil
+}
+
+// List returns a tabular representation of active silences.
+func (e *SilenceExecutor) List(_ context.Context, cmdCtx CommandContext) (interactive.CoreMessage, error) {
+	e.log.Debug("Listing silences...")
+	silences := e.store.List()
+	if len(silences) == 0 {
+		return respond(noActiveSilencesMsg, cmdCtx), nil
+	}
+
+	buf := new(bytes.Buffer)
+	w := tabwriter.NewWriter(buf, 5, 0, 1, ' ', 0)
+	fmt.Fprintf(w, "ID\tSOURCE\tNAMESPACE\tEXPIRES\tCREATED BY\tREASON")
+	for _, s := range silences {
+		fmt.Fprintf(w, "\n%s\t%s\t%s\t%s\t%s\t%s", s.ID, valueOrAll(s.Source), valueOrAll(s.Namespace), s.ExpiresAt.Format(time.RFC1123), s.CreatedBy, s.Reason)
+	}
+	w.Flush()
+	return respond(buf.String(), cmdCtx), nil
+}
+
+// Delete deletes a given silence.
+func (e *SilenceExecutor) Delete(ctx context.Context, cmdCtx CommandContext) (interactive.CoreMessage, error) {
+	if len(cmdCtx.Args) < 3 {
+		return interactive.CoreMessage{}, NewExecutionCommandError("Silence ID is required, e.g. %s delete silence <id>", api.MessageBotNamePlaceholder)
+	}
+
+	id := cmdCtx.Args[2]
+	e.log.WithField("id", id).Info("Deleting silence...")
+	err := e.store.Delete(ctx, id)
+	switch {
+	case err == nil:
+	case errors.Is(err, silence.ErrNotFound):
+		return interactive.CoreMessage{}, NewExecutionCommandError("Active silence %q not found", id)
+	default:
+		return interactive.CoreMessage{}, fmt.Errorf("while deleting silence: %w", err)
+	}
+
+	return respond(fmt.Sprintf(silenceDeletedMsgFmt, id), cmdCtx), nil
+}
+
+func silenceScope(s config.Silence) string {
+	var scope []string
+	if s.Source != "" {
+		scope = append(scope, fmt.Sprintf("source %q", s.Source))
+	}
+	if s.Namespace != "" {
+		scope = append(scope, fmt.Sprintf("namespace %q", s.Namespace))
+	}
+	if len(scope) == 0 {
+		return ""
+	}
+	return fmt.Sprintf(" for %s", strings.Join(scope, " and "))
+}
+
+func valueOrAll(in string) string {
+	if in == "" {
+		return "*"
+	}
+	return in
+}
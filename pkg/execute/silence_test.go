@@ -0,0 +1,142 @@
+package execute
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/internal/silence"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/execute/command"
+)
+
+func TestSilenceExecutor(t *testing.T) {
+	// given
+	ctx := context.Background()
+	now := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+	store := &fakeSilenceStore{}
+	cfg := config.Config{
+		Sources: map[string]config.Sources{
+			"k8s-err-events": {},
+		},
+	}
+
+	executor := NewSilenceExecutor(loggerx.NewNoop(), cfg, store)
+	executor.now = func() time.Time { return now }
+
+	// when
+	msg, err := executor.Create(ctx, fixSilenceCmdCtx("create", "silence", "--source", "k8s-err-events", "--namespace", "payments", "--for", "2h", "--reason", "db migration"))
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, `Notifications for source "k8s-err-events" and namespace "payments" are silenced until Sun, 01 Jan 2023 12:00:00 UTC. Silence ID: abc123`, msg.BaseBody.CodeBlock)
+	assert.Equal(t, []config.Silence{
+		{
+			ID:        "abc123",
+			Source:    "k8s-err-events",
+			Namespace: "payments",
+			Reason:    "db migration",
+			CreatedBy: "Joe",
+			ExpiresAt: now.Add(2 * time.Hour),
+		},
+	}, store.silences)
+
+	// when
+	msg, err = executor.List(ctx, fixSilenceCmdCtx("list", "silences"))
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, heredoc.Doc(`
+		ID     SOURCE         NAMESPACE EXPIRES                       CREATED BY REASON
+		abc123 k8s-err-events payments  Sun, 01 Jan 2023 12:00:00 UTC Joe        db migration`), msg.BaseBody.CodeBlock)
+
+	// when
+	msg, err = executor.Delete(ctx, fixSilenceCmdCtx("delete", "silence", "abc123"))
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "Silence abc123 was deleted.", msg.BaseBody.CodeBlock)
+	assert.Empty(t, store.silences)
+}
+
+func TestSilenceExecutorErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		args   []string
+		expErr string
+	}{
+		{
+			name:   "Missing duration",
+			args:   []string{"create", "silence", "--namespace", "payments"},
+			expErr: "The --for flag is required and must be a positive duration, e.g. --for 2h",
+		},
+		{
+			name:   "Unknown source",
+			args:   []string{"create", "silence", "--source", "unknown", "--for", "1h"},
+			expErr: `Source "unknown" is not defined in the configuration`,
+		},
+		{
+			name:   "Missing ID",
+			args:   []string{"delete", "silence"},
+			expErr: "Silence ID is required, e.g. {{BotName}} delete silence <id>",
+		},
+		{
+			name:   "Not found",
+			args:   []string{"delete", "silence", "unknown"},
+			expErr: `Active silence "unknown" not found`,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			executor := NewSilenceExecutor(loggerx.NewNoop(), config.Config{}, &fakeSilenceStore{})
+
+			// when
+			cmdFn := executor.Commands()[command.Verb(tc.args[0])]
+			_, err := cmdFn(context.Background(), fixSilenceCmdCtx(tc.args...))
+
+			// then
+			require.Error(t, err)
+			assert.True(t, IsExecutionCommandError(err))
+			assert.EqualError(t, err, tc.expErr)
+		})
+	}
+}
+
+type fakeSilenceStore struct {
+	silences []config.Silence
+}
+
+func (f *fakeSilenceStore) Create(_ context.Context, in config.Silence) (config.Silence, error) {
+	in.ID = "abc123"
+	f.silences = append(f.silences, in)
+	return in, nil
+}
+
+func (f *fakeSilenceStore) Delete(_ context.Context, id string) error {
+	for idx, s := range f.silences {
+		if s.ID == id {
+			f.silences = append(f.silences[:idx], f.silences[idx+1:]...)
+			return nil
+		}
+	}
+	return silence.ErrNotFound
+}
+
+func (f *fakeSilenceStore) List() []config.Silence {
+	return f.silences
+}
+
+func fixSilenceCmdCtx(args ...string) CommandContext {
+	return CommandContext{
+		Args:           args,
+		ClusterName:    clusterName,
+		User:           UserInput{DisplayName: "Joe"},
+		ExecutorFilter: newExecutorTextFilter(""),
+	}
+}
@@ -0,0 +1,374 @@
+package execute
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/status"
+
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/api/executor"
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/execute/command"
+)
+
+var _ CommandExecutor = &StreamExecutor{}
+
+const (
+	// streamIDLength is the length of generated stream IDs. They are short, so they can be easily typed in chat.
+	streamIDLength = 8
+
+	// maxLiveOutputSize is the max number of the latest output bytes shown in the live message. It fits the limits of all platforms.
+	maxLiveOutputSize = 1500
+
+	streamRunningMsgFmt   = "Streaming output... Stop it with `%s stop stream %s`."
+	streamFinishedMsgFmt  = "Finished after %s."
+	streamStoppedMsgFmt   = "Stopped by %s after %s."
+	streamTimeoutMsgFmt   = "Stopped after reaching the max duration of %s."
+	streamFailedMsgFmt    = "Failed after %s: %s"
+	streamTruncatedMsg    = "The live output is truncated. The full output is posted below."
+	streamNotFoundMsgFmt  = "Stream %q not found. The command has already finished."
+	streamStopUsageMsgFmt = "Usage:\n  %s stop stream <id>"
+)
+
+var streamFeatureName = FeatureName{Name: "stream"}
+
+// MessageStreamer posts messages with the output of commands streamed by executor plugins.
+// Bots create it for each incoming message, so the messages are posted in the same conversation or thread.
+// If a platform doesn't support editing messages, the output is posted in chunks.
+type MessageStreamer interface {
+	// PostStreamMessage posts a new message and returns its ID.
+	PostStreamMessage(ctx context.Context, msg interactive.CoreMessage) (string, error)
+}
+
+// EditableMessageStreamer is implemented by streamers of platforms which support editing messages.
+// The live output is then shown in a single message, which is updated periodically.
+type EditableMessageStreamer interface {
+	MessageStreamer
+	// UpdateStreamMessage replaces the content of a message with a given ID.
+	UpdateStreamMessage(ctx context.Context, msgID string, msg interactive.CoreMessage) error
+}
+
+type runningStream struct {
+	conversationID string
+	stop           func(stoppedBy string)
+}
+
+// StreamExecutor runs commands whose output is streamed by executor plugins, and stops them on user request.
+type StreamExecutor struct {
+	log logrus.FieldLogger
+	cfg config.Streaming
+
+	mu      sync.Mutex
+	running map[string]runningStream
+}
+
+// NewStreamExecutor returns a new StreamExecutor instance.
+func NewStreamExecutor(log logrus.FieldLogger, cfg config.Streaming) *StreamExecutor {
+	return &StreamExecutor{
+		log:     log,
+		cfg:     cfg,
+		running: map[string]runningStream{},
+	}
+}
+
+// Commands returns slice of commands the executor supports.
+func (e *StreamExecutor) Commands() map[command.Verb]CommandFn {
+	return map[command.Verb]CommandFn{
+		command.StopVerb: e.Stop,
+	}
+}
+
+// FeatureName returns the name and aliases of the feature provided by this executor.
+func (e *StreamExecutor) FeatureName() FeatureName {
+	return streamFeatureName
+}
+
+// Stop stops a running stream. Only users from the conversation in which the command was run can stop it.
+func (e *StreamExecutor) Stop(_ context.Context, cmdCtx CommandContext) (interactive.CoreMessage, error) {
+	if len(cmdCtx.Args) != 3 {
+		return interactive.CoreMessage{}, NewExecutionCommandError(streamStopUsageMsgFmt, api.MessageBotNamePlaceholder)
+	}
+	id := cmdCtx.Args[2]
+
+	e.mu.Lock()
+	stream, found := e.running[id]
+	e.mu.Unlock()
+	if !found || stream.conversationID != cmdCtx.Conversation.ID {
+		return interactive.CoreMessage{}, NewExecutionCommandError(streamNotFoundMsgFmt, id)
+	}
+
+	e.log.WithField("id", id).Info("Stopping stream on user request...")
+	stream.stop(userName(cmdCtx.User))
+	return respond(fmt.Sprintf("Stopping stream %q...", id), cmdCtx), nil
+}
+
+// Run executes a given command and sends its live output with the streamer from the command context. It blocks until the command
+// finishes, it's stopped by a user, or it exceeds the max duration. Commands which finish before the first update are answered
+// only with the returned message, the same as non-streamed commands. The output filter and conversion are applied to every chunk,
+// so the returned message must not be processed again.
+func (e *StreamExecutor) Run(ctx context.Context, cli executor.StreamExecutor, in executor.ExecuteInput, cmdCtx CommandContext) (api.Message, error) {
+	id := uuid.New().String()[:streamIDLength]
+	started := time.Now()
+
+	streamCtx, cancel := context.WithTimeout(ctx, e.cfg.GetMaxDuration())
+	defer cancel()
+
+	var (
+		stopMu    sync.Mutex
+		stoppedBy string
+	)
+	e.register(id, runningStream{
+		conversationID: cmdCtx.Conversation.ID,
+		stop: func(user string) {
+			stopMu.Lock()
+			stoppedBy = user
+			stopMu.Unlock()
+			cancel()
+		},
+	})
+	defer e.unregister(id)
+
+	outputs := make(chan api.Message)
+	done := make(chan error, 1)
+	go func() {
+		done <- cli.ExecuteStream(streamCtx, in, func(out executor.ExecuteOutput) error {
+			select {
+			case outputs <- out.Message:
+				return nil
+			case <-streamCtx.Done():
+				return streamCtx.Err()
+			}
+		})
+	}()
+
+	live := newLiveOutput(id, cmdCtx)
+	ticker := time.NewTicker(e.cfg.GetUpdateInterval())
+	defer ticker.Stop()
+
+	var processErr error
+	for {
+		select {
+		case msg := <-outputs:
+			if processErr != nil {
+				continue
+			}
+			msg, processErr = processStreamChunk(ctx, msg, cmdCtx)
+			if processErr != nil {
+				// the stream is stopped, and the error is reported once the plugin returns
+				cancel()
+				continue
+			}
+			live.Append(msg)
+		case <-ticker.C:
+			// the parent context is used, so the live message can be still updated once the stream is stopped
+			if err := live.Flush(ctx, started); err != nil {
+				e.log.WithField("id", id).Errorf("while sending live output: %s", err.Error())
+			}
+		case err := <-done:
+			if processErr != nil {
+				err = processErr
+			}
+			stopMu.Lock()
+			user := stoppedBy
+			stopMu.Unlock()
+
+			elapsed := time.Since(started).Round(time.Second)
+			var footer string
+			switch {
+			case user != "":
+				footer = fmt.Sprintf(streamStoppedMsgFmt, user, elapsed)
+			case processErr == nil && errors.Is(streamCtx.Err(), context.DeadlineExceeded):
+				footer = fmt.Sprintf(streamTimeoutMsgFmt, e.cfg.GetMaxDuration())
+			case err != nil && !live.IsStarted():
+				return api.Message{}, NewExecutionCommandError(status.Convert(err).Message())
+			case err != nil:
+				footer = fmt.Sprintf(streamFailedMsgFmt, elapsed, status.Convert(err).Message())
+			default:
+				footer = fmt.Sprintf(streamFinishedMsgFmt, elapsed)
+			}
+
+			return live.Finish(ctx, footer)
+		}
+	}
+}
+
+// processStreamChunk applies the output conversion and filter to a single chunk of the streamed output.
+func processStreamChunk(ctx context.Context, msg api.Message, cmdCtx CommandContext) (api.Message, error) {
+	msg, err := cmdCtx.OutputConversion.Apply(ctx, msg)
+	if err != nil {
+		return api.Message{}, err
+	}
+	if cmdCtx.ExecutorFilter == nil || !cmdCtx.ExecutorFilter.IsActive() {
+		return msg, nil
+	}
+	msg.BaseBody.CodeBlock = cmdCtx.ExecutorFilter.Apply(msg.BaseBody.CodeBlock)
+	msg.BaseBody.Plaintext = cmdCtx.ExecutorFilter.Apply(msg.BaseBody.Plaintext)
+	return msg, nil
+}
+
+func (e *StreamExecutor) register(id string, stream runningStream) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.running[id] = stream
+}
+
+func (e *StreamExecutor) unregister(id string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	delete(e.running, id)
+}
+
+// liveOutput accumulates streamed messages and sends them with a MessageStreamer.
+// Code blocks and plaintext bodies of all messages are concatenated.
+type liveOutput struct {
+	id       string
+	cmdCtx   CommandContext
+	streamer MessageStreamer
+
+	msgs      []api.Message
+	body      strings.Builder
+	sent      int
+	msgID     string
+	started   bool
+	truncated bool
+}
+
+func newLiveOutput(id string, cmdCtx CommandContext) *liveOutput {
+	return &liveOutput{
+		id:       id,
+		cmdCtx:   cmdCtx,
+		streamer: cmdCtx.MessageStreamer,
+	}
+}
+
+// Append adds a given message to the output.
+func (o *liveOutput) Append(msg api.Message) {
+	o.msgs = append(o.msgs, msg)
+
+	text := msg.BaseBody.CodeBlock
+	if text == "" {
+		text = msg.BaseBody.Plaintext
+	}
+	if text == "" {
+		return
+	}
+	if o.body.Len() > 0 && !strings.HasSuffix(o.body.String(), "\n") {
+		o.body.WriteString("\n")
+	}
+	o.body.WriteString(text)
+}
+
+// IsStarted returns true if any output was already sent.
+func (o *liveOutput) IsStarted() bool {
+	return o.started
+}
+
+// Flush sends the output received since the previous flush. Editable messages are replaced with the latest output,
+// otherwise only the new output is posted. The live output starts only once the second chunk arrives,
+// so slow commands which respond with a single message are answered in the same way as non-streamed commands.
+func (o *liveOutput) Flush(ctx context.Context, started time.Time) error {
+	if o.body.Len() == o.sent || (!o.started && len(o.msgs) < 2) {
+		return nil
+	}
+
+	running := fmt.Sprintf(streamRunningMsgFmt, api.MessageBotNamePlaceholder, o.id)
+	pending := o.pending()
+	isFirst := !o.started
+	o.sent = o.body.Len()
+	o.started = true
+
+	editable, ok := o.streamer.(EditableMessageStreamer)
+	switch {
+	case !ok:
+		// only the first chunk has the stop button, so it's not repeated in every message
+		_, err := o.streamer.PostStreamMessage(ctx, o.message(pending, running, isFirst))
+		return err
+	case isFirst:
+		id, err := o.streamer.PostStreamMessage(ctx, o.message(o.tail(), running, true))
+		o.msgID = id
+		return err
+	default:
+		footer := fmt.Sprintf("%s Running for %s.", running, time.Since(started).Round(time.Second))
+		return editable.UpdateStreamMessage(ctx, o.msgID, o.message(o.tail(), footer, true))
+	}
+}
+
+// Finish returns the final message. If the output was already sent, the live message is updated and the returned message
+// contains only the output which wasn't shown yet.
+func (o *liveOutput) Finish(ctx context.Context, footer string) (api.Message, error) {
+	if !o.started {
+		if len(o.msgs) == 1 {
+			return o.msgs[0], nil
+		}
+		return api.Message{
+			BaseBody: api.Body{
+				CodeBlock: o.body.String(),
+			},
+		}, nil
+	}
+
+	editable, ok := o.streamer.(EditableMessageStreamer)
+	if !ok {
+		return o.message(o.pending(), footer, false).Message, nil
+	}
+
+	if err := editable.UpdateStreamMessage(ctx, o.msgID, o.message(o.tail(), footer, false)); err != nil {
+		return api.Message{}, fmt.Errorf("while updating live message: %w", err)
+	}
+
+	if !o.truncated {
+		return api.Message{
+			BaseBody: api.Body{
+				Plaintext: footer,
+			},
+		}, nil
+	}
+	return o.message(o.body.String(), fmt.Sprintf("%s %s", footer, streamTruncatedMsg), false).Message, nil
+}
+
+func (o *liveOutput) pending() string {
+	// skip the line break which separates already sent output
+	return strings.TrimPrefix(o.body.String()[o.sent:], "\n")
+}
+
+func (o *liveOutput) tail() string {
+	out := o.body.String()
+	if len(out) <= maxLiveOutputSize {
+		return out
+	}
+	o.truncated = true
+	return "..." + out[len(out)-maxLiveOutputSize:]
+}
+
+func (o *liveOutput) message(body, footer string, withStopBtn bool) interactive.CoreMessage {
+	section := api.Section{
+		Context: api.ContextItems{
+			{Text: footer},
+		},
+	}
+	if withStopBtn {
+		btnBuilder := api.NewMessageButtonBuilder()
+		section.Buttons = api.Buttons{
+			btnBuilder.ForCommandWithoutDesc("Stop", fmt.Sprintf("stop stream %s", o.id), api.ButtonStyleDanger),
+		}
+	}
+
+	return interactive.CoreMessage{
+		Description: header(o.cmdCtx),
+		Message: api.Message{
+			// the body is empty if the whole output was already posted
+			BaseBody: api.Body{
+				CodeBlock: body,
+			},
+			Sections: []api.Section{section},
+		},
+	}
+}
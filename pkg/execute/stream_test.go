@@ -0,0 +1,352 @@
+package execute
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/api"
+	"github.com/kubeshop/botkube/pkg/api/executor"
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+func TestStreamExecutorRunFastCommand(t *testing.T) {
+	// given
+	streamer := &fakeMessageStreamer{}
+	cmdCtx := fixStreamCmdCtx(streamer)
+	streams := NewStreamExecutor(loggerx.NewNoop(), config.Streaming{UpdateInterval: time.Hour})
+	expMsg := api.NewCodeBlockMessage("NAME   READY\nnginx  1/1", true)
+
+	cli := fakeStreamPlugin(func(_ context.Context, send executor.SendOutputFn) error {
+		return send(executor.ExecuteOutput{Message: expMsg})
+	})
+
+	// when
+	msg, err := streams.Run(context.Background(), cli, executor.ExecuteInput{}, cmdCtx)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, expMsg, msg)
+	assert.Empty(t, streamer.posted())
+}
+
+func TestStreamExecutorRunEditableMessage(t *testing.T) {
+	// given
+	streamer := &fakeMessageStreamer{notify: make(chan struct{}, 10)}
+	cmdCtx := fixStreamCmdCtx(streamer)
+	streams := NewStreamExecutor(loggerx.NewNoop(), config.Streaming{UpdateInterval: 5 * time.Millisecond})
+
+	cli := fakeStreamPlugin(func(_ context.Context, send executor.SendOutputFn) error {
+		// the live output starts once the second chunk arrives
+		for _, chunk := range [][]string{{"line 1", "line 2"}, {"line 3"}} {
+			for _, line := range chunk {
+				if err := send(executor.ExecuteOutput{Message: api.NewCodeBlockMessage(line, false)}); err != nil {
+					return err
+				}
+			}
+			// wait until the output is sent, so each chunk is sent separately
+			<-streamer.notify
+		}
+		return nil
+	})
+
+	// when
+	msg, err := streams.Run(context.Background(), cli, executor.ExecuteInput{}, cmdCtx)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "Finished after 0s.", msg.BaseBody.Plaintext)
+
+	posted := streamer.posted()
+	require.Len(t, posted, 1)
+	assert.Equal(t, "line 1\nline 2", posted[0].BaseBody.CodeBlock)
+	require.Len(t, posted[0].Sections, 1)
+	require.Len(t, posted[0].Sections[0].Buttons, 1)
+	assert.Equal(t, "Stop", posted[0].Sections[0].Buttons[0].Name)
+
+	updated := streamer.updated()
+	require.Len(t, updated, 2)
+	assert.Equal(t, "line 1\nline 2\nline 3", updated[0].BaseBody.CodeBlock)
+	// the final update has no stop button
+	assert.Equal(t, "line 1\nline 2\nline 3", updated[1].BaseBody.CodeBlock)
+	assert.Empty(t, updated[1].Sections[0].Buttons)
+	assert.Equal(t, "Finished after 0s.", updated[1].Sections[0].Context[0].Text)
+}
+
+func TestStreamExecutorRunChunkedMessages(t *testing.T) {
+	// given
+	streamer := &fakeMessageStreamer{notify: make(chan struct{}, 10)}
+	cmdCtx := fixStreamCmdCtx(postOnlyMessageStreamer{streamer})
+	streams := NewStreamExecutor(loggerx.NewNoop(), config.Streaming{UpdateInterval: 5 * time.Millisecond})
+
+	cli := fakeStreamPlugin(func(_ context.Context, send executor.SendOutputFn) error {
+		for _, chunk := range [][]string{{"line 1", "line 2"}, {"line 3"}} {
+			for _, line := range chunk {
+				if err := send(executor.ExecuteOutput{Message: api.NewCodeBlockMessage(line, false)}); err != nil {
+					return err
+				}
+			}
+			<-streamer.notify
+		}
+		return nil
+	})
+
+	// when
+	msg, err := streams.Run(context.Background(), cli, executor.ExecuteInput{}, cmdCtx)
+
+	// then
+	require.NoError(t, err)
+	posted := streamer.posted()
+	require.Len(t, posted, 2)
+	assert.Equal(t, "line 1\nline 2", posted[0].BaseBody.CodeBlock)
+	assert.Len(t, posted[0].Sections[0].Buttons, 1)
+	assert.Equal(t, "line 3", posted[1].BaseBody.CodeBlock)
+	assert.Empty(t, posted[1].Sections[0].Buttons)
+
+	// the whole output was already posted
+	assert.Empty(t, msg.BaseBody.CodeBlock)
+	assert.Equal(t, "Finished after 0s.", msg.Sections[0].Context[0].Text)
+}
+
+func TestStreamExecutorStop(t *testing.T) {
+	// given
+	streamer := &fakeMessageStreamer{notify: make(chan struct{}, 10)}
+	cmdCtx := fixStreamCmdCtx(streamer)
+	streams := NewStreamExecutor(loggerx.NewNoop(), config.Streaming{UpdateInterval: 5 * time.Millisecond})
+
+	cli := fakeStreamPlugin(func(ctx context.Context, send executor.SendOutputFn) error {
+		for _, line := range []string{"following logs...", "line 1"} {
+			if err := send(executor.ExecuteOutput{Message: api.NewCodeBlockMessage(line, false)}); err != nil {
+				return err
+			}
+		}
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	type result struct {
+		msg api.Message
+		err error
+	}
+	resCh := make(chan result, 1)
+	go func() {
+		msg, err := streams.Run(context.Background(), cli, executor.ExecuteInput{}, cmdCtx)
+		resCh <- result{msg: msg, err: err}
+	}()
+	<-streamer.notify
+	id := onlyRunningStreamID(t, streams)
+
+	// when stopped from another conversation
+	otherCmdCtx := fixStreamCmdCtx(nil, "stop", "stream", id)
+	otherCmdCtx.Conversation.ID = "other"
+	_, err := streams.Stop(context.Background(), otherCmdCtx)
+
+	// then
+	require.Error(t, err)
+	assert.EqualError(t, err, `Stream "`+id+`" not found. The command has already finished.`)
+
+	// when
+	stopCmdCtx := fixStreamCmdCtx(nil, "stop", "stream", id)
+	stopCmdCtx.User = UserInput{Mention: "@Jane"}
+	_, err = streams.Stop(context.Background(), stopCmdCtx)
+
+	// then
+	require.NoError(t, err)
+	res := <-resCh
+	require.NoError(t, res.err)
+	assert.Equal(t, "Stopped by @Jane after 0s.", res.msg.BaseBody.Plaintext)
+	assert.Empty(t, streams.running)
+}
+
+func TestStreamExecutorMaxDuration(t *testing.T) {
+	// given
+	streamer := &fakeMessageStreamer{}
+	cmdCtx := fixStreamCmdCtx(streamer)
+	streams := NewStreamExecutor(loggerx.NewNoop(), config.Streaming{
+		MaxDuration:    20 * time.Millisecond,
+		UpdateInterval: 5 * time.Millisecond,
+	})
+
+	cli := fakeStreamPlugin(func(ctx context.Context, send executor.SendOutputFn) error {
+		for _, line := range []string{"following logs...", "line 1"} {
+			if err := send(executor.ExecuteOutput{Message: api.NewCodeBlockMessage(line, false)}); err != nil {
+				return err
+			}
+		}
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	// when
+	msg, err := streams.Run(context.Background(), cli, executor.ExecuteInput{}, cmdCtx)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "Stopped after reaching the max duration of 20ms.", msg.BaseBody.Plaintext)
+}
+
+func TestStreamExecutorRunSlowSingleResponse(t *testing.T) {
+	// given
+	streamer := &fakeMessageStreamer{}
+	cmdCtx := fixStreamCmdCtx(streamer)
+	streams := NewStreamExecutor(loggerx.NewNoop(), config.Streaming{UpdateInterval: time.Millisecond})
+	expMsg := api.NewCodeBlockMessage("NAME   READY\nnginx  1/1", true)
+
+	cli := fakeStreamPlugin(func(_ context.Context, send executor.SendOutputFn) error {
+		if err := send(executor.ExecuteOutput{Message: expMsg}); err != nil {
+			return err
+		}
+		// a few ticks pass before the command finishes
+		time.Sleep(20 * time.Millisecond)
+		return nil
+	})
+
+	// when
+	msg, err := streams.Run(context.Background(), cli, executor.ExecuteInput{}, cmdCtx)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, expMsg, msg)
+	assert.Empty(t, streamer.posted())
+}
+
+func TestStreamExecutorRunAppliesFilterAndConversionToChunks(t *testing.T) {
+	// given
+	streamer := &fakeMessageStreamer{notify: make(chan struct{}, 10)}
+	cmdCtx := fixStreamCmdCtx(postOnlyMessageStreamer{streamer})
+	cmdCtx.ExecutorFilter = newExecutorTextFilter("error")
+	cmdCtx.OutputConversion = outputConversion{JQ: ".msg"}
+	streams := NewStreamExecutor(loggerx.NewNoop(), config.Streaming{UpdateInterval: 5 * time.Millisecond})
+
+	cli := fakeStreamPlugin(func(_ context.Context, send executor.SendOutputFn) error {
+		for _, chunk := range [][]string{{`{"msg": "error: first"}`, `{"msg": "info: second"}`}, {`{"msg": "error: third"}`}} {
+			for _, line := range chunk {
+				if err := send(executor.ExecuteOutput{Message: api.NewCodeBlockMessage(line, false)}); err != nil {
+					return err
+				}
+			}
+			<-streamer.notify
+		}
+		return nil
+	})
+
+	// when
+	_, err := streams.Run(context.Background(), cli, executor.ExecuteInput{}, cmdCtx)
+
+	// then
+	require.NoError(t, err)
+	posted := streamer.posted()
+	require.Len(t, posted, 2)
+	assert.Equal(t, "error: first", posted[0].BaseBody.CodeBlock)
+	assert.Equal(t, "error: third", posted[1].BaseBody.CodeBlock)
+}
+
+func TestStreamExecutorRunConversionError(t *testing.T) {
+	// given
+	cmdCtx := fixStreamCmdCtx(&fakeMessageStreamer{})
+	cmdCtx.OutputConversion = outputConversion{JQ: ".msg"}
+	streams := NewStreamExecutor(loggerx.NewNoop(), config.Streaming{UpdateInterval: time.Hour})
+
+	cli := fakeStreamPlugin(func(ctx context.Context, send executor.SendOutputFn) error {
+		if err := send(executor.ExecuteOutput{Message: api.NewCodeBlockMessage("not a JSON", false)}); err != nil {
+			return err
+		}
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	// when
+	_, err := streams.Run(context.Background(), cli, executor.ExecuteInput{}, cmdCtx)
+
+	// then
+	require.Error(t, err)
+	assert.True(t, IsExecutionCommandError(err))
+	assert.Contains(t, err.Error(), "Cannot convert the command output")
+}
+
+func fixStreamCmdCtx(streamer MessageStreamer, args ...string) CommandContext {
+	cmdCtx := fixApprovalCmdCtx(UserInput{ID: "U0", DisplayName: "Joe"}, args...)
+	cmdCtx.Conversation = Conversation{ID: "C0"}
+	if streamer != nil {
+		cmdCtx.MessageStreamer = streamer
+	}
+	return cmdCtx
+}
+
+func onlyRunningStreamID(t *testing.T, streams *StreamExecutor) string {
+	t.Helper()
+	streams.mu.Lock()
+	defer streams.mu.Unlock()
+	require.Len(t, streams.running, 1)
+	for id := range streams.running {
+		return id
+	}
+	return ""
+}
+
+type fakeStreamPlugin func(ctx context.Context, send executor.SendOutputFn) error
+
+func (f fakeStreamPlugin) ExecuteStream(ctx context.Context, _ executor.ExecuteInput, send executor.SendOutputFn) error {
+	return f(ctx, send)
+}
+
+// postOnlyMessageStreamer simulates platforms which don't support editing messages.
+type postOnlyMessageStreamer struct {
+	f *fakeMessageStreamer
+}
+
+func (p postOnlyMessageStreamer) PostStreamMessage(ctx context.Context, msg interactive.CoreMessage) (string, error) {
+	return p.f.PostStreamMessage(ctx, msg)
+}
+
+type fakeMessageStreamer struct {
+	notify chan struct{}
+
+	mu      sync.Mutex
+	posts   []interactive.CoreMessage
+	updates []interactive.CoreMessage
+}
+
+func (f *fakeMessageStreamer) PostStreamMessage(_ context.Context, msg interactive.CoreMessage) (string, error) {
+	f.mu.Lock()
+	f.posts = append(f.posts, msg)
+	f.mu.Unlock()
+	f.notifySent()
+	return "msg-id", nil
+}
+
+func (f *fakeMessageStreamer) UpdateStreamMessage(_ context.Context, _ string, msg interactive.CoreMessage) error {
+	f.mu.Lock()
+	f.updates = append(f.updates, msg)
+	f.mu.Unlock()
+	f.notifySent()
+	return nil
+}
+
+func (f *fakeMessageStreamer) notifySent() {
+	if f.notify == nil {
+		return
+	}
+	select {
+	case f.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (f *fakeMessageStreamer) posted() []interactive.CoreMessage {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.posts
+}
+
+func (f *fakeMessageStreamer) updated() []interactive.CoreMessage {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.updates
+}
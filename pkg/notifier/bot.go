@@ -17,8 +17,9 @@ type Bot interface {
 	// TODO: Consider option per channel to turn on/off "announcements" (Botkube start/stop/upgrade, notify/config change).
 	SendMessageToAll(context.Context, interactive.CoreMessage) error
 
-	// SendMessage sends a generic message for a given source bindings.
-	SendMessage(context.Context, interactive.CoreMessage, []string) error
+	// SendMessage sends a generic message for a given source bindings to the channels selected by the routing rules.
+	// Nil receivers mean that routing doesn't apply, so all bound channels receive the message.
+	SendMessage(ctx context.Context, msg interactive.CoreMessage, sourceBindings []string, receivers *Receivers) error
 
 	// IntegrationName returns a name of a given communication platform.
 	IntegrationName() config.CommPlatformIntegration
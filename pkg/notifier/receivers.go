@@ -0,0 +1,32 @@
+package notifier
+
+import (
+	"golang.org/x/exp/slices"
+
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+// Receivers holds the channels and sinks selected by the routing rules for a given event.
+// A nil Receivers means that routing doesn't apply, so all bound channels and sinks receive the event.
+type Receivers struct {
+	Channels []string
+	Sinks    []string
+}
+
+// AllowsChannel returns true if a given channel should receive the event.
+func (r *Receivers) AllowsChannel(channel string) bool {
+	if r == nil {
+		return true
+	}
+	return slices.Contains(r.Channels, channel)
+}
+
+// AllowsSink returns true if a sink integration from a given communication group should receive the event.
+// Sinks are selected either by the integration name, which applies to all communication groups, or by the name
+// returned by config.SinkReceiverName.
+func (r *Receivers) AllowsSink(commGroupName string, sink config.CommPlatformIntegration) bool {
+	if r == nil {
+		return true
+	}
+	return slices.Contains(r.Sinks, string(sink)) || slices.Contains(r.Sinks, config.SinkReceiverName(commGroupName, sink))
+}
@@ -0,0 +1,177 @@
+package sink
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/multierror"
+)
+
+// DeadLetter describes an event which couldn't be delivered to a sink.
+type DeadLetter struct {
+	ID       string    `json:"id"`
+	Sink     string    `json:"sink"`
+	Sources  []string  `json:"sources"`
+	Data     any       `json:"data"`
+	Attempts int       `json:"attempts"`
+	Error    string    `json:"error"`
+	FailedAt time.Time `json:"failedAt"`
+}
+
+// DeadLetterStore stores events which couldn't be delivered to sinks.
+type DeadLetterStore interface {
+	Store(ctx context.Context, in DeadLetter) error
+}
+
+// NewDeadLetterStore returns a store which writes dead letters to all configured destinations.
+// It returns nil if none is configured.
+func NewDeadLetterStore(cfg config.SinkDeadLetter, k8sCli kubernetes.Interface) DeadLetterStore {
+	var stores multiDeadLetterStore
+	if cfg.FileName != "" {
+		stores = append(stores, NewFileDeadLetterStore(cfg.FileName))
+	}
+	if cfg.ConfigMap.Name != "" {
+		stores = append(stores, NewConfigMapDeadLetterStore(k8sCli, cfg.ConfigMap, cfg.MaxEntries))
+	}
+
+	if len(stores) == 0 {
+		return nil
+	}
+	return stores
+}
+
+type multiDeadLetterStore []DeadLetterStore
+
+// Store stores a given dead letter in all destinations.
+func (m multiDeadLetterStore) Store(ctx context.Context, in DeadLetter) error {
+	errs := multierror.New()
+	for _, store := range m {
+		if err := store.Store(ctx, in); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+	return errs.ErrorOrNil()
+}
+
+// FileDeadLetterStore appends dead letters to a file, one JSON object per line.
+type FileDeadLetterStore struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewFileDeadLetterStore returns a new FileDeadLetterStore instance.
+func NewFileDeadLetterStore(path string) *FileDeadLetterStore {
+	return &FileDeadLetterStore{path: path}
+}
+
+// Store appends a given dead letter to the file.
+func (s *FileDeadLetterStore) Store(_ context.Context, in DeadLetter) error {
+	raw, err := json.Marshal(in)
+	if err != nil {
+		return fmt.Errorf("while marshaling dead letter: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o750); err != nil {
+		return fmt.Errorf("while creating dead-letter directory: %w", err)
+	}
+	file, err := os.OpenFile(filepath.Clean(s.path), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("while opening dead-letter file: %w", err)
+	}
+
+	if _, err := file.Write(append(raw, '\n')); err != nil {
+		_ = file.Close()
+		return fmt.Errorf("while writing dead letter: %w", err)
+	}
+	return file.Close()
+}
+
+// ConfigMapDeadLetterStore stores dead letters in a ConfigMap. Each dead letter is stored under a separate key.
+// Only the latest dead letters are kept, as the ConfigMap size is limited.
+type ConfigMapDeadLetterStore struct {
+	k8sCli     kubernetes.Interface
+	ref        config.K8sResourceRef
+	maxEntries int
+}
+
+// NewConfigMapDeadLetterStore returns a new ConfigMapDeadLetterStore instance.
+func NewConfigMapDeadLetterStore(k8sCli kubernetes.Interface, ref config.K8sResourceRef, maxEntries int) *ConfigMapDeadLetterStore {
+	return &ConfigMapDeadLetterStore{
+		k8sCli:     k8sCli,
+		ref:        ref,
+		maxEntries: maxEntries,
+	}
+}
+
+// Store adds a given dead letter to the ConfigMap and removes the oldest ones above the limit.
+// The ConfigMap is created if it doesn't exist.
+func (s *ConfigMapDeadLetterStore) Store(ctx context.Context, in DeadLetter) error {
+	raw, err := json.Marshal(in)
+	if err != nil {
+		return fmt.Errorf("while marshaling dead letter: %w", err)
+	}
+	key := fmt.Sprintf("%s_%s.json", in.ID, invalidNameCharsRegex.ReplaceAllString(in.Sink, "-"))
+
+	cli := s.k8sCli.CoreV1().ConfigMaps(s.ref.Namespace)
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cm, err := cli.Get(ctx, s.ref.Name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			_, err := cli.Create(ctx, &v1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      s.ref.Name,
+					Namespace: s.ref.Namespace,
+				},
+				Data: map[string]string{key: string(raw)},
+			}, metav1.CreateOptions{})
+			if err != nil {
+				return fmt.Errorf("while creating dead-letter ConfigMap: %w", err)
+			}
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("while getting dead-letter ConfigMap: %w", err)
+		}
+
+		if cm.Data == nil {
+			cm.Data = map[string]string{}
+		}
+		cm.Data[key] = string(raw)
+		s.trim(cm.Data)
+
+		_, err = cli.Update(ctx, cm, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// trim removes the oldest entries above the limit. The keys are prefixed with time-ordered IDs.
+func (s *ConfigMapDeadLetterStore) trim(data map[string]string) {
+	if s.maxEntries <= 0 || len(data) <= s.maxEntries {
+		return
+	}
+
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys[:len(keys)-s.maxEntries] {
+		delete(data, key)
+	}
+}
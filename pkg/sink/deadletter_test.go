@@ -0,0 +1,89 @@
+package sink
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+func TestFileDeadLetterStore(t *testing.T) {
+	// given
+	path := filepath.Join(t.TempDir(), "dead-letters", "sinks.jsonl")
+	store := NewFileDeadLetterStore(path)
+
+	// when
+	for i := 0; i < 2; i++ {
+		err := store.Store(context.Background(), fixDeadLetter(i))
+		require.NoError(t, err)
+	}
+
+	// then
+	raw, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(string(raw)), "\n")
+	require.Len(t, lines, 2)
+
+	var got DeadLetter
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &got))
+	assert.Equal(t, "event-1", got.ID)
+	assert.Equal(t, "default-group-webhook", got.Sink)
+}
+
+func TestConfigMapDeadLetterStore(t *testing.T) {
+	// given
+	ref := config.K8sResourceRef{Name: "botkube-dead-letters", Namespace: "botkube"}
+	k8sCli := fake.NewSimpleClientset()
+	store := NewConfigMapDeadLetterStore(k8sCli, ref, 2)
+
+	// when
+	for i := 0; i < 3; i++ {
+		err := store.Store(context.Background(), fixDeadLetter(i))
+		require.NoError(t, err)
+	}
+
+	// then
+	cm, err := k8sCli.CoreV1().ConfigMaps(ref.Namespace).Get(context.Background(), ref.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+
+	var keys []string
+	for key := range cm.Data {
+		keys = append(keys, key)
+	}
+	assert.ElementsMatch(t, []string{
+		"event-1_default-group-webhook.json",
+		"event-2_default-group-webhook.json",
+	}, keys)
+}
+
+func TestNewDeadLetterStore(t *testing.T) {
+	// when
+	store := NewDeadLetterStore(config.SinkDeadLetter{}, nil)
+
+	// then
+	assert.Nil(t, store)
+}
+
+func fixDeadLetter(idx int) DeadLetter {
+	return DeadLetter{
+		ID:       fmt.Sprintf("event-%d", idx),
+		Sink:     "default-group-webhook",
+		Sources:  []string{"k8s-events"},
+		Data:     map[string]any{"Name": "foo"},
+		Attempts: 3,
+		Error:    "connection refused",
+		FailedAt: time.Date(2023, 1, 1, 0, 0, idx, 0, time.UTC),
+	}
+}
@@ -2,7 +2,10 @@ package sink
 
 import (
 	"context"
+	"crypto/sha256"
 	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
@@ -182,7 +185,13 @@ func (e *Elasticsearch) flushIndex(ctx context.Context, indexCfg config.ELSIndex
 		// nolint:staticcheck
 		indexService.Type(e.clusterVersion)
 	}
-	_, err = indexService.BodyJson(event).Do(ctx)
+	docID, err := documentID(ctx, event)
+	if err != nil {
+		return fmt.Errorf("while computing document ID: %w", err)
+	}
+	// A deterministic ID makes the write idempotent, so when the sink queue retries an event
+	// that failed only for some indices, the indices that already accepted it are not duplicated.
+	_, err = indexService.Id(docID).BodyJson(event).Do(ctx)
 	if err != nil {
 		return fmt.Errorf("while posting data to ELS: %w", err)
 	}
@@ -225,6 +234,27 @@ func (e *Elasticsearch) Type() config.IntegrationType {
 	return config.SinkIntegrationType
 }
 
+// documentID returns an ID derived from the event ID and content. The event ID is the same for all delivery attempts,
+// so identical events are stored separately, while retries don't duplicate them.
+// If the event ID is not available, the current time is used instead.
+func documentID(ctx context.Context, event any) (string, error) {
+	raw, err := json.Marshal(event)
+	if err != nil {
+		return "", err
+	}
+
+	id, found := eventIDFromContext(ctx)
+	if !found {
+		id = strconv.FormatInt(time.Now().UnixNano(), 10)
+	}
+
+	h := sha256.New()
+	h.Write([]byte(id))
+	h.Write([]byte{0})
+	h.Write(raw)
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
 func esMajorClusterVersion(v string) (int, error) {
 	versionParts := strings.Split(v, ".")
 	if len(versionParts) == 1 {
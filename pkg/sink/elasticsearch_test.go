@@ -1,10 +1,12 @@
 package sink
 
 import (
+	"context"
 	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestElasticsearchVersion(t *testing.T) {
@@ -27,3 +29,22 @@ func TestElasticsearchVersion(t *testing.T) {
 		assert.Equal(t, test.err, err)
 	}
 }
+
+func TestElasticsearchDocumentID(t *testing.T) {
+	// given
+	event := map[string]any{"kind": "Pod", "name": "nginx"}
+	ctx := withEventID(context.Background(), "first")
+	identicalEventCtx := withEventID(context.Background(), "second")
+
+	// when
+	first, err := documentID(ctx, event)
+	require.NoError(t, err)
+	retried, err := documentID(ctx, event)
+	require.NoError(t, err)
+	identical, err := documentID(identicalEventCtx, event)
+	require.NoError(t, err)
+
+	// then
+	assert.Equal(t, first, retried)
+	assert.NotEqual(t, first, identical)
+}
@@ -0,0 +1,364 @@
+package sink
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/sirupsen/logrus"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/notifier"
+)
+
+var _ Sink = &Queue{}
+
+const (
+	queueFullReason        = "queue_full"
+	retriesExhaustedReason = "retries_exhausted"
+
+	queuedEventFileExt = ".json"
+)
+
+// ErrQueueFull is returned when an event cannot be queued as the sink queue is full.
+var ErrQueueFull = errors.New("sink queue is full")
+
+var (
+	queueDepth = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "botkube_sink_queue_depth",
+		Help: "Number of events waiting for delivery to a given sink, including the ones which are being retried.",
+	}, []string{"sink"})
+	deliveryRetriesTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "botkube_sink_delivery_retries_total",
+		Help: "Total number of retried event deliveries to a given sink.",
+	}, []string{"sink"})
+	droppedEventsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "botkube_sink_dropped_events_total",
+		Help: "Total number of events which were not delivered to a given sink.",
+	}, []string{"sink", "reason"})
+
+	invalidNameCharsRegex = regexp.MustCompile(`[^-._a-zA-Z0-9]+`)
+)
+
+// Queue delivers events to a given sink in the background. Failed deliveries are retried with exponential backoff and jitter.
+// Events which exhaust all retries are passed to the dead-letter store.
+type Queue struct {
+	log         logrus.FieldLogger
+	name        string
+	sink        notifier.Sink
+	cfg         config.SinkDelivery
+	deadLetters DeadLetterStore
+	dir         string
+
+	events chan queuedEvent
+	jitter func(time.Duration) time.Duration
+
+	// backlog holds names of the persisted events which didn't fit into the queue. They are queued once there is space.
+	backlogMu sync.Mutex
+	backlog   []string
+}
+
+type queuedEvent struct {
+	ID      string   `json:"id"`
+	Data    any      `json:"data"`
+	Sources []string `json:"sources"`
+}
+
+// NewQueue returns a new Queue instance for a given sink. The name must be unique across all sinks, as it is used
+// for metrics and the on-disk queue directory. If the queue directory is configured, previously queued events are loaded.
+func NewQueue(log logrus.FieldLogger, name string, sink notifier.Sink, cfg config.SinkDelivery, deadLetters DeadLetterStore) (*Queue, error) {
+	name = invalidNameCharsRegex.ReplaceAllString(name, "-")
+	if cfg.QueueSize < 1 {
+		cfg.QueueSize = 1
+	}
+	if cfg.Concurrency < 1 {
+		cfg.Concurrency = 1
+	}
+
+	q := &Queue{
+		log:         log,
+		name:        name,
+		sink:        sink,
+		cfg:         cfg,
+		deadLetters: deadLetters,
+		events:      make(chan queuedEvent, cfg.QueueSize),
+		jitter:      withJitter,
+	}
+
+	if cfg.QueueDir == "" {
+		return q, nil
+	}
+
+	q.dir = filepath.Join(cfg.QueueDir, name)
+	if err := os.MkdirAll(q.dir, 0o750); err != nil {
+		return nil, fmt.Errorf("while creating queue directory: %w", err)
+	}
+	if err := q.loadPersisted(); err != nil {
+		return nil, fmt.Errorf("while loading queued events: %w", err)
+	}
+	return q, nil
+}
+
+// Start starts delivering queued events. It blocks until the context is canceled.
+func (q *Queue) Start(ctx context.Context) error {
+	q.log.Infof("Starting sink queue with %d workers...", q.cfg.Concurrency)
+
+	var wg sync.WaitGroup
+	for i := 0; i < q.cfg.Concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case evt := <-q.events:
+					q.deliver(ctx, evt)
+					if err := q.queueBacklog(); err != nil {
+						q.log.Errorf("while queuing persisted events: %s", err.Error())
+					}
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+	return nil
+}
+
+// SendEvent queues an event for delivery. It returns ErrQueueFull if the event cannot be queued.
+func (q *Queue) SendEvent(ctx context.Context, rawData any, sources []string) error {
+	evt := queuedEvent{
+		ID:      fmt.Sprintf("%020d-%s", time.Now().UnixNano(), uuid.New().String()[:8]),
+		Data:    rawData,
+		Sources: sources,
+	}
+
+	if len(q.events) == cap(q.events) {
+		return q.dropQueueFull(ctx, evt)
+	}
+
+	if err := q.persist(evt); err != nil {
+		return fmt.Errorf("while persisting queued event: %w", err)
+	}
+
+	if q.enqueue(evt) {
+		return nil
+	}
+	q.remove(evt)
+	return q.dropQueueFull(ctx, evt)
+}
+
+// IntegrationName describes the notifier integration name.
+func (q *Queue) IntegrationName() config.CommPlatformIntegration {
+	return q.sink.IntegrationName()
+}
+
+// Type describes the notifier type.
+func (q *Queue) Type() config.IntegrationType {
+	return q.sink.Type()
+}
+
+// enqueue adds an event to the queue without blocking. It returns false if the queue is full.
+func (q *Queue) enqueue(evt queuedEvent) bool {
+	select {
+	case q.events <- evt:
+		queueDepth.WithLabelValues(q.name).Inc()
+		return true
+	default:
+		return false
+	}
+}
+
+func (q *Queue) deliver(ctx context.Context, evt queuedEvent) {
+	defer queueDepth.WithLabelValues(q.name).Dec()
+
+	var err error
+	attempts := 0
+	for ; attempts <= q.cfg.MaxRetries; attempts++ {
+		if attempts > 0 {
+			deliveryRetriesTotal.WithLabelValues(q.name).Inc()
+			if !sleep(ctx, q.backoff(attempts)) {
+				// the event stays persisted, so it's delivered after restart
+				return
+			}
+		}
+
+		err = q.sink.SendEvent(withEventID(ctx, evt.ID), evt.Data, evt.Sources)
+		if err == nil {
+			q.remove(evt)
+			return
+		}
+		q.log.Debugf("Delivery attempt %d of event %q failed: %s", attempts+1, evt.ID, err.Error())
+	}
+
+	if ctx.Err() != nil {
+		return
+	}
+
+	droppedEventsTotal.WithLabelValues(q.name, retriesExhaustedReason).Inc()
+	q.storeDeadLetter(ctx, evt, attempts, err)
+	q.remove(evt)
+}
+
+// backoff returns the delay before a given retry. The exponential delay is capped by MaxBackoff and randomized with jitter.
+func (q *Queue) backoff(retry int) time.Duration {
+	delay := q.cfg.InitialBackoff
+	for i := 1; i < retry && (q.cfg.MaxBackoff <= 0 || delay < q.cfg.MaxBackoff); i++ {
+		delay *= 2
+	}
+	if q.cfg.MaxBackoff > 0 && delay > q.cfg.MaxBackoff {
+		delay = q.cfg.MaxBackoff
+	}
+	return q.jitter(delay)
+}
+
+func (q *Queue) dropQueueFull(ctx context.Context, evt queuedEvent) error {
+	droppedEventsTotal.WithLabelValues(q.name, queueFullReason).Inc()
+	q.storeDeadLetter(ctx, evt, 0, ErrQueueFull)
+	return ErrQueueFull
+}
+
+func (q *Queue) storeDeadLetter(ctx context.Context, evt queuedEvent, attempts int, deliveryErr error) {
+	q.log.Errorf("Event %q was not delivered after %d attempts: %s", evt.ID, attempts, deliveryErr.Error())
+	if q.deadLetters == nil {
+		return
+	}
+
+	err := q.deadLetters.Store(ctx, DeadLetter{
+		ID:       evt.ID,
+		Sink:     q.name,
+		Sources:  evt.Sources,
+		Data:     evt.Data,
+		Attempts: attempts,
+		Error:    deliveryErr.Error(),
+		FailedAt: time.Now(),
+	})
+	if err != nil {
+		q.log.Errorf("while storing dead letter for event %q: %s", evt.ID, err.Error())
+	}
+}
+
+func (q *Queue) persist(evt queuedEvent) error {
+	if q.dir == "" {
+		return nil
+	}
+
+	raw, err := json.Marshal(evt)
+	if err != nil {
+		return fmt.Errorf("while marshaling event: %w", err)
+	}
+	return os.WriteFile(q.eventPath(evt), raw, 0o600)
+}
+
+func (q *Queue) remove(evt queuedEvent) {
+	if q.dir == "" {
+		return
+	}
+	if err := os.Remove(q.eventPath(evt)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		q.log.Errorf("while removing queued event %q: %s", evt.ID, err.Error())
+	}
+}
+
+func (q *Queue) eventPath(evt queuedEvent) string {
+	return filepath.Join(q.dir, evt.ID+queuedEventFileExt)
+}
+
+// loadPersisted queues events stored on disk, the oldest first. Events which don't fit into the queue are queued
+// once there is space.
+func (q *Queue) loadPersisted() error {
+	entries, err := os.ReadDir(q.dir)
+	if err != nil {
+		return err
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), queuedEventFileExt) {
+			continue
+		}
+		names = append(names, entry.Name())
+	}
+	sort.Strings(names)
+
+	q.backlogMu.Lock()
+	q.backlog = names
+	q.backlogMu.Unlock()
+
+	if err := q.queueBacklog(); err != nil {
+		return err
+	}
+
+	q.backlogMu.Lock()
+	defer q.backlogMu.Unlock()
+	if len(q.backlog) > 0 {
+		q.log.Warnf("Sink queue is full. %d persisted events will be queued once there is space.", len(q.backlog))
+	}
+	return nil
+}
+
+// queueBacklog moves persisted events from the backlog to the queue until the queue is full.
+func (q *Queue) queueBacklog() error {
+	q.backlogMu.Lock()
+	defer q.backlogMu.Unlock()
+
+	for len(q.backlog) > 0 {
+		if len(q.events) == cap(q.events) {
+			return nil
+		}
+
+		name := q.backlog[0]
+		raw, err := os.ReadFile(filepath.Clean(filepath.Join(q.dir, name)))
+		if err != nil {
+			// the event is skipped, so it doesn't block the rest of the backlog
+			q.backlog = q.backlog[1:]
+			return fmt.Errorf("while reading %q: %w", name, err)
+		}
+		var evt queuedEvent
+		if err := json.Unmarshal(raw, &evt); err != nil {
+			q.log.Errorf("Skipping malformed queued event %q: %s", name, err.Error())
+			q.backlog = q.backlog[1:]
+			continue
+		}
+
+		if !q.enqueue(evt) {
+			// new events were queued in the meantime
+			return nil
+		}
+		q.backlog = q.backlog[1:]
+	}
+	return nil
+}
+
+// withJitter returns a random duration in the [d/2, d) range.
+func withJitter(d time.Duration) time.Duration {
+	if d <= 1 {
+		return d
+	}
+	half := d / 2
+	return half + time.Duration(rand.Int63n(int64(d-half))) // #nosec G404
+}
+
+func sleep(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
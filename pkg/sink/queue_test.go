@@ -0,0 +1,290 @@
+package sink
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/internal/loggerx"
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+func TestQueueRetriesFailedDelivery(t *testing.T) {
+	// given
+	fake := &fakeSink{failures: 2, delivered: make(chan any, 1)}
+	deadLetters := &fakeDeadLetterStore{}
+	queue, err := NewQueue(loggerx.NewNoop(), "retry", fake, fixSinkDelivery(3), deadLetters)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		_ = queue.Start(ctx)
+	}()
+
+	// when
+	err = queue.SendEvent(ctx, "event", []string{"k8s-events"})
+	require.NoError(t, err)
+
+	// then
+	select {
+	case got := <-fake.delivered:
+		assert.Equal(t, "event", got)
+	case <-time.After(5 * time.Second):
+		t.Fatal("event was not delivered")
+	}
+	assert.Equal(t, 3, fake.Attempts())
+	assert.Empty(t, deadLetters.Stored())
+}
+
+func TestQueueStoresDeadLetterWhenRetriesExhausted(t *testing.T) {
+	// given
+	fake := &fakeSink{failures: 10}
+	deadLetters := &fakeDeadLetterStore{stored: make(chan DeadLetter, 1)}
+	queue, err := NewQueue(loggerx.NewNoop(), "exhausted", fake, fixSinkDelivery(2), deadLetters)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		_ = queue.Start(ctx)
+	}()
+
+	// when
+	err = queue.SendEvent(ctx, "event", []string{"k8s-events"})
+	require.NoError(t, err)
+
+	// then
+	select {
+	case got := <-deadLetters.stored:
+		assert.Equal(t, "exhausted", got.Sink)
+		assert.Equal(t, "event", got.Data)
+		assert.Equal(t, []string{"k8s-events"}, got.Sources)
+		assert.Equal(t, 3, got.Attempts)
+		assert.Equal(t, "fake failure", got.Error)
+	case <-time.After(5 * time.Second):
+		t.Fatal("dead letter was not stored")
+	}
+}
+
+func TestQueueDropsEventsWhenFull(t *testing.T) {
+	// given
+	deadLetters := &fakeDeadLetterStore{stored: make(chan DeadLetter, 1)}
+	cfg := fixSinkDelivery(0)
+	cfg.QueueSize = 1
+	queue, err := NewQueue(loggerx.NewNoop(), "full", &fakeSink{}, cfg, deadLetters)
+	require.NoError(t, err)
+
+	// when
+	err = queue.SendEvent(context.Background(), "first", nil)
+	require.NoError(t, err)
+
+	err = queue.SendEvent(context.Background(), "second", nil)
+
+	// then
+	assert.ErrorIs(t, err, ErrQueueFull)
+	got := <-deadLetters.stored
+	assert.Equal(t, "second", got.Data)
+}
+
+func TestQueueLoadsPersistedEvents(t *testing.T) {
+	// given
+	cfg := fixSinkDelivery(0)
+	cfg.QueueDir = t.TempDir()
+
+	queue, err := NewQueue(loggerx.NewNoop(), "default/webhook", &fakeSink{}, cfg, nil)
+	require.NoError(t, err)
+	require.NoError(t, queue.SendEvent(context.Background(), map[string]any{"Name": "foo"}, []string{"k8s-events"}))
+
+	fake := &fakeSink{delivered: make(chan any, 1)}
+
+	// when
+	restarted, err := NewQueue(loggerx.NewNoop(), "default/webhook", fake, cfg, nil)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		_ = restarted.Start(ctx)
+	}()
+
+	// then
+	select {
+	case got := <-fake.delivered:
+		assert.Equal(t, map[string]any{"Name": "foo"}, got)
+	case <-time.After(5 * time.Second):
+		t.Fatal("persisted event was not delivered")
+	}
+
+	assert.Eventually(t, func() bool {
+		entries, err := os.ReadDir(restarted.dir)
+		return err == nil && len(entries) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestQueueDrainsPersistedBacklog(t *testing.T) {
+	// given
+	cfg := fixSinkDelivery(0)
+	cfg.QueueDir = t.TempDir()
+
+	queue, err := NewQueue(loggerx.NewNoop(), "backlog", &fakeSink{}, cfg, nil)
+	require.NoError(t, err)
+	for _, name := range []string{"first", "second", "third"} {
+		require.NoError(t, queue.SendEvent(context.Background(), name, nil))
+	}
+
+	cfg.QueueSize = 1
+	fake := &fakeSink{delivered: make(chan any, 3)}
+
+	// when
+	restarted, err := NewQueue(loggerx.NewNoop(), "backlog", fake, cfg, nil)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		_ = restarted.Start(ctx)
+	}()
+
+	// then
+	var got []any
+	for len(got) < 3 {
+		select {
+		case data := <-fake.delivered:
+			got = append(got, data)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("persisted events were not delivered, got %v", got)
+		}
+	}
+	assert.Equal(t, []any{"first", "second", "third"}, got)
+}
+
+func TestQueueDepthOnCanceledRetry(t *testing.T) {
+	// given
+	cfg := fixSinkDelivery(5)
+	cfg.InitialBackoff = time.Hour
+	cfg.MaxBackoff = time.Hour
+	fake := &fakeSink{failures: 10}
+	queue, err := NewQueue(loggerx.NewNoop(), "canceled", fake, cfg, nil)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan struct{})
+	go func() {
+		_ = queue.Start(ctx)
+		close(stopped)
+	}()
+
+	// when
+	require.NoError(t, queue.SendEvent(ctx, "event", nil))
+	require.Eventually(t, func() bool { return fake.Attempts() == 1 }, 5*time.Second, 10*time.Millisecond)
+	cancel()
+	<-stopped
+
+	// then
+	assert.Equal(t, float64(0), testutil.ToFloat64(queueDepth.WithLabelValues("canceled")))
+}
+
+func TestQueueBackoff(t *testing.T) {
+	// given
+	queue := &Queue{
+		cfg: config.SinkDelivery{
+			InitialBackoff: time.Second,
+			MaxBackoff:     5 * time.Second,
+		},
+		jitter: func(d time.Duration) time.Duration { return d },
+	}
+
+	// then
+	assert.Equal(t, time.Second, queue.backoff(1))
+	assert.Equal(t, 2*time.Second, queue.backoff(2))
+	assert.Equal(t, 4*time.Second, queue.backoff(3))
+	assert.Equal(t, 5*time.Second, queue.backoff(4))
+	assert.Equal(t, 5*time.Second, queue.backoff(10))
+}
+
+func TestWithJitter(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		got := withJitter(time.Second)
+		assert.GreaterOrEqual(t, got, 500*time.Millisecond)
+		assert.Less(t, got, time.Second)
+	}
+}
+
+func fixSinkDelivery(maxRetries int) config.SinkDelivery {
+	return config.SinkDelivery{
+		QueueSize:      10,
+		Concurrency:    1,
+		MaxRetries:     maxRetries,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     5 * time.Millisecond,
+	}
+}
+
+type fakeSink struct {
+	failures  int
+	delivered chan any
+
+	mu       sync.Mutex
+	attempts int
+}
+
+func (f *fakeSink) SendEvent(_ context.Context, data any, _ []string) error {
+	f.mu.Lock()
+	f.attempts++
+	attempts := f.attempts
+	f.mu.Unlock()
+
+	if attempts <= f.failures {
+		return errors.New("fake failure")
+	}
+	if f.delivered != nil {
+		f.delivered <- data
+	}
+	return nil
+}
+
+func (f *fakeSink) Attempts() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.attempts
+}
+
+func (f *fakeSink) IntegrationName() config.CommPlatformIntegration {
+	return config.WebhookCommPlatformIntegration
+}
+
+func (f *fakeSink) Type() config.IntegrationType {
+	return config.SinkIntegrationType
+}
+
+type fakeDeadLetterStore struct {
+	stored chan DeadLetter
+
+	mu  sync.Mutex
+	all []DeadLetter
+}
+
+func (f *fakeDeadLetterStore) Store(_ context.Context, in DeadLetter) error {
+	f.mu.Lock()
+	f.all = append(f.all, in)
+	f.mu.Unlock()
+
+	if f.stored != nil {
+		f.stored <- in
+	}
+	return nil
+}
+
+func (f *fakeDeadLetterStore) Stored() []DeadLetter {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.all
+}
@@ -1,6 +1,8 @@
 package sink
 
 import (
+	"context"
+
 	"github.com/kubeshop/botkube/pkg/config"
 	"github.com/kubeshop/botkube/pkg/notifier"
 )
@@ -15,3 +17,16 @@ type AnalyticsReporter interface {
 	// ReportSinkEnabled reports an enabled sink.
 	ReportSinkEnabled(platform config.CommPlatformIntegration) error
 }
+
+type eventIDCtxKey struct{}
+
+// withEventID returns a context with a given event ID, which is the same for all delivery attempts of the event.
+func withEventID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, eventIDCtxKey{}, id)
+}
+
+// eventIDFromContext returns the event ID set by the sink queue.
+func eventIDFromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(eventIDCtxKey{}).(string)
+	return id, ok && id != ""
+}
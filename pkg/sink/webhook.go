@@ -3,9 +3,17 @@ package sink
 import (
 	"bytes"
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
@@ -24,6 +32,11 @@ type Webhook struct {
 
 	URL      string
 	Bindings config.SinkBindings
+	Headers  map[string]string
+	Auth     config.WebhookAuth
+	Signing  config.WebhookSigning
+
+	client *http.Client
 }
 
 // WebhookPayload contains json payload to be sent to webhook url
@@ -35,14 +48,29 @@ type WebhookPayload struct {
 
 // NewWebhook creates a new Webhook instance.
 func NewWebhook(log logrus.FieldLogger, c config.Webhook, reporter AnalyticsReporter) (*Webhook, error) {
+	tlsCfg, err := webhookTLSConfig(c.TLS)
+	if err != nil {
+		return nil, fmt.Errorf("while creating TLS config: %w", err)
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsCfg
+
 	whNotifier := &Webhook{
 		log:      log,
 		reporter: reporter,
 		URL:      c.URL,
 		Bindings: c.Bindings,
+		Headers:  c.Headers,
+		Auth:     c.Auth,
+		Signing:  c.Signing,
+		client: &http.Client{
+			Timeout:   defaultHTTPCliTimeout,
+			Transport: transport,
+		},
 	}
 
-	err := reporter.ReportSinkEnabled(whNotifier.IntegrationName())
+	err = reporter.ReportSinkEnabled(whNotifier.IntegrationName())
 	if err != nil {
 		return nil, fmt.Errorf("while reporting analytics: %w", err)
 	}
@@ -79,7 +107,12 @@ func (w *Webhook) PostWebhook(ctx context.Context, jsonPayload *WebhookPayload)
 	}
 	req.Header.Add("Content-Type", "application/json")
 
-	client := &http.Client{Timeout: defaultHTTPCliTimeout}
+	w.setRequestHeaders(req, message)
+
+	client := w.client
+	if client == nil {
+		client = &http.Client{Timeout: defaultHTTPCliTimeout}
+	}
 	resp, err := client.Do(req)
 	if err != nil {
 		return err
@@ -98,6 +131,85 @@ func (w *Webhook) PostWebhook(ctx context.Context, jsonPayload *WebhookPayload)
 	return nil
 }
 
+// SignWebhookPayload returns the HMAC-SHA256 signature of a given payload in the "sha256={hex}" format.
+// Receivers can use it to verify the signature header.
+func SignWebhookPayload(secret []byte, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// setRequestHeaders sets custom headers, authentication and signature headers.
+func (w *Webhook) setRequestHeaders(req *http.Request, payload []byte) {
+	for name, val := range w.Headers {
+		req.Header.Set(name, val)
+	}
+
+	switch {
+	case w.Auth.BearerToken != "":
+		req.Header.Set("Authorization", "Bearer "+w.Auth.BearerToken)
+	case w.Auth.Basic.Username != "":
+		req.SetBasicAuth(w.Auth.Basic.Username, w.Auth.Basic.Password)
+	}
+
+	if w.Signing.Secret == "" {
+		return
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	req.Header.Set(headerOrDefault(w.Signing.TimestampHeader, config.DefaultWebhookTimestampHeader), timestamp)
+	req.Header.Set(headerOrDefault(w.Signing.Header, config.DefaultWebhookSignatureHeader), SignWebhookPayload([]byte(w.Signing.Secret), timestamp, payload))
+}
+
+func headerOrDefault(header, def string) string {
+	if header == "" {
+		return def
+	}
+	return header
+}
+
+func webhookTLSConfig(cfg config.WebhookTLS) (*tls.Config, error) {
+	out := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if cfg.CAFile != "" {
+		caCert, err := os.ReadFile(filepath.Clean(cfg.CAFile))
+		if err != nil {
+			return nil, fmt.Errorf("while reading CA bundle: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("CA bundle %q doesn't contain any valid PEM certificate", cfg.CAFile)
+		}
+		out.RootCAs = pool
+	}
+
+	if cfg.CertFile != "" || cfg.KeyFile != "" {
+		// fail fast on invalid configuration
+		if _, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile); err != nil {
+			return nil, fmt.Errorf("while loading client certificate: %w", err)
+		}
+		out.GetClientCertificate = clientCertificateLoader(cfg.CertFile, cfg.KeyFile)
+	}
+
+	return out, nil
+}
+
+// clientCertificateLoader returns a function which loads the client certificate for every new connection,
+// so a rotated certificate, e.g. from a mounted Secret, is picked up without restart.
+func clientCertificateLoader(certFile, keyFile string) func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	return func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("while loading client certificate: %w", err)
+		}
+		return &cert, nil
+	}
+}
+
 // IntegrationName describes the notifier integration name.
 func (w *Webhook) IntegrationName() config.CommPlatformIntegration {
 	return config.WebhookCommPlatformIntegration
@@ -2,12 +2,27 @@ package sink
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
 	"fmt"
+	"io"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/pkg/config"
 )
 
 // Unit test PostWebhook
@@ -44,3 +59,148 @@ func TestPostWebhook(t *testing.T) {
 		})
 	}
 }
+
+func TestPostWebhookAuthAndSigning(t *testing.T) {
+	// given
+	var gotReq *http.Request
+	var gotBody []byte
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotReq = r
+		gotBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	w := &Webhook{
+		URL: ts.URL,
+		Headers: map[string]string{
+			"X-Tenant": "team-a",
+		},
+		Auth: config.WebhookAuth{
+			BearerToken: "token",
+		},
+		Signing: config.WebhookSigning{
+			Secret: "s3cr3t",
+		},
+	}
+
+	// when
+	err := w.PostWebhook(context.Background(), &WebhookPayload{Source: "k8s-events"})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "team-a", gotReq.Header.Get("X-Tenant"))
+	assert.Equal(t, "Bearer token", gotReq.Header.Get("Authorization"))
+
+	timestamp := gotReq.Header.Get(config.DefaultWebhookTimestampHeader)
+	require.NotEmpty(t, timestamp)
+	assert.Equal(t, SignWebhookPayload([]byte("s3cr3t"), timestamp, gotBody), gotReq.Header.Get(config.DefaultWebhookSignatureHeader))
+}
+
+func TestPostWebhookBasicAuth(t *testing.T) {
+	// given
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, pass, ok := r.BasicAuth()
+		if !ok || user != "botkube" || pass != "pass" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	w := &Webhook{
+		URL: ts.URL,
+		Auth: config.WebhookAuth{
+			Basic: config.WebhookBasicAuth{
+				Username: "botkube",
+				Password: "pass",
+			},
+		},
+	}
+
+	// when
+	err := w.PostWebhook(context.Background(), &WebhookPayload{})
+
+	// then
+	assert.NoError(t, err)
+}
+
+func TestWebhookClientCertificateRotation(t *testing.T) {
+	// given
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "tls.crt")
+	keyFile := filepath.Join(dir, "tls.key")
+	writeCertificate(t, certFile, keyFile, "first")
+
+	tlsCfg, err := webhookTLSConfig(config.WebhookTLS{CertFile: certFile, KeyFile: keyFile})
+	require.NoError(t, err)
+	require.NotNil(t, tlsCfg.GetClientCertificate)
+
+	// when
+	first, err := tlsCfg.GetClientCertificate(&tls.CertificateRequestInfo{})
+	require.NoError(t, err)
+	writeCertificate(t, certFile, keyFile, "rotated")
+	rotated, err := tlsCfg.GetClientCertificate(&tls.CertificateRequestInfo{})
+	require.NoError(t, err)
+
+	// then
+	assert.Equal(t, "first", commonName(t, first))
+	assert.Equal(t, "rotated", commonName(t, rotated))
+}
+
+func TestWebhookTLSConfig(t *testing.T) {
+	// given
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	caFile := filepath.Join(t.TempDir(), "ca.crt")
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw})
+	require.NoError(t, os.WriteFile(caFile, caPEM, 0o600))
+
+	tlsCfg, err := webhookTLSConfig(config.WebhookTLS{CAFile: caFile})
+	require.NoError(t, err)
+
+	w := &Webhook{
+		URL: ts.URL,
+		client: &http.Client{
+			Transport: &http.Transport{TLSClientConfig: tlsCfg},
+		},
+	}
+
+	// when
+	err = w.PostWebhook(context.Background(), &WebhookPayload{})
+
+	// then
+	assert.NoError(t, err)
+}
+
+func writeCertificate(t *testing.T, certFile, keyFile, commonName string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600))
+}
+
+func commonName(t *testing.T, cert *tls.Certificate) string {
+	t.Helper()
+
+	parsed, err := x509.ParseCertificate(cert.Certificate[0])
+	require.NoError(t, err)
+	return parsed.Subject.CommonName
+}